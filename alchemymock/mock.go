@@ -81,6 +81,38 @@ func (m *AlchemyHttpMock) RegisterBatchResponderOnce(response string) {
 	m.batchResponders = append(m.batchResponders, responder)
 }
 
+// RegisterBatchResultsOnce registers a single-use responder for a JSON-RPC
+// batch request that answers its i-th request with results[i] (a raw JSON
+// value) under the id of that request, for batches whose ids are not known
+// in advance, e.g. sent alongside other requests of the same client.
+func (m *AlchemyHttpMock) RegisterBatchResultsOnce(results ...string) {
+	responder := func(req *http.Request) (*http.Response, error) {
+		var requests []struct {
+			ID json.RawMessage `json:"id"`
+		}
+		if err := json.NewDecoder(req.Body).Decode(&requests); err != nil {
+			return nil, errors.New("invalid json")
+		}
+		if len(requests) != len(results) {
+			return nil, fmt.Errorf("batch of %d requests mocked with %d results", len(requests), len(results))
+		}
+
+		responses := make([]map[string]json.RawMessage, len(requests))
+		for i, request := range requests {
+			responses[i] = map[string]json.RawMessage{
+				"jsonrpc": json.RawMessage(`"2.0"`),
+				"id":      request.ID,
+				"result":  json.RawMessage(results[i]),
+			}
+		}
+		return httpmock.NewJsonResponse(http.StatusOK, responses)
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.batchResponders = append(m.batchResponders, responder)
+}
+
 func (m *AlchemyHttpMock) registerMasterResponder() {
 	httpmock.RegisterResponder("POST", m.baseUrl, func(req *http.Request) (*http.Response, error) {
 		var request jsonRpcRequest
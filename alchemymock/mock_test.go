@@ -217,6 +217,8 @@ func newBatcherForMock() *batch.Batcher {
 		nil,
 		0,
 		nil,
+		nil,
+		nil,
 	))
 	return batch.NewBatcher(e)
 }
@@ -266,3 +268,46 @@ func TestAlchemyMock_BatchResponder(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func TestAlchemyMock_BatchResultsResponder(t *testing.T) {
+	t.Run("answers each request of the batch under its id", func(t *testing.T) {
+		// Arrange
+		alchemyMock := alchemymock.NewAlchemyHttpMock(batchMockSetting, t)
+		defer alchemyMock.DeactivateAndReset()
+
+		b := newBatcherForMock()
+		balance := b.Core.Balance("0xabc", "latest")
+		blockNumber := b.Core.BlockNumber()
+		alchemyMock.RegisterBatchResultsOnce(`"0x1234"`, `"0x10"`)
+
+		// Act
+		err := b.Send()
+
+		// Assert
+		assert.NoError(t, err)
+
+		bal, err := balance.Unwrap()
+		assert.NoError(t, err)
+		assert.Equal(t, "4660", bal.String())
+
+		bn, err := blockNumber.Unwrap()
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(16), bn)
+	})
+
+	t.Run("if the batch size differs from the results, Send returns error", func(t *testing.T) {
+		// Arrange
+		alchemyMock := alchemymock.NewAlchemyHttpMock(batchMockSetting, t)
+		defer alchemyMock.DeactivateAndReset()
+
+		b := newBatcherForMock()
+		b.Core.BlockNumber()
+		alchemyMock.RegisterBatchResultsOnce(`"0x10"`, `"0x11"`)
+
+		// Act
+		err := b.Send()
+
+		// Assert
+		assert.Error(t, err)
+	})
+}
@@ -138,6 +138,39 @@ func (m *AlchemyWsMock) Close() {
 	m.handlers.Wait()
 }
 
+// DropConnections closes every open ws connection while the server keeps
+// listening, simulating a socket drop the client can redial from.
+func (m *AlchemyWsMock) DropConnections() {
+	m.mu.Lock()
+	conns := make([]*wsMockConn, len(m.conns))
+	copy(conns, m.conns)
+	m.mu.Unlock()
+	for _, mc := range conns {
+		mc.conn.Close()
+	}
+}
+
+// SubscriptionCount returns the number of active subscriptions of kind across
+// all open connections, e.g. to wait until a client has resubscribed.
+func (m *AlchemyWsMock) SubscriptionCount(kind string) int {
+	m.mu.Lock()
+	conns := make([]*wsMockConn, len(m.conns))
+	copy(conns, m.conns)
+	m.mu.Unlock()
+
+	count := 0
+	for _, mc := range conns {
+		mc.mu.Lock()
+		for _, k := range mc.subKind {
+			if k == kind {
+				count++
+			}
+		}
+		mc.mu.Unlock()
+	}
+	return count
+}
+
 // RegisterResponderOnce queues response as the next JSON-RPC reply for method.
 // The format mirrors AlchemyHttpMock.RegisterResponderOnce: pass the full
 // JSON-RPC envelope, e.g.:
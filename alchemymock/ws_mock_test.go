@@ -7,6 +7,7 @@ import (
 	"time"
 
 	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/poteto-go/go-alchemy-sdk/alchemymock"
 	"github.com/poteto-go/go-alchemy-sdk/gas"
 	"github.com/poteto-go/go-alchemy-sdk/types"
@@ -150,3 +151,53 @@ func TestAlchemyWsMock_EmitNewHeads_MultipleHeaders(t *testing.T) {
 		}
 	}
 }
+
+func TestAlchemyWsMock_SubscriptionCount(t *testing.T) {
+	mock := alchemymock.NewAlchemyWsMock(wsMockSetting, t)
+	a, err := mock.NewAlchemy()
+	require.NoError(t, err)
+
+	assert.Equal(t, 0, mock.SubscriptionCount("newHeads"))
+
+	subscription, err := a.WS.Subscribe(context.Background(), make(chan *gethTypes.Header), "newHeads")
+	require.NoError(t, err)
+
+	assert.Equal(t, 1, mock.SubscriptionCount("newHeads"))
+	assert.Equal(t, 0, mock.SubscriptionCount("logs"))
+
+	subscription.Unsubscribe()
+	assert.Eventually(t, func() bool {
+		return mock.SubscriptionCount("newHeads") == 0
+	}, 3*time.Second, 10*time.Millisecond)
+}
+
+func TestAlchemyWsMock_DropConnections(t *testing.T) {
+	mock := alchemymock.NewAlchemyWsMock(wsMockSetting, t)
+	a, err := mock.NewAlchemy()
+	require.NoError(t, err)
+
+	subscription, err := a.WS.Subscribe(context.Background(), make(chan *gethTypes.Header), "newHeads")
+	require.NoError(t, err)
+	defer subscription.Unsubscribe()
+
+	mock.DropConnections()
+
+	// MaxRetries 0: the client gives up resubscribing and reports the drop.
+	select {
+	case err := <-subscription.Err():
+		assert.Error(t, err)
+	case <-time.After(3 * time.Second):
+		t.Fatal("timed out waiting for the dropped subscription")
+	}
+	assert.Equal(t, 0, mock.SubscriptionCount("newHeads"))
+
+	// the server keeps listening after a drop
+	mock.RegisterResponderOnce("eth_blockNumber", `{"jsonrpc":"2.0","id":1,"result":"0x10"}`)
+	client, err := rpc.Dial(mock.URL())
+	require.NoError(t, err)
+	defer client.Close()
+
+	var bn string
+	require.NoError(t, client.Call(&bn, "eth_blockNumber"))
+	assert.Equal(t, "0x10", bn)
+}
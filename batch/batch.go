@@ -1,6 +1,8 @@
 package batch
 
 import (
+	"context"
+
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/ethereum/go-ethereum/rpc"
@@ -51,6 +53,30 @@ func failed[T any](err error) *Result[T] {
 	return &Result[T]{err: err, settled: true}
 }
 
+const (
+	DefaultMaxBatchSize = 100
+	DefaultConcurrency  = 4
+)
+
+// Config tunes how Send splits the queued requests and dispatches them.
+type Config struct {
+	// MaxBatchSize is the most requests sent in one JSON-RPC batch, or in one
+	// aggregate3 of a multicall Batcher; 0 uses DefaultMaxBatchSize.
+	MaxBatchSize int
+
+	// Concurrency is how many chunks are in flight at once; 0 uses
+	// DefaultConcurrency.
+	Concurrency int
+
+	// Backoff, if set, sends a chunk that failed again, while the chunks that
+	// succeeded are kept. nil leaves retries to the EtherApi.
+	Backoff *types.BackoffConfig
+
+	// MulticallAddress, if set, sends the contract reads in aggregate3s to
+	// the Multicall3 at it; see NewMulticallBatcher.
+	MulticallAddress string
+}
+
 /*
 Batcher collects multiple read-only calls and sends them in a single HTTP
 round-trip while keeping the SDK's typed, decoded ergonomics.
@@ -66,6 +92,9 @@ namespaces, then call Send once and read each Result with Unwrap:
 		return err
 	}
 	n, err := bn.Unwrap()
+
+More calls than Config.MaxBatchSize are split into chunks of at most that
+many, sent concurrently.
 */
 type Batcher struct {
 	ether      types.EtherApi
@@ -73,6 +102,15 @@ type Batcher struct {
 	finalizers []func(rpc.BatchElem)
 	sent       bool
 
+	maxBatchSize int
+	concurrency  int
+	backoff      *types.BackoffConfig
+
+	// calls are the eth_calls of AddCall by elem, nil for the others; a
+	// multicall Batcher sends them in aggregate3s.
+	calls     []*call3
+	multicall *common.Address
+
 	Core       *CoreBatch
 	ERC20      *ERC20Batch
 	StableCoin *StableCoinBatch
@@ -83,7 +121,31 @@ type Batcher struct {
 // NewBatcher creates a Batcher bound to the given EtherApi (e.g.
 // alchemy.GetProvider().Eth()).
 func NewBatcher(ether types.EtherApi) *Batcher {
-	b := &Batcher{ether: ether}
+	return NewBatcherWithConfig(ether, Config{})
+}
+
+// NewBatcherWithConfig is NewBatcher with the chunking, concurrency and
+// retries of config.
+func NewBatcherWithConfig(ether types.EtherApi, config Config) *Batcher {
+	maxBatchSize := config.MaxBatchSize
+	if maxBatchSize <= 0 {
+		maxBatchSize = DefaultMaxBatchSize
+	}
+	concurrency := config.Concurrency
+	if concurrency <= 0 {
+		concurrency = DefaultConcurrency
+	}
+
+	b := &Batcher{
+		ether:        ether,
+		maxBatchSize: maxBatchSize,
+		concurrency:  concurrency,
+		backoff:      config.Backoff,
+	}
+	if config.MulticallAddress != "" {
+		address := common.HexToAddress(config.MulticallAddress)
+		b.multicall = &address
+	}
 	b.Core = &CoreBatch{b: b}
 	b.ERC20 = &ERC20Batch{b: b}
 	b.StableCoin = &StableCoinBatch{ERC20Batch: b.ERC20}
@@ -93,14 +155,24 @@ func NewBatcher(ether types.EtherApi) *Batcher {
 }
 
 /*
-Send dispatches every queued call in one HTTP round-trip and decodes each
-result into its Result.
+Send dispatches every queued call and decodes each result into its Result.
 
-The returned error is only set for I/O level failures (the whole batch failed);
-per-request RPC errors are surfaced through each Result.Unwrap. A batch can only
-be sent once; a second call returns constant.ErrBatchAlreadySent.
+The returned error is only set for I/O level failures (a whole chunk failed);
+per-request RPC errors are surfaced through each Result.Unwrap. The Results of
+a chunk that failed are settled with an error that is both
+constant.ErrBatchNotSent and the chunk's. A batch can only be sent once; a
+second call returns constant.ErrBatchAlreadySent.
+
+A Batcher of NewMulticallBatcher sends its contract reads in aggregate3
+eth_calls instead; see there.
 */
 func (b *Batcher) Send() error {
+	return b.SendContext(context.Background())
+}
+
+// SendContext is Send, which stops sending chunks once ctx is done; their
+// Results carry ctx.Err().
+func (b *Batcher) SendContext(ctx context.Context) error {
 	if b.sent {
 		return constant.ErrBatchAlreadySent
 	}
@@ -110,14 +182,11 @@ func (b *Batcher) Send() error {
 		return nil
 	}
 
-	if err := b.ether.BatchCall(b.elems); err != nil {
-		return err
-	}
-
+	err := b.dispatch(ctx)
 	for i, finalize := range b.finalizers {
 		finalize(b.elems[i])
 	}
-	return nil
+	return err
 }
 
 // addConv queues one call: geth unmarshals its raw result into target during
@@ -137,6 +206,7 @@ func addConv[D, T any](
 		Args:   args,
 		Result: target,
 	})
+	b.calls = append(b.calls, nil)
 	b.finalizers = append(b.finalizers, func(elem rpc.BatchElem) {
 		res.settled = true
 		if elem.Error != nil {
@@ -173,7 +243,15 @@ the latest block) and decodes the returned bytes with decode. The typed token
 sub-namespaces (ERC20, StableCoin) are built on it.
 
 signature is the function signature (e.g. []byte("balanceOf(address)")) and args
-are the already ABI-encoded 32-byte words (see encode.ABIAddress).
+are the already ABI-encoded 32-byte words (see encode.ABIAddress), or a whole
+encode.ABIPack. decode.ABIDecoder builds decode for any return layout:
+
+	type Slot0 struct {
+		SqrtPriceX96 *big.Int `abi:"uint160"`
+		Tick         int64    `abi:"int24"`
+		...
+	}
+	slot0 := batch.AddCall(b, pool, []byte("slot0()"), decode.ABIDecoder[Slot0](""))
 */
 func AddCall[T any](
 	b *Batcher,
@@ -189,8 +267,14 @@ func AddCall[T any](
 	}
 	target := new(hexutil.Bytes)
 
-	return addConv(b, constant.Eth_Call, []any{call, "latest"}, target,
+	res := addConv(b, constant.Eth_Call, []any{call, "latest"}, target,
 		func(t *hexutil.Bytes) (T, error) { return decode([]byte(*t)) })
+	b.calls[len(b.calls)-1] = &call3{
+		Target:       common.HexToAddress(contractAddress),
+		AllowFailure: true,
+		CallData:     calldata,
+	}
+	return res
 }
 
 // addStringCall validates a single contract address and queues an eth_call
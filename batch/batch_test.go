@@ -13,6 +13,7 @@ import (
 	"github.com/poteto-go/go-alchemy-sdk/alchemymock"
 	"github.com/poteto-go/go-alchemy-sdk/batch"
 	"github.com/poteto-go/go-alchemy-sdk/constant"
+	"github.com/poteto-go/go-alchemy-sdk/decode"
 	"github.com/poteto-go/go-alchemy-sdk/encode"
 	"github.com/poteto-go/go-alchemy-sdk/ether"
 	"github.com/poteto-go/go-alchemy-sdk/gas"
@@ -61,6 +62,8 @@ func newBatchEther() types.EtherApi {
 		nil,
 		0,
 		nil,
+		nil,
+		nil,
 	))
 }
 
@@ -174,6 +177,31 @@ func TestBatcher_Send(t *testing.T) {
 		assert.Equal(t, "10", bal.String())
 	})
 
+	t.Run("AddCall decodes a struct return with decode.ABIDecoder", func(t *testing.T) {
+		mock := alchemymock.NewAlchemyHttpMock(batchSetting, t)
+		defer mock.DeactivateAndReset()
+
+		type slot0 struct {
+			SqrtPriceX96 *big.Int `abi:"uint160"`
+			Tick         int64    `abi:"int24"`
+			Unlocked     bool
+		}
+		b := batch.NewBatcher(newBatchEther())
+		result := batch.AddCall(b, "0xpool", []byte("slot0()"), decode.ABIDecoder[slot0](""))
+
+		output, _ := encode.ABIPack("uint160,int24,bool", 1<<40, -5, true)
+		mock.RegisterBatchResponderOnce(
+			`[{"jsonrpc":"2.0","id":1,"result":"` + "0x" + hex.EncodeToString(output) + `"}]`,
+		)
+
+		err := b.Send()
+		assert.NoError(t, err)
+
+		s, err := result.Unwrap()
+		assert.NoError(t, err)
+		assert.Equal(t, slot0{SqrtPriceX96: big.NewInt(1 << 40), Tick: -5, Unlocked: true}, s)
+	})
+
 	t.Run("per-request RPC error surfaces only on that result", func(t *testing.T) {
 		mock := alchemymock.NewAlchemyHttpMock(batchSetting, t)
 		defer mock.DeactivateAndReset()
@@ -1,16 +1,20 @@
 package batch
 
 import (
+	"encoding/json"
 	"math/big"
 	"strings"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/poteto-go/go-alchemy-sdk/constant"
+	"github.com/poteto-go/go-alchemy-sdk/types"
+	"github.com/poteto-go/go-alchemy-sdk/validate"
 )
 
-// CoreBatch queues geth-backed scalar reads onto its Batcher. Mirrors the
-// read-only methods of the Core namespace.
+// CoreBatch queues geth-backed reads onto its Batcher. Mirrors the read-only
+// methods of the Core namespace.
 type CoreBatch struct {
 	b *Batcher
 }
@@ -51,6 +55,83 @@ func (c *CoreBatch) StorageAt(address, position, blockTag string) *Result[string
 	return Add(c.b, constant.Eth_GetStorageAt, []any{address, position, blockTag}, new(hexutil.Bytes), fromHexBytesRaw)
 }
 
+/*
+Queues eth_getBlockByNumber with full transactions for the given block number
+or tag. Uncles are only hashes in the response, so the Block has none; a block
+that does not exist fails with constant.ErrResultIsNil.
+*/
+func (c *CoreBatch) BlockByNumber(blockNumber string) *Result[*gethTypes.Block] {
+	if err := validate.BlockTag(blockNumber); err != nil {
+		return failed[*gethTypes.Block](err)
+	}
+	return addConv(c.b, constant.Eth_GetBlockByNumber, []any{blockNumber, true}, new(json.RawMessage), decodeBlock)
+}
+
+/* Queues eth_getBlockByHash with full transactions, as BlockByNumber. */
+func (c *CoreBatch) BlockByHash(blockHash string) *Result[*gethTypes.Block] {
+	if err := validate.Hash(blockHash); err != nil {
+		return failed[*gethTypes.Block](err)
+	}
+	return addConv(c.b, constant.Eth_GetBlockByHash, []any{blockHash, true}, new(json.RawMessage), decodeBlock)
+}
+
+/*
+Queues eth_getBlockByNumber without transactions for the given block number
+or tag, decoding only the header.
+*/
+func (c *CoreBatch) HeaderByNumber(blockNumber string) *Result[*gethTypes.Header] {
+	if err := validate.BlockTag(blockNumber); err != nil {
+		return failed[*gethTypes.Header](err)
+	}
+	return addConv(c.b, constant.Eth_GetBlockByNumber, []any{blockNumber, false}, new(*gethTypes.Header), notNil)
+}
+
+/* Queues eth_getBlockByHash without transactions, decoding only the header. */
+func (c *CoreBatch) HeaderByHash(blockHash string) *Result[*gethTypes.Header] {
+	if err := validate.Hash(blockHash); err != nil {
+		return failed[*gethTypes.Header](err)
+	}
+	return addConv(c.b, constant.Eth_GetBlockByHash, []any{blockHash, false}, new(*gethTypes.Header), notNil)
+}
+
+/* Queues eth_getTransactionByHash. */
+func (c *CoreBatch) Transaction(hash string) *Result[*gethTypes.Transaction] {
+	if err := validate.Hash(hash); err != nil {
+		return failed[*gethTypes.Transaction](err)
+	}
+	return addConv(c.b, constant.Eth_GetTransactionByHash, []any{hash}, new(*gethTypes.Transaction), notNil)
+}
+
+/* Queues eth_getTransactionReceipt. A pending transaction has none yet. */
+func (c *CoreBatch) TransactionReceipt(hash string) *Result[*gethTypes.Receipt] {
+	if err := validate.Hash(hash); err != nil {
+		return failed[*gethTypes.Receipt](err)
+	}
+	return addConv(c.b, constant.Eth_GetTransactionReceipt, []any{hash}, new(*gethTypes.Receipt), notNil)
+}
+
+/* Queues eth_getBlockReceipts for the given block number, tag or hash. */
+func (c *CoreBatch) BlockReceipts(blockNumberOrHash string) *Result[[]*gethTypes.Receipt] {
+	return addConv(c.b, constant.Eth_GetBlockReceipts, []any{blockNumberOrHash}, new([]*gethTypes.Receipt),
+		func(receipts *[]*gethTypes.Receipt) ([]*gethTypes.Receipt, error) {
+			// an unknown block is null, a block without transactions []
+			if *receipts == nil {
+				return nil, constant.ErrResultIsNil
+			}
+			return *receipts, nil
+		})
+}
+
+/* Queues eth_getLogs for the given filter. */
+func (c *CoreBatch) Logs(filter types.Filter) *Result[[]gethTypes.Log] {
+	return Add(c.b, constant.Eth_GetLogs, []any{filter}, new([]gethTypes.Log), func(logs *[]gethTypes.Log) []gethTypes.Log {
+		if *logs == nil {
+			return []gethTypes.Log{}
+		}
+		return *logs
+	})
+}
+
 // --- decoded-value converters ------------------------------------------------
 
 func fromHexUint64(v *hexutil.Uint64) uint64 { return uint64(*v) }
@@ -60,3 +141,38 @@ func fromHexBig(v *hexutil.Big) *big.Int { return (*big.Int)(v) }
 func fromHexBytesEncoded(v *hexutil.Bytes) string { return hexutil.Encode(*v) }
 
 func fromHexBytesRaw(v *hexutil.Bytes) string { return common.Bytes2Hex(*v) }
+
+// notNil returns the decoded value, or constant.ErrResultIsNil for a null
+// result (an unknown block, transaction or receipt).
+func notNil[T any](v **T) (*T, error) {
+	if *v == nil {
+		return nil, constant.ErrResultIsNil
+	}
+	return *v, nil
+}
+
+// rpcBlockBody is the body of a block of a JSON-RPC response, with full
+// transactions.
+type rpcBlockBody struct {
+	Transactions []*gethTypes.Transaction `json:"transactions"`
+	Withdrawals  []*gethTypes.Withdrawal  `json:"withdrawals"`
+}
+
+func decodeBlock(raw *json.RawMessage) (*gethTypes.Block, error) {
+	if string(*raw) == "null" {
+		return nil, constant.ErrResultIsNil
+	}
+
+	var header gethTypes.Header
+	if err := json.Unmarshal(*raw, &header); err != nil {
+		return nil, err
+	}
+	var body rpcBlockBody
+	if err := json.Unmarshal(*raw, &body); err != nil {
+		return nil, err
+	}
+	return gethTypes.NewBlockWithHeader(&header).WithBody(gethTypes.Body{
+		Transactions: body.Transactions,
+		Withdrawals:  body.Withdrawals,
+	}), nil
+}
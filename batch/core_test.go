@@ -1,10 +1,19 @@
 package batch_test
 
 import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/poteto-go/go-alchemy-sdk/alchemymock"
 	"github.com/poteto-go/go-alchemy-sdk/batch"
+	"github.com/poteto-go/go-alchemy-sdk/constant"
+	"github.com/poteto-go/go-alchemy-sdk/types"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -41,3 +50,189 @@ func TestCoreBatch_AllMethods(t *testing.T) {
 	assertUnwrap(t, code, "0xabcd")
 	assertUnwrap(t, storage, "00ff")
 }
+
+// rawResp builds a JSON-RPC batch response of JSON results, with ids 1..N.
+func rawResp(results ...string) string {
+	parts := make([]string, len(results))
+	for i, r := range results {
+		parts[i] = fmt.Sprintf(`{"jsonrpc":"2.0","id":%d,"result":%s}`, i+1, r)
+	}
+	return "[" + strings.Join(parts, ",") + "]"
+}
+
+func mustJSON(t *testing.T, v any) string {
+	t.Helper()
+	b, err := json.Marshal(v)
+	assert.NoError(t, err)
+	return string(b)
+}
+
+func TestCoreBatch_ChainData(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	tx, _ := gethTypes.SignNewTx(key, gethTypes.LatestSignerForChainID(big.NewInt(1)), &gethTypes.DynamicFeeTx{
+		ChainID:   big.NewInt(1),
+		Nonce:     7,
+		GasTipCap: big.NewInt(1),
+		GasFeeCap: big.NewInt(2),
+		Gas:       21000,
+		To:        &common.Address{0x1},
+		Value:     big.NewInt(3),
+	})
+	header := &gethTypes.Header{
+		ParentHash: common.Hash{0x1},
+		Number:     big.NewInt(16),
+		Difficulty: big.NewInt(0),
+		GasLimit:   30_000_000,
+		Time:       1_700_000_000,
+	}
+	log := &gethTypes.Log{
+		Address:     common.HexToAddress(contractAddr),
+		Topics:      []common.Hash{{0x2}},
+		Data:        []byte{0x3},
+		BlockNumber: 16,
+		TxHash:      tx.Hash(),
+	}
+	receipt := &gethTypes.Receipt{
+		Type:              gethTypes.DynamicFeeTxType,
+		Status:            gethTypes.ReceiptStatusSuccessful,
+		CumulativeGasUsed: 21000,
+		Logs:              []*gethTypes.Log{log},
+		TxHash:            tx.Hash(),
+		GasUsed:           21000,
+		BlockNumber:       big.NewInt(16),
+	}
+
+	var blockJSON map[string]any
+	assert.NoError(t, json.Unmarshal([]byte(mustJSON(t, header)), &blockJSON))
+	blockJSON["transactions"] = []*gethTypes.Transaction{tx}
+	blockJSON["uncles"] = []string{}
+
+	t.Run("blocks, headers, transactions, receipts & logs are decoded to geth types", func(t *testing.T) {
+		// Arrange
+		mock := alchemymock.NewAlchemyHttpMock(batchSetting, t)
+		defer mock.DeactivateAndReset()
+		b := batch.NewBatcher(newBatchEther())
+		block := b.Core.BlockByNumber("0x10")
+		blockByHash := b.Core.BlockByHash(header.Hash().Hex())
+		head := b.Core.HeaderByNumber("latest")
+		transaction := b.Core.Transaction(tx.Hash().Hex())
+		txReceipt := b.Core.TransactionReceipt(tx.Hash().Hex())
+		blockReceipts := b.Core.BlockReceipts("0x10")
+		logs := b.Core.Logs(types.Filter{Address: contractAddr, FromBlock: "0x10", ToBlock: "0x10"})
+		mock.RegisterBatchResponderOnce(rawResp(
+			mustJSON(t, blockJSON),
+			mustJSON(t, blockJSON),
+			mustJSON(t, header),
+			mustJSON(t, tx),
+			mustJSON(t, receipt),
+			mustJSON(t, []*gethTypes.Receipt{receipt}),
+			mustJSON(t, []*gethTypes.Log{log}),
+		))
+
+		// Act
+		err := b.Send()
+
+		// Assert
+		assert.NoError(t, err)
+		gotBlock, err := block.Unwrap()
+		assert.NoError(t, err)
+		assert.Equal(t, header.Hash(), gotBlock.Hash())
+		assert.Equal(t, tx.Hash(), gotBlock.Transactions()[0].Hash())
+		gotBlockByHash, err := blockByHash.Unwrap()
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(16), gotBlockByHash.NumberU64())
+		gotHead, err := head.Unwrap()
+		assert.NoError(t, err)
+		assert.Equal(t, header.Hash(), gotHead.Hash())
+		gotTx, err := transaction.Unwrap()
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(7), gotTx.Nonce())
+		gotReceipt, err := txReceipt.Unwrap()
+		assert.NoError(t, err)
+		assert.Equal(t, tx.Hash(), gotReceipt.TxHash)
+		assert.Equal(t, common.Hash{0x2}, gotReceipt.Logs[0].Topics[0])
+		gotReceipts, err := blockReceipts.Unwrap()
+		assert.NoError(t, err)
+		assert.Len(t, gotReceipts, 1)
+		gotLogs, err := logs.Unwrap()
+		assert.NoError(t, err)
+		assert.Equal(t, []byte{0x3}, gotLogs[0].Data)
+	})
+
+	t.Run("a null result fails with ErrResultIsNil", func(t *testing.T) {
+		// Arrange
+		mock := alchemymock.NewAlchemyHttpMock(batchSetting, t)
+		defer mock.DeactivateAndReset()
+		b := batch.NewBatcher(newBatchEther())
+		block := b.Core.BlockByHash(common.Hash{0x9}.Hex())
+		head := b.Core.HeaderByHash(common.Hash{0x9}.Hex())
+		transaction := b.Core.Transaction(common.Hash{0x9}.Hex())
+		txReceipt := b.Core.TransactionReceipt(common.Hash{0x9}.Hex())
+		blockReceipts := b.Core.BlockReceipts(common.Hash{0x9}.Hex())
+		logs := b.Core.Logs(types.Filter{BlockHash: common.Hash{0x9}.Hex()})
+		mock.RegisterBatchResponderOnce(rawResp("null", "null", "null", "null", "null", "[]"))
+
+		// Act
+		err := b.Send()
+
+		// Assert
+		assert.NoError(t, err)
+		_, err = block.Unwrap()
+		assert.ErrorIs(t, err, constant.ErrResultIsNil)
+		_, err = head.Unwrap()
+		assert.ErrorIs(t, err, constant.ErrResultIsNil)
+		_, err = transaction.Unwrap()
+		assert.ErrorIs(t, err, constant.ErrResultIsNil)
+		_, err = txReceipt.Unwrap()
+		assert.ErrorIs(t, err, constant.ErrResultIsNil)
+		_, err = blockReceipts.Unwrap()
+		assert.ErrorIs(t, err, constant.ErrResultIsNil)
+		gotLogs, err := logs.Unwrap()
+		assert.NoError(t, err)
+		assert.Equal(t, []gethTypes.Log{}, gotLogs)
+	})
+
+	t.Run("if invalid hash, fail only that entry without queueing", func(t *testing.T) {
+		// Arrange
+		mock := alchemymock.NewAlchemyHttpMock(batchSetting, t)
+		defer mock.DeactivateAndReset()
+		b := batch.NewBatcher(newBatchEther())
+		block := b.Core.BlockByHash("0xabc")
+		head := b.Core.HeaderByHash("")
+		transaction := b.Core.Transaction("hoge")
+		blockNumber := b.Core.BlockNumber()
+		txReceipt := b.Core.TransactionReceipt(common.Address{0x1}.Hex())
+		mock.RegisterBatchResultsOnce(`"0x10"`)
+
+		// Act
+		err := b.Send()
+
+		// Assert
+		assert.NoError(t, err)
+		_, err = block.Unwrap()
+		assert.ErrorIs(t, err, constant.ErrInvalidHash)
+		_, err = head.Unwrap()
+		assert.ErrorIs(t, err, constant.ErrInvalidHash)
+		_, err = transaction.Unwrap()
+		assert.ErrorIs(t, err, constant.ErrInvalidHash)
+		_, err = txReceipt.Unwrap()
+		assert.ErrorIs(t, err, constant.ErrInvalidHash)
+		assertUnwrap(t, blockNumber, uint64(16))
+	})
+
+	t.Run("if invalid block tag, fail without queueing", func(t *testing.T) {
+		// Arrange
+		b := batch.NewBatcher(newBatchEther())
+
+		// Act
+		block := b.Core.BlockByNumber("hoge")
+		head := b.Core.HeaderByNumber("")
+
+		// Assert
+		_, err := block.Unwrap()
+		assert.ErrorIs(t, err, constant.ErrInvalidBlockTag)
+		_, err = head.Unwrap()
+		assert.ErrorIs(t, err, constant.ErrInvalidBlockTag)
+		assert.NoError(t, b.Send())
+	})
+}
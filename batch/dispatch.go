@@ -0,0 +1,127 @@
+package batch
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/poteto-go/go-alchemy-sdk/constant"
+	"github.com/poteto-go/go-alchemy-sdk/internal"
+)
+
+// chunk is the elems at indexes, sent as one JSON-RPC batch or, if
+// aggregate, as one aggregate3.
+type chunk struct {
+	indexes   []int
+	aggregate bool
+}
+
+func (b *Batcher) chunks() []chunk {
+	var batched, aggregated []int
+	for i, call := range b.calls {
+		if b.multicall != nil && call != nil {
+			aggregated = append(aggregated, i)
+		} else {
+			batched = append(batched, i)
+		}
+	}
+
+	var chunks []chunk
+	for indexes := range slices.Chunk(batched, b.maxBatchSize) {
+		chunks = append(chunks, chunk{indexes: indexes})
+	}
+	for indexes := range slices.Chunk(aggregated, b.maxBatchSize) {
+		chunks = append(chunks, chunk{indexes: indexes, aggregate: true})
+	}
+	return chunks
+}
+
+/*
+dispatch sends the chunks, at most b.concurrency at once, and fails the elems
+of a chunk that still failed after b.backoff. It returns the error of the
+first chunk that failed.
+*/
+func (b *Batcher) dispatch(ctx context.Context) error {
+	chunks := b.chunks()
+	errs := make([]error, len(chunks))
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, b.concurrency)
+	for i, c := range chunks {
+		if err := ctx.Err(); err != nil {
+			errs[i] = err
+			continue
+		}
+		select {
+		case <-ctx.Done():
+			errs[i] = ctx.Err()
+			continue
+		case sem <- struct{}{}:
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			errs[i] = b.sendChunk(ctx, c)
+		}()
+	}
+	wg.Wait()
+
+	var firstErr error
+	for i, c := range chunks {
+		if errs[i] == nil {
+			continue
+		}
+		if firstErr == nil {
+			firstErr = errs[i]
+		}
+		for _, index := range c.indexes {
+			b.elems[index].Error = errors.Join(constant.ErrBatchNotSent, errs[i])
+		}
+	}
+	return firstErr
+}
+
+func (b *Batcher) sendChunk(ctx context.Context, c chunk) error {
+	send := b.batchCall
+	if c.aggregate {
+		send = b.aggregateCall
+	}
+	if b.backoff == nil {
+		return send(ctx, c.indexes)
+	}
+
+	backoff := internal.NewBackoffManager(*b.backoff)
+	for {
+		err := send(ctx, c.indexes)
+		if err == nil || ctx.Err() != nil {
+			return err
+		}
+
+		if backoffErr := backoff.BackoffContext(ctx); backoffErr != nil {
+			if errors.Is(backoffErr, constant.ErrOverMaxRetries) {
+				return err
+			}
+			return backoffErr
+		}
+	}
+}
+
+// batchCall sends the elems at indexes in one JSON-RPC batch. It works on a
+// copy, so a failed attempt leaves them as they were.
+func (b *Batcher) batchCall(ctx context.Context, indexes []int) error {
+	elems := make([]rpc.BatchElem, len(indexes))
+	for j, i := range indexes {
+		elems[j] = b.elems[i]
+	}
+	if err := b.ether.BatchCallContext(ctx, elems); err != nil {
+		return err
+	}
+	for j, i := range indexes {
+		b.elems[i] = elems[j]
+	}
+	return nil
+}
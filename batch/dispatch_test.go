@@ -0,0 +1,154 @@
+package batch_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/poteto-go/go-alchemy-sdk/batch"
+	"github.com/poteto-go/go-alchemy-sdk/constant"
+	"github.com/poteto-go/go-alchemy-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// batchNode answers eth_getBalance batches with the address as the balance,
+// recording the size of every batch and how many were in flight at once.
+type batchNode struct {
+	types.EtherApi
+
+	mu          sync.Mutex
+	sizes       []int
+	inFlight    int
+	maxInFlight int
+
+	// failures is how many times the batch starting at an address fails.
+	failures map[string]int
+}
+
+func (n *batchNode) BatchCallContext(_ context.Context, elems []rpc.BatchElem) error {
+	n.mu.Lock()
+	n.sizes = append(n.sizes, len(elems))
+	n.inFlight++
+	n.maxInFlight = max(n.maxInFlight, n.inFlight)
+	first := elems[0].Args[0].(string)
+	fail := n.failures[first] > 0
+	if fail {
+		n.failures[first]--
+	}
+	n.mu.Unlock()
+	defer func() {
+		n.mu.Lock()
+		n.inFlight--
+		n.mu.Unlock()
+	}()
+
+	time.Sleep(5 * time.Millisecond)
+	if fail {
+		return errors.New("connection reset")
+	}
+	for i := range elems {
+		balance := common.HexToAddress(elems[i].Args[0].(string)).Big()
+		*elems[i].Result.(*hexutil.Big) = hexutil.Big(*balance)
+	}
+	return nil
+}
+
+func balanceAddr(i int) string {
+	return fmt.Sprintf("0x%040x", i)
+}
+
+func queueBalances(b *batch.Batcher, n int) []*batch.Result[*big.Int] {
+	results := make([]*batch.Result[*big.Int], n)
+	for i := range n {
+		results[i] = b.Core.Balance(balanceAddr(i), "latest")
+	}
+	return results
+}
+
+var chunkRetry = &types.BackoffConfig{MaxRetries: 1, InitialDelayMs: 1, MaxDelayMs: 1}
+
+func TestBatcher_SendChunks(t *testing.T) {
+	t.Run("splits into chunks of MaxBatchSize, sent at most Concurrency at once", func(t *testing.T) {
+		// Arrange
+		node := &batchNode{}
+		b := batch.NewBatcherWithConfig(node, batch.Config{MaxBatchSize: 3, Concurrency: 2})
+		results := queueBalances(b, 10)
+
+		// Act
+		err := b.Send()
+
+		// Assert
+		assert.NoError(t, err)
+		slices.Sort(node.sizes)
+		assert.Equal(t, []int{1, 3, 3, 3}, node.sizes)
+		assert.LessOrEqual(t, node.maxInFlight, 2)
+		for i, result := range results {
+			assertUnwrapStr(t, result, fmt.Sprint(i))
+		}
+	})
+
+	t.Run("retries only the chunk that failed", func(t *testing.T) {
+		// Arrange
+		node := &batchNode{failures: map[string]int{balanceAddr(3): 1}}
+		b := batch.NewBatcherWithConfig(node, batch.Config{MaxBatchSize: 3, Backoff: chunkRetry})
+		results := queueBalances(b, 10)
+
+		// Act
+		err := b.Send()
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Len(t, node.sizes, 5)
+		for i, result := range results {
+			assertUnwrapStr(t, result, fmt.Sprint(i))
+		}
+	})
+
+	t.Run("if a chunk still fails, return its error & settle its results with it", func(t *testing.T) {
+		// Arrange
+		node := &batchNode{failures: map[string]int{balanceAddr(3): 2}}
+		b := batch.NewBatcherWithConfig(node, batch.Config{MaxBatchSize: 3, Backoff: chunkRetry})
+		results := queueBalances(b, 10)
+
+		// Act
+		err := b.Send()
+
+		// Assert
+		assert.EqualError(t, err, "connection reset")
+		for i, result := range results {
+			if i < 3 || i >= 6 {
+				assertUnwrapStr(t, result, fmt.Sprint(i))
+				continue
+			}
+			_, unwrapErr := result.Unwrap()
+			assert.ErrorIs(t, unwrapErr, constant.ErrBatchNotSent)
+			assert.ErrorContains(t, unwrapErr, "connection reset")
+		}
+	})
+
+	t.Run("if ctx is done, send nothing & settle the results with ctx.Err()", func(t *testing.T) {
+		// Arrange
+		node := &batchNode{}
+		b := batch.NewBatcherWithConfig(node, batch.Config{MaxBatchSize: 3})
+		results := queueBalances(b, 4)
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		// Act
+		err := b.SendContext(ctx)
+
+		// Assert
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Empty(t, node.sizes)
+		_, unwrapErr := results[0].Unwrap()
+		assert.ErrorIs(t, unwrapErr, context.Canceled)
+	})
+}
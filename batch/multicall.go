@@ -0,0 +1,202 @@
+package batch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/v2"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/poteto-go/go-alchemy-sdk/constant"
+	"github.com/poteto-go/go-alchemy-sdk/decode"
+	"github.com/poteto-go/go-alchemy-sdk/encode"
+	"github.com/poteto-go/go-alchemy-sdk/types"
+)
+
+// call3 is a Call3 of aggregate3. A Batcher always allows failure, so a read
+// that reverts only fails its own Result.
+type call3 struct {
+	Target       common.Address
+	AllowFailure bool
+	CallData     []byte
+}
+
+// call3Result is a Result of aggregate3.
+type call3Result struct {
+	Success    bool
+	ReturnData []byte
+}
+
+/*
+multicall3MetaData deploys a contract that implements aggregate3 of
+Multicall3 (https://github.com/mds1/multicall, src/Multicall3.sol), and only
+it. The ABI is the aggregate3 entry of that contract. The bytecode is
+hand-assembled, not the creation code of the mds1/multicall deployment,
+without PUSH0 so it runs on pre-Shanghai dev chains too; a failed call that
+does not allow failure reverts with no data.
+*/
+var multicall3MetaData = &bind.MetaData{
+	ID:  "Multicall3",
+	ABI: `[{"type":"function","name":"aggregate3","stateMutability":"payable","inputs":[{"name":"calls","type":"tuple[]","components":[{"name":"target","type":"address"},{"name":"allowFailure","type":"bool"},{"name":"callData","type":"bytes"}]}],"outputs":[{"name":"returnData","type":"tuple[]","components":[{"name":"success","type":"bool"},{"name":"returnData","type":"bytes"}]}]}]`,
+	Bin: "0x6100e88061000d6000396000f3" +
+		"60003560e01c6382ad56cb14156100e25760043560040180358060205260a0526020016040526020608052602051" +
+		"60051b60c0016060525b602051600051146100d8576040518060005160051b01350180604001358101803580826020" +
+		"01606051376000600082606051600087355af180846020013517156100e25760c06060510360005160051b60c00152" +
+		"606051526040606051602001523d80606051604001528060006060516060013e600081606051016060015260" +
+		"1f01601f191660605101606001606052505050600051600101600052610037565b6080606051036080f35b60006000fd",
+}
+
+/*
+NewMulticallBatcher creates a Batcher that packs every contract read - AddCall
+and the token sub-namespaces built on it - into eth_calls to aggregate3 of the
+Multicall3 at multicallAddress (constant.Multicall3Address if empty), one per
+Config.MaxBatchSize reads.
+It works where JSON-RPC batching does not: on a simulated backend, or past the
+batch size limit of a provider. Config.MulticallAddress of NewBatcherWithConfig
+does the same with the other settings of a Config.
+
+Each read is sent with allowFailure, so one that reverts fails only its own
+Result, with a *types.RevertError; the others decode as usual. Requests that
+are not eth_calls (e.g. Core.BlockNumber) still go through BatchCall. Send
+returns constant.ErrMulticallNotDeployed if multicallAddress has no code; on a
+simulated or dev chain, EnsureMulticall3 deploys one.
+*/
+func NewMulticallBatcher(ether types.EtherApi, multicallAddress string) *Batcher {
+	if multicallAddress == "" {
+		multicallAddress = constant.Multicall3Address
+	}
+	return NewBatcherWithConfig(ether, Config{MulticallAddress: multicallAddress})
+}
+
+// Multicall3Config configures EnsureMulticall3WithConfig.
+type Multicall3Config struct {
+	// AllowAnyChain deploys on a chain that is not in constant.DevChainIDs.
+	AllowAnyChain bool
+}
+
+// deployedMulticall3 caches the address EnsureMulticall3 deployed at, by
+// chain ID, so a second call does not deploy again.
+var (
+	deployedMulticall3   = map[string]string{}
+	deployedMulticall3Mu sync.Mutex
+)
+
+/*
+EnsureMulticall3 returns constant.Multicall3Address if the chain of ether has
+Multicall3 there, or else deploys one with auth, waits for it with
+WaitDeployed (which commits a simulated backend) and returns its address.
+
+It is meant for a simulated or dev chain: the deployed contract implements
+aggregate3 only, which is all NewMulticallBatcher needs. It returns
+constant.ErrNotDevChain instead of deploying on a chain that is not in
+constant.DevChainIDs; EnsureMulticall3WithConfig can opt in to any chain.
+The deployed address is cached per chain ID, and deployed again only once
+it has no code (e.g. a dev chain that was reset).
+*/
+func EnsureMulticall3(ctx context.Context, ether types.EtherApi, auth *bind.TransactOpts) (string, error) {
+	return EnsureMulticall3WithConfig(ctx, ether, auth, Multicall3Config{})
+}
+
+// EnsureMulticall3WithConfig is EnsureMulticall3 with the settings of config.
+func EnsureMulticall3WithConfig(ctx context.Context, ether types.EtherApi, auth *bind.TransactOpts, config Multicall3Config) (string, error) {
+	canonical, err := hasCode(ctx, ether, constant.Multicall3Address)
+	if err != nil {
+		return "", err
+	}
+	if canonical {
+		return constant.Multicall3Address, nil
+	}
+
+	chainID, err := ether.ChainIDContext(ctx)
+	if err != nil {
+		return "", err
+	}
+	if !config.AllowAnyChain && !slices.Contains(constant.DevChainIDs, chainID.Int64()) {
+		return "", fmt.Errorf("%w: %s", constant.ErrNotDevChain, chainID)
+	}
+
+	deployedMulticall3Mu.Lock()
+	defer deployedMulticall3Mu.Unlock()
+	if address, ok := deployedMulticall3[chainID.String()]; ok {
+		deployed, err := hasCode(ctx, ether, address)
+		if err != nil {
+			return "", err
+		}
+		if deployed {
+			return address, nil
+		}
+	}
+
+	deployed, err := ether.DeployContract(auth, multicall3MetaData)
+	if err != nil {
+		return "", err
+	}
+	address, err := ether.WaitDeployed(ctx, deployed.Txs[multicall3MetaData.ID].Hash())
+	if err != nil {
+		return "", err
+	}
+	deployedMulticall3[chainID.String()] = address.Hex()
+	return address.Hex(), nil
+}
+
+func hasCode(ctx context.Context, ether types.EtherApi, address string) (bool, error) {
+	code, err := ether.CodeAtContext(ctx, address, "latest")
+	if err != nil {
+		return false, err
+	}
+	return code != "0x", nil
+}
+
+// aggregateCall sends the eth_calls of AddCall at indexes in one aggregate3,
+// filling their elems in as BatchCall would.
+func (b *Batcher) aggregateCall(ctx context.Context, indexes []int) error {
+	calls := make([]call3, len(indexes))
+	for j, i := range indexes {
+		calls[j] = *b.calls[i]
+	}
+
+	results, err := b.aggregate3(ctx, calls)
+	if err != nil {
+		return err
+	}
+	for j, i := range indexes {
+		if !results[j].Success {
+			b.elems[i].Error = decode.Revert(results[j].ReturnData)
+			continue
+		}
+		*b.elems[i].Result.(*hexutil.Bytes) = results[j].ReturnData
+	}
+	return nil
+}
+
+func (b *Batcher) aggregate3(ctx context.Context, calls []call3) ([]call3Result, error) {
+	data, err := encode.Calldata(string(constant.Aggregate3FnSignature), calls)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := b.ether.CallContractContext(ctx, ethereum.CallMsg{To: b.multicall, Data: data}, "latest")
+	if err != nil {
+		return nil, err
+	}
+	// a call to an account without code succeeds with no output
+	if len(output) == 0 {
+		return nil, errors.Join(constant.ErrMulticallNotDeployed, fmt.Errorf("no code at %s", b.multicall.Hex()))
+	}
+
+	var results []call3Result
+	if err := decode.ABIUnpackInto("(bool,bytes)[]", output, &results); err != nil {
+		return nil, err
+	}
+	if len(results) != len(calls) {
+		return nil, errors.Join(
+			constant.ErrUnexpectedResponseType,
+			fmt.Errorf("%d results for %d calls", len(results), len(calls)),
+		)
+	}
+	return results, nil
+}
@@ -0,0 +1,180 @@
+package batch_test
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/v2"
+	"github.com/ethereum/go-ethereum/common"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	gethSimulated "github.com/ethereum/go-ethereum/ethclient/simulated"
+	"github.com/poteto-go/go-alchemy-sdk/_fixture/artifacts"
+	"github.com/poteto-go/go-alchemy-sdk/alchemymock"
+	"github.com/poteto-go/go-alchemy-sdk/batch"
+	"github.com/poteto-go/go-alchemy-sdk/constant"
+	"github.com/poteto-go/go-alchemy-sdk/decode"
+	"github.com/poteto-go/go-alchemy-sdk/encode"
+	"github.com/poteto-go/go-alchemy-sdk/ether/simulated"
+	"github.com/poteto-go/go-alchemy-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// aggregate3Result builds the eth_call response of aggregate3.
+func aggregate3Result(t *testing.T, results ...[]any) string {
+	t.Helper()
+	packed, err := encode.ABIPack("(bool,bytes)[]", results)
+	assert.NoError(t, err)
+	return `{"jsonrpc":"2.0","id":1,"result":"` + "0x" + common.Bytes2Hex(packed) + `"}`
+}
+
+func TestMulticallBatcher_Send(t *testing.T) {
+	t.Run("packs the contract reads into one eth_call & the others into a batch", func(t *testing.T) {
+		// Arrange
+		mock := alchemymock.NewAlchemyHttpMock(batchSetting, t)
+		defer mock.DeactivateAndReset()
+		b := batch.NewMulticallBatcher(newBatchEther(), "")
+		symbol := b.ERC20.Symbol(contractAddr)
+		blockNumber := b.Core.BlockNumber()
+		balance := b.ERC20.BalanceOf(contractAddr, walletAddr)
+		// the batch & the eth_call go at once, so the batch's id is not known
+		mock.RegisterBatchResultsOnce(`"0x10"`)
+		mock.RegisterResponderOnce("eth_call", aggregate3Result(t,
+			[]any{true, encode.ABIString("MTK")},
+			[]any{true, encode.ABIUint256(big.NewInt(42))},
+		))
+
+		// Act
+		err := b.Send()
+
+		// Assert
+		assert.NoError(t, err)
+		assertUnwrap(t, symbol, "MTK")
+		assertUnwrap(t, blockNumber, uint64(16))
+		assertUnwrapStr(t, balance, "42")
+	})
+
+	t.Run("a failed read fails its own Result with a RevertError", func(t *testing.T) {
+		// Arrange
+		mock := alchemymock.NewAlchemyHttpMock(batchSetting, t)
+		defer mock.DeactivateAndReset()
+		b := batch.NewMulticallBatcher(newBatchEther(), "")
+		name := b.ERC20.Name(contractAddr)
+		decimals := b.ERC20.Decimals(contractAddr)
+		mock.RegisterResponderOnce("eth_call", aggregate3Result(t,
+			[]any{false, encode.ReadCalldata([]byte("Error(string)"), encode.ABIString("no name"))},
+			[]any{true, encode.ABIUint256(big.NewInt(6))},
+		))
+
+		// Act
+		err := b.Send()
+
+		// Assert
+		assert.NoError(t, err)
+		_, nameErr := name.Unwrap()
+		revertErr, ok := errors.AsType[*types.RevertError](nameErr)
+		assert.True(t, ok)
+		assert.Equal(t, "no name", revertErr.Reason)
+		assertUnwrap(t, decimals, uint8(6))
+	})
+
+	t.Run("if no code at the multicall address, return ErrMulticallNotDeployed", func(t *testing.T) {
+		// Arrange
+		mock := alchemymock.NewAlchemyHttpMock(batchSetting, t)
+		defer mock.DeactivateAndReset()
+		b := batch.NewMulticallBatcher(newBatchEther(), "")
+		symbol := b.ERC20.Symbol(contractAddr)
+		mock.RegisterResponderOnce("eth_call", `{"jsonrpc":"2.0","id":1,"result":"0x"}`)
+
+		// Act
+		err := b.Send()
+
+		// Assert
+		assert.ErrorIs(t, err, constant.ErrMulticallNotDeployed)
+		_, unwrapErr := symbol.Unwrap()
+		assert.ErrorIs(t, unwrapErr, constant.ErrBatchNotSent)
+	})
+
+	t.Run("if the results do not match the calls, return ErrUnexpectedResponseType", func(t *testing.T) {
+		// Arrange
+		mock := alchemymock.NewAlchemyHttpMock(batchSetting, t)
+		defer mock.DeactivateAndReset()
+		b := batch.NewMulticallBatcher(newBatchEther(), "")
+		b.ERC20.Symbol(contractAddr)
+		b.ERC20.Name(contractAddr)
+		mock.RegisterResponderOnce("eth_call", aggregate3Result(t, []any{true, encode.ABIString("MTK")}))
+
+		// Act
+		err := b.Send()
+
+		// Assert
+		assert.ErrorIs(t, err, constant.ErrUnexpectedResponseType)
+	})
+}
+
+func TestEnsureMulticall3(t *testing.T) {
+	t.Run("if the chain has Multicall3, return its address", func(t *testing.T) {
+		// Arrange
+		mock := alchemymock.NewAlchemyHttpMock(batchSetting, t)
+		defer mock.DeactivateAndReset()
+		mock.RegisterResponderOnce("eth_getCode", `{"jsonrpc":"2.0","id":1,"result":"0x6080"}`)
+
+		// Act
+		address, err := batch.EnsureMulticall3(context.Background(), newBatchEther(), nil)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, constant.Multicall3Address, address)
+	})
+
+	t.Run("if the chain is not a dev chain, return ErrNotDevChain", func(t *testing.T) {
+		// Arrange
+		mock := alchemymock.NewAlchemyHttpMock(batchSetting, t)
+		defer mock.DeactivateAndReset()
+		mock.RegisterResponderOnce("eth_getCode", `{"jsonrpc":"2.0","id":1,"result":"0x"}`)
+		mock.RegisterResponderOnce("eth_chainId", `{"jsonrpc":"2.0","id":1,"result":"0x1"}`)
+
+		// Act
+		_, err := batch.EnsureMulticall3(context.Background(), newBatchEther(), nil)
+
+		// Assert
+		assert.ErrorIs(t, err, constant.ErrNotDevChain)
+	})
+
+	t.Run("deploys one on a simulated backend, which aggregates the reads", func(t *testing.T) {
+		// Arrange
+		key, _ := crypto.GenerateKey()
+		from := crypto.PubkeyToAddress(key.PublicKey)
+		backend := gethSimulated.NewBackend(gethTypes.GenesisAlloc{
+			from: {Balance: big.NewInt(1_000_000_000_000_000_000)},
+		})
+		defer backend.Close()
+		eth := simulated.NewSimulatedApi(backend)
+		auth := bind.NewKeyedTransactor(key, big.NewInt(1337))
+
+		deployed, err := eth.DeployContract(auth, &artifacts.PotetoStorageMetaData)
+		assert.NoError(t, err)
+		storage, err := eth.WaitDeployed(context.Background(), deployed.Txs[artifacts.PotetoStorageMetaData.ID].Hash())
+		assert.NoError(t, err)
+
+		// Act
+		address, err := batch.EnsureMulticall3(context.Background(), eth, auth)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.NotEqual(t, constant.Multicall3Address, address)
+		cached, err := batch.EnsureMulticall3(context.Background(), eth, auth)
+		assert.NoError(t, err)
+		assert.Equal(t, address, cached)
+
+		b := batch.NewMulticallBatcher(eth, address)
+		stored := batch.AddCall(b, storage.Hex(), []byte("retrieve()"), decode.Uint256)
+		symbol := b.ERC20.Symbol(storage.Hex())
+		assert.NoError(t, b.Send())
+		assertUnwrapStr(t, stored, "0")
+		_, symbolErr := symbol.Unwrap()
+		assert.IsType(t, &types.RevertError{}, symbolErr)
+	})
+}
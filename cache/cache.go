@@ -0,0 +1,246 @@
+/*
+Package cache keeps JSON-RPC results that can be served again without
+asking the node.
+
+Enable it with gas.AlchemySetting.Cache. It caches:
+
+  - forever: eth_chainId, eth_getBlockByHash, mined eth_getTransactionReceipt
+    and the ERC-20 decimals(), name() and symbol() reads of eth_call
+  - by block: eth_call, eth_getBlockByNumber, eth_getBalance, eth_getCode and
+    eth_getStorageAt pinned to a block number or hash are kept as long as the
+    store holds them
+  - for Config.LatestTTL: the same calls at latest, safe or finalized
+
+pending calls, errors and nil or empty results are never cached.
+*/
+package cache
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/poteto-go/go-alchemy-sdk/constant"
+	"github.com/poteto-go/go-alchemy-sdk/types"
+	"github.com/poteto-go/go-alchemy-sdk/utils"
+)
+
+const DefaultLatestTTL = 2 * time.Second
+
+type Config struct {
+	// Store holds the results; nil uses NewLRUStore(DefaultLRUSize).
+	Store Store
+
+	// LatestTTL is how long latest, safe and finalized reads are kept.
+	// 0 uses DefaultLatestTTL, a negative value does not cache them.
+	LatestTTL time.Duration
+}
+
+type Cache struct {
+	store     Store
+	latestTTL time.Duration
+}
+
+func New(config Config) *Cache {
+	store := config.Store
+	if store == nil {
+		store = NewLRUStore(DefaultLRUSize)
+	}
+	latestTTL := config.LatestTTL
+	if latestTTL == 0 {
+		latestTTL = DefaultLatestTTL
+	}
+	return &Cache{
+		store:     store,
+		latestTTL: latestTTL,
+	}
+}
+
+/*
+Middleware returns the types.Middleware that serves cached results. gas
+wires it in front of AlchemySetting.Middlewares, so these only see the calls
+that reach the node.
+
+A cached *big.Int or []byte is copied for every caller; other results
+(blocks, receipts) are shared and must not be modified.
+*/
+func (c *Cache) Middleware() types.Middleware {
+	return func(next types.RpcHandler) types.RpcHandler {
+		return func(ctx context.Context, req types.RpcRequest) (any, error) {
+			key, ttl, ok := c.policy(req)
+			if !ok {
+				return next(ctx, req)
+			}
+
+			if res, ok := c.store.Get(key); ok {
+				return clone(res), nil
+			}
+
+			res, err := next(ctx, req)
+			if err != nil || isEmpty(res) {
+				return res, err
+			}
+			c.store.Set(key, clone(res), ttl)
+			return res, nil
+		}
+	}
+}
+
+// immutableReads are the eth_call selectors whose result never changes.
+var immutableReads = [][]byte{
+	crypto.Keccak256(constant.DecimalsFnSignature)[:4],
+	crypto.Keccak256(constant.NameFnSignature)[:4],
+	crypto.Keccak256(constant.SymbolFnSignature)[:4],
+}
+
+// blockParamAt is the index of the block param of the block-scoped methods.
+var blockParamAt = map[string]int{
+	constant.Eth_GetBlockByNumber: 0,
+	constant.Eth_GetBalance:       1,
+	constant.Eth_GetCode:          1,
+	constant.Eth_GetStorageAt:     2,
+	constant.Eth_Call:             1,
+}
+
+// policy returns the key and ttl req is cached under; ttl 0 never expires.
+func (c *Cache) policy(req types.RpcRequest) (string, time.Duration, bool) {
+	params, ttl, ok := c.scope(req)
+	if !ok {
+		return "", 0, false
+	}
+	key, ok := keyOf(req.Method, params)
+	return key, ttl, ok
+}
+
+// scope returns the params that identify req's result and how long it lasts.
+func (c *Cache) scope(req types.RpcRequest) (types.RequestArgs, time.Duration, bool) {
+	switch req.Method {
+	case constant.Eth_ChainId, constant.Eth_GetBlockByHash, constant.Eth_GetTransactionReceipt:
+		return req.Params, 0, true
+	case constant.Eth_Call:
+		if len(req.Params) > 0 && isImmutableRead(req.Params[0]) {
+			// any block answers the same, leave it out of the key
+			return req.Params[:1], 0, true
+		}
+	}
+
+	i, ok := blockParamAt[req.Method]
+	if !ok || i >= len(req.Params) {
+		return nil, 0, false
+	}
+	ttl, ok := c.blockTTL(req.Params[i])
+	return req.Params, ttl, ok
+}
+
+// blockTTL maps a block param, in its geth (*big.Int, common.Hash) or
+// JSON-RPC (string) form, to how long a read at that block is kept.
+func (c *Cache) blockTTL(block any) (time.Duration, bool) {
+	var number *big.Int
+	switch v := block.(type) {
+	case nil:
+	case *big.Int:
+		number = v
+	case common.Hash:
+		return 0, true
+	case string:
+		n, err := utils.ToBlockNumber(v)
+		if err != nil {
+			return 0, false
+		}
+		number = n
+	default:
+		return 0, false
+	}
+
+	switch {
+	case number == nil:
+		// latest
+	case number.Sign() >= 0:
+		return 0, true
+	case number.Int64() == int64(rpc.PendingBlockNumber):
+		return 0, false
+	}
+	if c.latestTTL < 0 {
+		return 0, false
+	}
+	return c.latestTTL, true
+}
+
+func isImmutableRead(msg any) bool {
+	var data []byte
+	switch v := msg.(type) {
+	case ethereum.CallMsg:
+		if v.To == nil {
+			return false
+		}
+		data = v.Data
+	case types.TransactionRequest:
+		if v.To == "" {
+			return false
+		}
+		data = v.Data
+	default:
+		return false
+	}
+
+	if len(data) != 4 {
+		return false
+	}
+	for _, selector := range immutableReads {
+		if bytes.Equal(data, selector) {
+			return true
+		}
+	}
+	return false
+}
+
+// keyOf identifies a call by its method and params, Go types included, so
+// the geth and JSON-RPC forms of a call never share an entry.
+func keyOf(method string, params types.RequestArgs) (string, bool) {
+	var key strings.Builder
+	key.WriteString(method)
+	for _, param := range params {
+		encoded, err := json.Marshal(param)
+		if err != nil {
+			return "", false
+		}
+		fmt.Fprintf(&key, "|%T:%s", param, encoded)
+	}
+	return key.String(), true
+}
+
+func clone(v any) any {
+	switch v := v.(type) {
+	case *big.Int:
+		return new(big.Int).Set(v)
+	case []byte:
+		return bytes.Clone(v)
+	}
+	return v
+}
+
+// isEmpty reports a nil result, or an empty eth_call output, e.g. of an
+// address with no contract deployed yet.
+func isEmpty(v any) bool {
+	if v == nil || v == "0x" {
+		return true
+	}
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Pointer, reflect.Map, reflect.Interface:
+		return rv.IsNil()
+	case reflect.Slice:
+		return rv.Len() == 0
+	}
+	return false
+}
@@ -0,0 +1,202 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/poteto-go/go-alchemy-sdk/constant"
+	"github.com/poteto-go/go-alchemy-sdk/types"
+)
+
+// countingHandler answers every call with result and counts the calls
+// reaching it.
+func countingHandler(result func() (any, error)) (types.RpcHandler, *int) {
+	calls := 0
+	return func(ctx context.Context, req types.RpcRequest) (any, error) {
+		calls++
+		return result()
+	}, &calls
+}
+
+func callTwice(t *testing.T, handler types.RpcHandler, req types.RpcRequest) (any, any) {
+	first, err := handler(context.Background(), req)
+	assert.NoError(t, err)
+	second, err := handler(context.Background(), req)
+	assert.NoError(t, err)
+	return first, second
+}
+
+func TestCache_Middleware(t *testing.T) {
+	contract := common.HexToAddress("0x1234567890abcdef1234567890abcdef12345678")
+	account := common.HexToAddress("0xabcdef1234567890abcdef1234567890abcdef12")
+
+	t.Run("caches the immutable calls", func(t *testing.T) {
+		for _, req := range []types.RpcRequest{
+			{Method: constant.Eth_ChainId, Params: types.RequestArgs{}},
+			{Method: constant.Eth_GetBlockByHash, Params: types.RequestArgs{common.HexToHash("0x01"), true}},
+			{Method: constant.Eth_GetTransactionReceipt, Params: types.RequestArgs{common.HexToHash("0x02")}},
+		} {
+			t.Run(req.Method, func(t *testing.T) {
+				// Arrange
+				next, calls := countingHandler(func() (any, error) { return "result", nil })
+				handler := New(Config{}).Middleware()(next)
+
+				// Act
+				first, second := callTwice(t, handler, req)
+
+				// Assert
+				assert.Equal(t, "result", first)
+				assert.Equal(t, "result", second)
+				assert.Equal(t, 1, *calls)
+			})
+		}
+	})
+
+	t.Run("decimals read is cached whatever the block", func(t *testing.T) {
+		// Arrange
+		next, calls := countingHandler(func() (any, error) { return []byte{18}, nil })
+		handler := New(Config{LatestTTL: -1}).Middleware()(next)
+		msg := ethereum.CallMsg{To: &contract, Data: crypto.Keccak256(constant.DecimalsFnSignature)[:4]}
+
+		// Act
+		_, err := handler(context.Background(), types.RpcRequest{Method: constant.Eth_Call, Params: types.RequestArgs{msg, (*big.Int)(nil)}})
+		assert.NoError(t, err)
+		res, err := handler(context.Background(), types.RpcRequest{Method: constant.Eth_Call, Params: types.RequestArgs{msg, big.NewInt(100)}})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, []byte{18}, res)
+		assert.Equal(t, 1, *calls)
+	})
+
+	t.Run("other reads are cached by block", func(t *testing.T) {
+		// Arrange
+		next, calls := countingHandler(func() (any, error) { return big.NewInt(1), nil })
+		handler := New(Config{}).Middleware()(next)
+
+		// Act
+		callTwice(t, handler, types.RpcRequest{Method: constant.Eth_GetBalance, Params: types.RequestArgs{account, big.NewInt(100)}})
+		_, err := handler(context.Background(), types.RpcRequest{Method: constant.Eth_GetBalance, Params: types.RequestArgs{account, big.NewInt(101)}})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, 2, *calls)
+	})
+
+	t.Run("latest reads expire after LatestTTL", func(t *testing.T) {
+		// Arrange
+		next, calls := countingHandler(func() (any, error) { return "0x1", nil })
+		handler := New(Config{LatestTTL: 10 * time.Millisecond}).Middleware()(next)
+		req := types.RpcRequest{Method: constant.Eth_Call, Params: types.RequestArgs{types.TransactionRequest{To: "0x1"}, "latest"}}
+
+		// Act
+		callTwice(t, handler, req)
+		time.Sleep(20 * time.Millisecond)
+		_, err := handler(context.Background(), req)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, 2, *calls)
+	})
+
+	t.Run("not cached", func(t *testing.T) {
+		for name, req := range map[string]types.RpcRequest{
+			"pending":          {Method: constant.Eth_GetBalance, Params: types.RequestArgs{account, big.NewInt(int64(rpc.PendingBlockNumber))}},
+			"pending tag":      {Method: constant.Eth_Call, Params: types.RequestArgs{types.TransactionRequest{To: "0x1"}, "pending"}},
+			"latest disabled":  {Method: constant.Eth_GetBlockByNumber, Params: types.RequestArgs{(*big.Int)(nil), true}},
+			"uncached method":  {Method: constant.Eth_BlockNumber, Params: types.RequestArgs{}},
+			"unknown param":    {Method: constant.Eth_GetBalance, Params: types.RequestArgs{account, 1}},
+			"missing block":    {Method: constant.Eth_GetStorageAt, Params: types.RequestArgs{account}},
+			"unencodable call": {Method: constant.Eth_ChainId, Params: types.RequestArgs{make(chan int)}},
+		} {
+			t.Run(name, func(t *testing.T) {
+				// Arrange
+				next, calls := countingHandler(func() (any, error) { return "0x1", nil })
+				handler := New(Config{LatestTTL: -1}).Middleware()(next)
+
+				// Act
+				callTwice(t, handler, req)
+
+				// Assert
+				assert.Equal(t, 2, *calls)
+			})
+		}
+	})
+
+	t.Run("errors and empty results are not cached", func(t *testing.T) {
+		for name, result := range map[string]func() (any, error){
+			"error":        func() (any, error) { return nil, errors.New("test error") },
+			"nil":          func() (any, error) { return nil, nil },
+			"typed nil":    func() (any, error) { return (*big.Int)(nil), nil },
+			"empty output": func() (any, error) { return []byte{}, nil },
+			"empty hex":    func() (any, error) { return "0x", nil },
+		} {
+			t.Run(name, func(t *testing.T) {
+				// Arrange
+				next, calls := countingHandler(result)
+				handler := New(Config{}).Middleware()(next)
+				req := types.RpcRequest{Method: constant.Eth_ChainId, Params: types.RequestArgs{}}
+
+				// Act
+				handler(context.Background(), req)
+				handler(context.Background(), req)
+
+				// Assert
+				assert.Equal(t, 2, *calls)
+			})
+		}
+	})
+
+	t.Run("geth and json-rpc forms do not share an entry", func(t *testing.T) {
+		// Arrange
+		next, calls := countingHandler(func() (any, error) { return "0x1", nil })
+		handler := New(Config{}).Middleware()(next)
+
+		// Act
+		callTwice(t, handler, types.RpcRequest{Method: constant.Eth_GetBlockByNumber, Params: types.RequestArgs{big.NewInt(1), false}})
+		callTwice(t, handler, types.RpcRequest{Method: constant.Eth_GetBlockByNumber, Params: types.RequestArgs{big.NewInt(1), true}})
+		callTwice(t, handler, types.RpcRequest{Method: constant.Eth_GetBlockByNumber, Params: types.RequestArgs{"0x1", true}})
+
+		// Assert
+		assert.Equal(t, 3, *calls)
+	})
+
+	t.Run("a cached *big.Int is copied for every caller", func(t *testing.T) {
+		// Arrange
+		next, _ := countingHandler(func() (any, error) { return big.NewInt(1), nil })
+		handler := New(Config{}).Middleware()(next)
+		req := types.RpcRequest{Method: constant.Eth_ChainId, Params: types.RequestArgs{}}
+
+		// Act
+		first, second := callTwice(t, handler, req)
+		first.(*big.Int).SetInt64(2)
+		third, _ := handler(context.Background(), req)
+
+		// Assert
+		assert.Equal(t, big.NewInt(1), second)
+		assert.Equal(t, big.NewInt(1), third)
+	})
+
+	t.Run("uses the given store", func(t *testing.T) {
+		// Arrange
+		store := NewLRUStore(1)
+		next, _ := countingHandler(func() (any, error) { return "0x1", nil })
+		handler := New(Config{Store: store}).Middleware()(next)
+
+		// Act
+		_, err := handler(context.Background(), types.RpcRequest{Method: constant.Eth_ChainId, Params: types.RequestArgs{}})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, 1, store.Len())
+	})
+}
@@ -0,0 +1,97 @@
+package cache
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+// Store holds cached results. It must be safe for concurrent use.
+type Store interface {
+	// Get returns the value under key, unless it is missing or expired.
+	Get(key string) (any, bool)
+
+	// Set stores value under key; a ttl <= 0 never expires.
+	Set(key string, value any, ttl time.Duration)
+}
+
+const DefaultLRUSize = 4096
+
+type lruEntry struct {
+	key       string
+	value     any
+	expiresAt time.Time
+}
+
+// LRUStore is the in-memory Store used by default. Past size entries, the
+// least recently used one is evicted.
+type LRUStore struct {
+	mu      sync.Mutex
+	size    int
+	order   *list.List
+	entries map[string]*list.Element
+}
+
+// NewLRUStore returns an LRUStore of size entries; size <= 0 uses
+// DefaultLRUSize.
+func NewLRUStore(size int) *LRUStore {
+	if size <= 0 {
+		size = DefaultLRUSize
+	}
+	return &LRUStore{
+		size:    size,
+		order:   list.New(),
+		entries: make(map[string]*list.Element),
+	}
+}
+
+func (s *LRUStore) Get(key string) (any, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	elem, ok := s.entries[key]
+	if !ok {
+		return nil, false
+	}
+	entry := elem.Value.(*lruEntry)
+	if !entry.expiresAt.IsZero() && time.Now().After(entry.expiresAt) {
+		s.remove(elem)
+		return nil, false
+	}
+	s.order.MoveToFront(elem)
+	return entry.value, true
+}
+
+func (s *LRUStore) Set(key string, value any, ttl time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var expiresAt time.Time
+	if ttl > 0 {
+		expiresAt = time.Now().Add(ttl)
+	}
+
+	if elem, ok := s.entries[key]; ok {
+		entry := elem.Value.(*lruEntry)
+		entry.value, entry.expiresAt = value, expiresAt
+		s.order.MoveToFront(elem)
+		return
+	}
+
+	s.entries[key] = s.order.PushFront(&lruEntry{key: key, value: value, expiresAt: expiresAt})
+	if s.order.Len() > s.size {
+		s.remove(s.order.Back())
+	}
+}
+
+// Len returns the number of entries, expired ones not yet evicted included.
+func (s *LRUStore) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.order.Len()
+}
+
+func (s *LRUStore) remove(elem *list.Element) {
+	s.order.Remove(elem)
+	delete(s.entries, elem.Value.(*lruEntry).key)
+}
@@ -0,0 +1,92 @@
+package cache
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewLRUStore(t *testing.T) {
+	t.Run("non-positive size uses the default", func(t *testing.T) {
+		// Act
+		store := NewLRUStore(0)
+
+		// Assert
+		assert.Equal(t, DefaultLRUSize, store.size)
+	})
+}
+
+func TestLRUStore_GetSet(t *testing.T) {
+	t.Run("returns what was set", func(t *testing.T) {
+		// Arrange
+		store := NewLRUStore(2)
+		store.Set("a", 1, 0)
+
+		// Act
+		value, ok := store.Get("a")
+
+		// Assert
+		assert.True(t, ok)
+		assert.Equal(t, 1, value)
+	})
+
+	t.Run("missing key", func(t *testing.T) {
+		// Arrange
+		store := NewLRUStore(2)
+
+		// Act
+		_, ok := store.Get("a")
+
+		// Assert
+		assert.False(t, ok)
+	})
+
+	t.Run("evicts the least recently used entry", func(t *testing.T) {
+		// Arrange
+		store := NewLRUStore(2)
+		store.Set("a", 1, 0)
+		store.Set("b", 2, 0)
+		store.Get("a")
+
+		// Act
+		store.Set("c", 3, 0)
+
+		// Assert
+		_, okA := store.Get("a")
+		_, okB := store.Get("b")
+		_, okC := store.Get("c")
+		assert.True(t, okA)
+		assert.False(t, okB)
+		assert.True(t, okC)
+		assert.Equal(t, 2, store.Len())
+	})
+
+	t.Run("set on an existing key replaces it", func(t *testing.T) {
+		// Arrange
+		store := NewLRUStore(2)
+		store.Set("a", 1, 0)
+
+		// Act
+		store.Set("a", 2, 0)
+
+		// Assert
+		value, _ := store.Get("a")
+		assert.Equal(t, 2, value)
+		assert.Equal(t, 1, store.Len())
+	})
+
+	t.Run("expired entry is dropped", func(t *testing.T) {
+		// Arrange
+		store := NewLRUStore(2)
+		store.Set("a", 1, time.Millisecond)
+		time.Sleep(5 * time.Millisecond)
+
+		// Act
+		_, ok := store.Get("a")
+
+		// Assert
+		assert.False(t, ok)
+		assert.Equal(t, 0, store.Len())
+	})
+}
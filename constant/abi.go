@@ -18,3 +18,13 @@ const (
 	// safeBatchTransferFrom(address,address,uint256[],uint256[],bytes).
 	Erc1155SafeTransferFromHeadSize = 5 * ABIWordSize
 )
+
+// Selectors of the revert payloads the Solidity compiler emits.
+var (
+	// ErrorSelector is the selector of Error(string), a require / revert("...").
+	ErrorSelector = []byte{0x08, 0xc3, 0x79, 0xa0}
+
+	// PanicSelector is the selector of Panic(uint256), a failed assert or
+	// checked arithmetic.
+	PanicSelector = []byte{0x4e, 0x48, 0x7b, 0x71}
+)
@@ -75,4 +75,7 @@ var (
 	ENSResolverFnSignature = []byte("resolver(bytes32)")
 	ENSAddrFnSignature     = []byte("addr(bytes32)")
 	ENSNameFnSignature     = []byte("name(bytes32)")
+
+	// Multicall3
+	Aggregate3FnSignature = []byte("aggregate3((address,bool,bytes)[])")
 )
@@ -21,6 +21,7 @@ var (
 	ErrRequestTimeoutTooShort           = errors.New("request timeout should be longer than batch timeout")
 	ErrRequestTimeout                   = errors.New("request timeout")
 	ErrOverMaxRetries                   = errors.New("over max retries")
+	ErrRetryAfterTooLong                = errors.New("retry after is longer than the backoff waits")
 	ErrInvalidBlockTag                  = errors.New("invalid block tag")
 	ErrInvalidGetTransactionReceiptsArg = errors.New("invalid get transaction receipts arg, need blockHash or blockNumber")
 	ErrFailedToTransformBlockNumber     = errors.New("failed to transform block number")
@@ -42,6 +43,7 @@ var (
 	ErrNegativeAmount                   = errors.New("amount must not be negative")
 	ErrAmountExceedsUint256             = errors.New("amount exceeds uint256 max")
 	ErrInvalidAddress                   = errors.New("invalid hex address")
+	ErrInvalidHash                      = errors.New("invalid hex hash")
 	ErrInvalidABIString                 = errors.New("invalid ABI string")
 	ErrInvalidAuthorizationWindow       = errors.New("validAfter must be less than validBefore")
 	ErrFailedToReadResponse             = errors.New("failed to read response body")
@@ -64,6 +66,32 @@ var (
 	ErrChainNotSupportEIP1559           = errors.New("chain does not support EIP-1559")
 	ErrFailedToMapAssetTransfers        = errors.New("failed to map asset transfers response")
 	ErrUnsupportedNotWebsocketProvider  = errors.New("unsupported provider, not a websocket provider")
+	ErrWsClientShutdown                 = errors.New("websocket client was shut down")
+	ErrUnexpectedMiddlewareType         = errors.New("middleware changed a param or result to an unexpected type")
+	ErrComputeUnitRateExceeded          = errors.New("compute unit rate limit exceeded")
+	ErrComputeUnitBudgetExceeded        = errors.New("compute unit daily budget exceeded")
+	ErrInvalidABIType                   = errors.New("invalid ABI type")
+	ErrABIValueMismatch                 = errors.New("value does not match ABI type")
+	ErrUnknownEvent                     = errors.New("unknown event")
+	ErrReorgTooDeep                     = errors.New("reorg is deeper than the follower window")
+	ErrMulticallNotDeployed             = errors.New("multicall3 is not deployed at the address")
+	ErrNotDevChain                      = errors.New("chain is not a known dev chain")
+	ErrTxNotPending                     = errors.New("transaction is not pending")
+	ErrTxNotFound                       = errors.New("transaction is not found")
+	ErrRepeatedPageKey                  = errors.New("node returned a page key it already returned")
+	ErrTxNotFromWallet                  = errors.New("transaction is not sent from the wallet")
+	ErrUnsupportedTxType                = errors.New("unsupported transaction type")
+	ErrUnsupportedKDF                   = errors.New("unsupported keystore kdf")
+	ErrKeystoreDirClosed                = errors.New("keystore directory is closed")
+	ErrInvalidMnemonic                  = errors.New("invalid mnemonic")
+	ErrInvalidMnemonicWords             = errors.New("mnemonic must have 12, 15, 18, 21 or 24 words")
+	ErrInvalidDerivationPath            = errors.New("invalid derivation path")
+	ErrInvalidDerivedKey                = errors.New("derived key is invalid, use the next index")
+	ErrInvalidSignatureLength           = errors.New("signature must be 65 bytes")
+	ErrSignerAddressMismatch            = errors.New("signature is not by the signer's address")
+	ErrSignHashUnsupported              = errors.New("signer does not sign raw hashes")
+	ErrRemoteSignerFailed               = errors.New("remote signer failed to sign")
+	ErrKeyNotExportable                 = errors.New("signer does not expose its private key")
 )
 
 var HttpClientErrorCodeList = []int{
@@ -14,12 +14,21 @@ var (
 	Eth_GetBlockByNumber      = "eth_getBlockByNumber"
 	Eth_GetBlockByHash        = "eth_getBlockByHash"
 	Eth_ChainId               = "eth_chainId"
+	Eth_MaxPriorityFeePerGas  = "eth_maxPriorityFeePerGas"
+	Eth_GetTransactionCount   = "eth_getTransactionCount"
+	Eth_SendRawTransaction    = "eth_sendRawTransaction"
+	Eth_GetBlockReceipts      = "eth_getBlockReceipts"
 )
 
 var (
 	Net_PeerCount = "net_peerCount"
 )
 
+// Rpc_Batch is the method a middleware sees for a whole BatchCall.
+var (
+	Rpc_Batch = "rpc_batch"
+)
+
 var (
 	Evm_Snapshot = "evm_snapshot"
 	Evm_Revert   = "evm_revert"
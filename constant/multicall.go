@@ -0,0 +1,9 @@
+package constant
+
+// Multicall3Address is the address Multicall3 is deployed at on mainnet and
+// most other chains, through its keyless deployment transaction.
+const Multicall3Address = "0xcA11bde05977b3631167028862bE2a173976CA11"
+
+// DevChainIDs are the chain IDs EnsureMulticall3 deploys on without opt-in:
+// geth --dev and its simulated backend (1337), anvil and hardhat (31337).
+var DevChainIDs = []int64{1337, 31337}
@@ -1,5 +1,7 @@
 package constant
 
+import "time"
+
 // JWT/JWS parameters for geth's iat window check.
 const (
 	// GethJwsIatWindowSec is the tight iat window geth accepts for the
@@ -13,3 +15,30 @@ const (
 	// JwsAliveWindowSec is the effective alive window after applying the safety ratio.
 	JwsAliveWindowSec = int64(GethJwsIatWindowSec * JwsAliveSafetyRatio)
 )
+
+// JSON-RPC batching of AlchemySetting.IsRequestBatch.
+const (
+	// DefaultBatchFlushConcurrency is how many batches are sent at once.
+	DefaultBatchFlushConcurrency = 4
+)
+
+// eth_getLogs range splitting of Core.GetLogsRange.
+const (
+	// DefaultLogsRangeChunkSize is the block span of one eth_getLogs request:
+	// Alchemy serves any number of logs over up to 2000 blocks.
+	DefaultLogsRangeChunkSize = 2000
+
+	// DefaultLogsRangeConcurrency is how many eth_getLogs requests run at once.
+	DefaultLogsRangeConcurrency = 4
+)
+
+// HTTP remote signer of signer.Remote.
+const (
+	// DefaultRemoteSignerTimeout bounds a request to the remote signer when
+	// RemoteOption.Client is nil.
+	DefaultRemoteSignerTimeout = 10 * time.Second
+
+	// MaxRemoteSignerResponseBytes bounds the response read from the remote
+	// signer; a signature response is well under it.
+	MaxRemoteSignerResponseBytes = 64 * 1024
+)
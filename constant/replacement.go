@@ -0,0 +1,5 @@
+package constant
+
+// MinReplacementBumpPercent is how much a replacement tx must raise every fee
+// of the pending tx it replaces: the default price bump of the geth tx pool.
+const MinReplacementBumpPercent = 10
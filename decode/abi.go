@@ -1,11 +1,15 @@
 package decode
 
 import (
+	"errors"
 	"fmt"
 	"math/big"
+	"reflect"
+	"strings"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/poteto-go/go-alchemy-sdk/constant"
+	"github.com/poteto-go/go-alchemy-sdk/internal"
 	"github.com/poteto-go/go-alchemy-sdk/validate"
 )
 
@@ -87,3 +91,95 @@ func ABIString(output []byte) (string, error) {
 	length := new(big.Int).SetBytes(output[constant.ABIWordSize : constant.ABIWordSize*2]).Int64()
 	return string(output[constant.ABIStringHeaderSize : constant.ABIStringHeaderSize+length]), nil
 }
+
+/*
+ABIUnpack decodes output as the values of types, a comma separated list of
+Solidity types such as "uint160,int24,uint16,uint16,uint16,uint8,bool".
+Values are typed as go-ethereum unpacks them: uint8..uint64 and int8..int64
+as the Go integer of that size, other widths as *big.Int, tuples as structs.
+Use ABIUnpackInto to decode into Go types of your own.
+*/
+func ABIUnpack(types string, output []byte) ([]any, error) {
+	args, err := internal.ParseABIArguments(types)
+	if err != nil {
+		return nil, err
+	}
+	return args.Unpack(output)
+}
+
+/*
+ABIUnpackInto decodes output as the values of types into out, a non-nil
+pointer. A single value is stored into *out; several are stored into the
+fields of struct *out, in order. Integers of any width and *big.Int convert
+into each other, and tuples into structs, field by field in order.
+
+An empty types is derived from *out (see encode.ABIPackStruct): the fields of
+a struct are the values, anything else a single value. For example, Uniswap
+v3 slot0():
+
+	type Slot0 struct {
+		SqrtPriceX96               *big.Int `abi:"uint160"`
+		Tick                       int64    `abi:"int24"`
+		ObservationIndex           uint16
+		ObservationCardinality     uint16
+		ObservationCardinalityNext uint16
+		FeeProtocol                uint8
+		Unlocked                   bool
+	}
+
+	var slot0 Slot0
+	err := decode.ABIUnpackInto("", output, &slot0)
+*/
+func ABIUnpackInto(types string, output []byte, out any) error {
+	dst := reflect.ValueOf(out)
+	if dst.Kind() != reflect.Pointer || dst.IsNil() {
+		return errors.Join(constant.ErrABIValueMismatch, fmt.Errorf("%T is not a non-nil pointer", out))
+	}
+	dst = dst.Elem()
+
+	if types == "" {
+		derived, err := abiTypesOf(dst.Type())
+		if err != nil {
+			return err
+		}
+		types = derived
+	}
+
+	values, err := ABIUnpack(types, output)
+	if err != nil {
+		return err
+	}
+	if len(values) == 1 {
+		return internal.ConvertABIValue(dst, reflect.ValueOf(values[0]))
+	}
+	return internal.ConvertABIValue(dst, reflect.ValueOf(values))
+}
+
+/*
+ABIDecoder returns a decoder of output into a T by ABIUnpackInto, to pass as
+the decode of batch.AddCall:
+
+	slot0 := batch.AddCall(b, pool, []byte("slot0()"), decode.ABIDecoder[Slot0](""))
+*/
+func ABIDecoder[T any](types string) func([]byte) (T, error) {
+	return func(output []byte) (T, error) {
+		var value T
+		if err := ABIUnpackInto(types, output, &value); err != nil {
+			var zero T
+			return zero, err
+		}
+		return value, nil
+	}
+}
+
+// abiTypesOf derives the types of ABIUnpackInto from the Go type of *out.
+func abiTypesOf(t reflect.Type) (string, error) {
+	if t.Kind() == reflect.Struct && t != reflect.TypeFor[big.Int]() {
+		fields, err := internal.ABIFieldTypes(t)
+		if err != nil {
+			return "", err
+		}
+		return strings.Join(fields, ","), nil
+	}
+	return internal.ABITypeOf(t)
+}
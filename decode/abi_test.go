@@ -7,6 +7,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/poteto-go/go-alchemy-sdk/constant"
 	"github.com/poteto-go/go-alchemy-sdk/decode"
+	"github.com/poteto-go/go-alchemy-sdk/encode"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -186,3 +187,141 @@ func TestUint256Array(t *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+type slot0 struct {
+	SqrtPriceX96               *big.Int `abi:"uint160"`
+	Tick                       int64    `abi:"int24"`
+	ObservationIndex           uint16
+	ObservationCardinality     uint16
+	ObservationCardinalityNext uint16
+	FeeProtocol                uint8
+	Unlocked                   bool
+}
+
+func slot0Output() []byte {
+	output, _ := encode.ABIPack(
+		"uint160,int24,uint16,uint16,uint16,uint8,bool",
+		new(big.Int).Lsh(big.NewInt(1), 96), -887272, 1, 2, 3, 0, true,
+	)
+	return output
+}
+
+func TestABIUnpack(t *testing.T) {
+	t.Run("values are typed as go-ethereum unpacks them", func(t *testing.T) {
+		// Act
+		values, err := decode.ABIUnpack("uint160,int24,uint16,uint16,uint16,uint8,bool", slot0Output())
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, new(big.Int).Lsh(big.NewInt(1), 96), values[0])
+		assert.Equal(t, big.NewInt(-887272), values[1])
+		assert.Equal(t, uint16(1), values[2])
+		assert.Equal(t, uint8(0), values[5])
+		assert.Equal(t, true, values[6])
+	})
+
+	t.Run("output too short", func(t *testing.T) {
+		// Act
+		_, err := decode.ABIUnpack("uint256,uint256", make([]byte, constant.ABIWordSize))
+
+		// Assert
+		assert.Error(t, err)
+	})
+}
+
+func TestABIUnpackInto(t *testing.T) {
+	t.Run("types derived from a struct (Uniswap v3 slot0)", func(t *testing.T) {
+		// Arrange
+		var s slot0
+
+		// Act
+		err := decode.ABIUnpackInto("", slot0Output(), &s)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, slot0{
+			SqrtPriceX96:               new(big.Int).Lsh(big.NewInt(1), 96),
+			Tick:                       -887272,
+			ObservationIndex:           1,
+			ObservationCardinality:     2,
+			ObservationCardinalityNext: 3,
+			FeeProtocol:                0,
+			Unlocked:                   true,
+		}, s)
+	})
+
+	t.Run("a returned struct array with nested arrays", func(t *testing.T) {
+		// Arrange
+		type position struct {
+			Owner  common.Address
+			Ticks  [2]int32
+			Fees   []*big.Int
+			Secret [8]byte
+		}
+		types := "(address,int24[2],uint256[],bytes8)[]"
+		output, err := encode.ABIPack(types, []any{
+			[]any{"0x00000000000000000000000000000000000000aa", []int{-10, 10}, []int{1, 2}, []byte("12345678")},
+			[]any{"0x00000000000000000000000000000000000000bb", []int{0, 1}, []int{}, []byte("abcdefgh")},
+		})
+		assert.NoError(t, err)
+		var positions []position
+
+		// Act
+		err = decode.ABIUnpackInto(types, output, &positions)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, []position{
+			{common.HexToAddress("0xaa"), [2]int32{-10, 10}, []*big.Int{big.NewInt(1), big.NewInt(2)}, [8]byte([]byte("12345678"))},
+			{common.HexToAddress("0xbb"), [2]int32{0, 1}, []*big.Int{}, [8]byte([]byte("abcdefgh"))},
+		}, positions)
+	})
+
+	t.Run("a single value", func(t *testing.T) {
+		// Arrange
+		var n uint64
+
+		// Act
+		err := decode.ABIUnpackInto("uint256", encode.ABIUint256(big.NewInt(42)), &n)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(42), n)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		// Arrange
+		var small uint8
+		var s slot0
+
+		// Act
+		errPointer := decode.ABIUnpackInto("uint256", encode.ABIUint256(big.NewInt(1)), small)
+		errOverflow := decode.ABIUnpackInto("uint256", encode.ABIUint256(big.NewInt(256)), &small)
+		errFields := decode.ABIUnpackInto("uint256,uint256", make([]byte, 2*constant.ABIWordSize), &s)
+
+		// Assert
+		assert.ErrorIs(t, errPointer, constant.ErrABIValueMismatch)
+		assert.ErrorIs(t, errOverflow, constant.ErrOverFlow)
+		assert.ErrorIs(t, errFields, constant.ErrABIValueMismatch)
+	})
+}
+
+func TestABIDecoder(t *testing.T) {
+	t.Run("decodes into T", func(t *testing.T) {
+		// Act
+		s, err := decode.ABIDecoder[slot0]("")(slot0Output())
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, int64(-887272), s.Tick)
+	})
+
+	t.Run("zero value on error", func(t *testing.T) {
+		// Act
+		s, err := decode.ABIDecoder[slot0]("")(nil)
+
+		// Assert
+		assert.Error(t, err)
+		assert.Equal(t, slot0{}, s)
+	})
+}
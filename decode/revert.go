@@ -0,0 +1,151 @@
+package decode
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/poteto-go/go-alchemy-sdk/constant"
+	"github.com/poteto-go/go-alchemy-sdk/types"
+)
+
+// panicReasons names the Panic(uint256) codes of the Solidity compiler.
+//
+// refs: https://docs.soliditylang.org/en/latest/control-structures.html#panic-via-assert-and-error-via-require
+var panicReasons = map[uint64]string{
+	0x00: "generic compiler panic",
+	0x01: "assertion failed",
+	0x11: "arithmetic underflow or overflow",
+	0x12: "division or modulo by zero",
+	0x21: "enum conversion out of range",
+	0x22: "invalid storage byte array encoding",
+	0x31: "pop on empty array",
+	0x32: "array index out of bounds",
+	0x41: "out of memory",
+	0x51: "call to zero-initialized function",
+}
+
+// PanicReason names a Panic(uint256) code; "unknown panic" for a code the
+// compiler does not emit.
+func PanicReason(code *big.Int) string {
+	if code.IsUint64() {
+		if reason, ok := panicReasons[code.Uint64()]; ok {
+			return reason
+		}
+	}
+	return "unknown panic"
+}
+
+/*
+Revert decodes a revert payload: Error(string), Panic(uint256), or a Solidity
+custom error declared in one of abis. An unrecognised payload is only kept as
+RevertError.Data.
+*/
+func Revert(data []byte, abis ...abi.ABI) *types.RevertError {
+	revertErr := &types.RevertError{Data: data}
+	if len(data) < 4 {
+		return revertErr
+	}
+
+	selector, args := data[:4], data[4:]
+	switch {
+	case bytes.Equal(selector, constant.ErrorSelector):
+		if reason, err := ABIString(args); err == nil {
+			revertErr.Reason = reason
+		}
+	case bytes.Equal(selector, constant.PanicSelector):
+		if len(args) >= constant.ABIWordSize {
+			revertErr.PanicCode = new(big.Int).SetBytes(args[:constant.ABIWordSize])
+			revertErr.PanicReason = PanicReason(revertErr.PanicCode)
+		}
+	default:
+		for _, contractAbi := range abis {
+			for _, abiErr := range contractAbi.Errors {
+				if !bytes.Equal(abiErr.ID[:4], selector) {
+					continue
+				}
+				values, err := abiErr.Inputs.Unpack(args)
+				if err != nil {
+					continue
+				}
+				revertErr.ErrorName = abiErr.Name
+				revertErr.ErrorArgs = values
+				return revertErr
+			}
+		}
+	}
+	return revertErr
+}
+
+/*
+RevertErrorOf returns the *types.RevertError of a failed eth_call or
+eth_estimateGas, decoding the revert data the node attached to its JSON-RPC
+error (see Revert). The error is kept as RevertError.Err.
+
+A *types.RevertError already in err is decoded again against abis when its
+custom error was not matched yet. ok is false when err is not a revert.
+*/
+func RevertErrorOf(err error, abis ...abi.ABI) (*types.RevertError, bool) {
+	if err == nil {
+		return nil, false
+	}
+
+	if revertErr, ok := errors.AsType[*types.RevertError](err); ok {
+		if revertErr.ErrorName != "" || len(abis) == 0 {
+			return revertErr, true
+		}
+		decoded := Revert(revertErr.Data, abis...)
+		decoded.TxHash = revertErr.TxHash
+		decoded.Err = revertErr.Err
+		return decoded, true
+	}
+
+	if !isRevert(err) {
+		return nil, false
+	}
+
+	var data []byte
+	if dataErr, ok := errors.AsType[rpc.DataError](err); ok {
+		data = revertData(dataErr.ErrorData())
+	}
+	revertErr := Revert(data, abis...)
+	revertErr.Err = err
+	return revertErr, true
+}
+
+func isRevert(err error) bool {
+	if rpcErr, ok := errors.AsType[*types.RpcError](err); ok {
+		return rpcErr.IsExecutionReverted()
+	}
+	if rpcErr, ok := errors.AsType[rpc.Error](err); ok && rpcErr.ErrorCode() == 3 {
+		return true
+	}
+	return strings.Contains(err.Error(), "execution reverted")
+}
+
+// revertData reads the hex revert data of a JSON-RPC error: raw JSON from a
+// *types.RpcError, or the string geth decoded.
+func revertData(data any) []byte {
+	var encoded string
+	switch v := data.(type) {
+	case json.RawMessage:
+		if err := json.Unmarshal(v, &encoded); err != nil {
+			return nil
+		}
+	case string:
+		encoded = v
+	default:
+		return nil
+	}
+
+	decoded, err := hexutil.Decode(encoded)
+	if err != nil {
+		return nil
+	}
+	return decoded
+}
@@ -0,0 +1,160 @@
+package decode_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/poteto-go/go-alchemy-sdk/decode"
+	"github.com/poteto-go/go-alchemy-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const tokenErrorsAbi = `[{"type":"error","name":"InsufficientBalance","inputs":[{"name":"available","type":"uint256"},{"name":"required","type":"uint256"}]}]`
+
+func errorStringPayload(reason string) []byte {
+	return append(common.FromHex("08c379a0"), abiStringBytes(reason)...)
+}
+
+func panicPayload(code int64) []byte {
+	return append(common.FromHex("4e487b71"), common.LeftPadBytes(big.NewInt(code).Bytes(), 32)...)
+}
+
+func insufficientBalancePayload(t *testing.T) ([]byte, abi.ABI) {
+	t.Helper()
+	contractAbi, err := abi.JSON(strings.NewReader(tokenErrorsAbi))
+	require.NoError(t, err)
+	abiErr := contractAbi.Errors["InsufficientBalance"]
+	args, err := abiErr.Inputs.Pack(big.NewInt(100), big.NewInt(200))
+	require.NoError(t, err)
+	return append(abiErr.ID[:4:4], args...), contractAbi
+}
+
+// testDataError is a geth rpc.DataError as decoded by the geth client.
+type testDataError struct {
+	code int
+	data any
+}
+
+func (e testDataError) Error() string  { return "execution reverted" }
+func (e testDataError) ErrorCode() int { return e.code }
+func (e testDataError) ErrorData() any { return e.data }
+
+func TestRevert(t *testing.T) {
+	t.Run("Error(string)", func(t *testing.T) {
+		// Act
+		revertErr := decode.Revert(errorStringPayload("not owner"))
+
+		// Assert
+		assert.Equal(t, "not owner", revertErr.Reason)
+		assert.Equal(t, "execution reverted: not owner", revertErr.Error())
+	})
+
+	t.Run("Panic(uint256)", func(t *testing.T) {
+		// Act
+		revertErr := decode.Revert(panicPayload(0x11))
+
+		// Assert
+		assert.Equal(t, big.NewInt(0x11), revertErr.PanicCode)
+		assert.Equal(t, "arithmetic underflow or overflow", revertErr.PanicReason)
+		assert.Equal(t, "execution reverted: panic 0x11 (arithmetic underflow or overflow)", revertErr.Error())
+	})
+
+	t.Run("custom error of a supplied ABI", func(t *testing.T) {
+		// Arrange
+		payload, contractAbi := insufficientBalancePayload(t)
+
+		// Act
+		revertErr := decode.Revert(payload, contractAbi)
+
+		// Assert
+		assert.Equal(t, "InsufficientBalance", revertErr.ErrorName)
+		assert.Equal(t, []any{big.NewInt(100), big.NewInt(200)}, revertErr.ErrorArgs)
+		assert.Equal(t, "execution reverted: InsufficientBalance(100, 200)", revertErr.Error())
+	})
+
+	t.Run("custom error without its ABI", func(t *testing.T) {
+		// Arrange
+		payload, _ := insufficientBalancePayload(t)
+
+		// Act
+		revertErr := decode.Revert(payload)
+
+		// Assert
+		assert.Empty(t, revertErr.ErrorName)
+		assert.Equal(t, payload, revertErr.Data)
+		assert.Equal(t, fmt.Sprintf("execution reverted: unknown error 0x%x", payload[:4]), revertErr.Error())
+	})
+
+	t.Run("no payload", func(t *testing.T) {
+		assert.Equal(t, "execution reverted", decode.Revert(nil).Error())
+	})
+}
+
+func TestPanicReason(t *testing.T) {
+	assert.Equal(t, "division or modulo by zero", decode.PanicReason(big.NewInt(0x12)))
+	assert.Equal(t, "unknown panic", decode.PanicReason(big.NewInt(0x99)))
+}
+
+func TestRevertErrorOf(t *testing.T) {
+	t.Run("*types.RpcError with raw data", func(t *testing.T) {
+		// Arrange
+		data, _ := json.Marshal(hexutil.Encode(errorStringPayload("not owner")))
+		rpcErr := &types.RpcError{Method: "eth_call", Code: 3, Message: "execution reverted", Data: data}
+
+		// Act
+		revertErr, ok := decode.RevertErrorOf(fmt.Errorf("wrapped: %w", rpcErr))
+
+		// Assert
+		assert.True(t, ok)
+		assert.Equal(t, "not owner", revertErr.Reason)
+		assert.ErrorIs(t, revertErr, rpcErr)
+	})
+
+	t.Run("geth rpc.DataError with hex string data", func(t *testing.T) {
+		// Arrange
+		payload, contractAbi := insufficientBalancePayload(t)
+
+		// Act
+		revertErr, ok := decode.RevertErrorOf(testDataError{code: 3, data: hexutil.Encode(payload)}, contractAbi)
+
+		// Assert
+		assert.True(t, ok)
+		assert.Equal(t, "InsufficientBalance", revertErr.ErrorName)
+	})
+
+	t.Run("decodes a RevertError again against new ABIs", func(t *testing.T) {
+		// Arrange
+		payload, contractAbi := insufficientBalancePayload(t)
+		txHash := common.HexToHash("0x01")
+		decoded := decode.Revert(payload)
+		decoded.TxHash = txHash
+
+		// Act
+		revertErr, ok := decode.RevertErrorOf(decoded, contractAbi)
+
+		// Assert
+		assert.True(t, ok)
+		assert.Equal(t, "InsufficientBalance", revertErr.ErrorName)
+		assert.Equal(t, txHash, revertErr.TxHash)
+	})
+
+	t.Run("not a revert", func(t *testing.T) {
+		// Act
+		_, ok1 := decode.RevertErrorOf(&types.RpcError{Code: -32000, Message: "nonce too low"})
+		_, ok2 := decode.RevertErrorOf(errors.New("test error"))
+		_, ok3 := decode.RevertErrorOf(nil)
+
+		// Assert
+		assert.False(t, ok1)
+		assert.False(t, ok2)
+		assert.False(t, ok3)
+	})
+}
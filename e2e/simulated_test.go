@@ -29,7 +29,8 @@ import (
 // reach for the raw *rpc.Client are NOT available on a simulated backend and
 // are skipped in the scenarios below:
 //   - Core.GetBalance / wallet.GetBalance (eth_getBalance via Send)
-//   - batch.Batcher (BatchCall via raw rpc.Client)
+//   - batch.Batcher (BatchCall via raw rpc.Client); the contract reads of
+//     batch.NewMulticallBatcher are a single eth_call and do work
 //   - Core.GetCode by block hash (CodeAtHash)
 //   - custom http.RoundTripper transport (no HTTP layer in simulated)
 //
@@ -2,11 +2,16 @@ package encode
 
 import (
 	"encoding/binary"
+	"errors"
+	"fmt"
 	"math/big"
+	"reflect"
+	"strings"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/poteto-go/go-alchemy-sdk/constant"
+	"github.com/poteto-go/go-alchemy-sdk/internal"
 )
 
 // ReadCalldata builds eth_call calldata for a contract read: the 4-byte
@@ -117,3 +122,89 @@ func ABIDynamicArgs(tails ...[]byte) []byte {
 	}
 	return b
 }
+
+/*
+ABIPack ABI-encodes values as the arguments of types, a comma separated list
+of Solidity types such as "address,(uint160,int24)[],bytes32". Tuples,
+nested and fixed-size arrays, bytesN and intN are supported, with the heads
+and tails of static and dynamic arguments laid out as the ABI spec requires.
+
+Each value may be any Go value of the same shape: *big.Int or any integer for
+intN / uintN, common.Address or a hex string for address, [N]byte or []byte
+for bytesN, a slice or array for T[] / T[N], and a struct (fields in order)
+or []any for a tuple.
+*/
+func ABIPack(types string, values ...any) ([]byte, error) {
+	args, err := internal.ParseABIArguments(types)
+	if err != nil {
+		return nil, err
+	}
+	if len(args) != len(values) {
+		return nil, errors.Join(
+			constant.ErrABIValueMismatch,
+			fmt.Errorf("%d values for %d types", len(values), len(args)),
+		)
+	}
+
+	packed := make([]any, len(args))
+	for i, arg := range args {
+		v := reflect.New(arg.Type.GetType()).Elem()
+		if err := internal.ConvertABIValue(v, reflect.ValueOf(values[i])); err != nil {
+			return nil, err
+		}
+		packed[i] = v.Interface()
+	}
+	return args.Pack(packed...)
+}
+
+/*
+ABIPackStruct ABI-encodes the exported fields of struct v as arguments, in
+order. Field types are derived from their Go types, or set with an
+`abi:"<type>"` tag:
+
+	type Swap struct {
+		TokenIn common.Address
+		Fee     *big.Int `abi:"uint24"`
+		Path    []common.Address
+	}
+*/
+func ABIPackStruct(v any) ([]byte, error) {
+	value := reflect.Indirect(reflect.ValueOf(v))
+	if value.Kind() != reflect.Struct {
+		return nil, errors.Join(constant.ErrABIValueMismatch, fmt.Errorf("%T is not a struct", v))
+	}
+	types, err := internal.ABIFieldTypes(value.Type())
+	if err != nil {
+		return nil, err
+	}
+	return ABIPack(strings.Join(types, ","), internal.ABIFieldValues(value)...)
+}
+
+/*
+Calldata builds the calldata of a call to signature, e.g.
+"exactInputSingle((address,address,uint24,address,uint256,uint256,uint160))":
+the 4-byte selector of the canonical signature (parameter names and spaces
+dropped) followed by ABIPack of values.
+*/
+func Calldata(signature string, values ...any) ([]byte, error) {
+	open := strings.Index(signature, "(")
+	if open < 0 || !strings.HasSuffix(signature, ")") {
+		return nil, errors.Join(constant.ErrInvalidABIType, fmt.Errorf("invalid signature %q", signature))
+	}
+	args, err := internal.ParseABIArguments(signature[open+1 : len(signature)-1])
+	if err != nil {
+		return nil, err
+	}
+
+	types := make([]string, len(args))
+	for i, arg := range args {
+		types[i] = arg.Type.String()
+	}
+	canonical := strings.TrimSpace(signature[:open]) + "(" + strings.Join(types, ",") + ")"
+
+	packed, err := ABIPack(strings.Join(types, ","), values...)
+	if err != nil {
+		return nil, err
+	}
+	return ReadCalldata([]byte(canonical), packed), nil
+}
@@ -203,3 +203,190 @@ func TestABIBool(t *testing.T) {
 		assert.Equal(t, make([]byte, 32), out)
 	})
 }
+
+// words joins hex ABI words into bytes.
+func words(hexWords ...string) []byte {
+	var b []byte
+	for _, w := range hexWords {
+		b = append(b, common.FromHex(w)...)
+	}
+	return b
+}
+
+func TestCalldata(t *testing.T) {
+	t.Run("static and dynamic heads (ABI spec example)", func(t *testing.T) {
+		// Act
+		calldata, err := encode.Calldata(
+			"f(uint256,uint32[],bytes10,bytes)",
+			big.NewInt(0x123),
+			[]uint32{0x456, 0x789},
+			[]byte("1234567890"),
+			[]byte("Hello, world!"),
+		)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, words(
+			"8be65246",
+			"0000000000000000000000000000000000000000000000000000000000000123",
+			"0000000000000000000000000000000000000000000000000000000000000080",
+			"3132333435363738393000000000000000000000000000000000000000000000",
+			"00000000000000000000000000000000000000000000000000000000000000e0",
+			"0000000000000000000000000000000000000000000000000000000000000002",
+			"0000000000000000000000000000000000000000000000000000000000000456",
+			"0000000000000000000000000000000000000000000000000000000000000789",
+			"000000000000000000000000000000000000000000000000000000000000000d",
+			"48656c6c6f2c20776f726c642100000000000000000000000000000000000000",
+		), calldata)
+	})
+
+	t.Run("nested dynamic arrays (ABI spec example)", func(t *testing.T) {
+		// Act
+		calldata, err := encode.Calldata(
+			"g(uint256[][] values, string[] memory names)",
+			[][]int{{1, 2}, {3}},
+			[]string{"one", "two", "three"},
+		)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, words(
+			"2289b18c",
+			"0000000000000000000000000000000000000000000000000000000000000040",
+			"0000000000000000000000000000000000000000000000000000000000000140",
+			"0000000000000000000000000000000000000000000000000000000000000002",
+			"0000000000000000000000000000000000000000000000000000000000000040",
+			"00000000000000000000000000000000000000000000000000000000000000a0",
+			"0000000000000000000000000000000000000000000000000000000000000002",
+			"0000000000000000000000000000000000000000000000000000000000000001",
+			"0000000000000000000000000000000000000000000000000000000000000002",
+			"0000000000000000000000000000000000000000000000000000000000000001",
+			"0000000000000000000000000000000000000000000000000000000000000003",
+			"0000000000000000000000000000000000000000000000000000000000000003",
+			"0000000000000000000000000000000000000000000000000000000000000060",
+			"00000000000000000000000000000000000000000000000000000000000000a0",
+			"00000000000000000000000000000000000000000000000000000000000000e0",
+			"0000000000000000000000000000000000000000000000000000000000000003",
+			"6f6e650000000000000000000000000000000000000000000000000000000000",
+			"0000000000000000000000000000000000000000000000000000000000000003",
+			"74776f0000000000000000000000000000000000000000000000000000000000",
+			"0000000000000000000000000000000000000000000000000000000000000005",
+			"7468726565000000000000000000000000000000000000000000000000000000",
+		), calldata)
+	})
+
+	t.Run("matches the hand-written layout", func(t *testing.T) {
+		// Arrange
+		owner := "0xabcdef1234567890abcdef1234567890abcdef12"
+
+		// Act
+		calldata, err := encode.Calldata("balanceOf(address)", owner)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, encode.ReadCalldata(constant.BalanceOfFnSignature, encode.ABIAddress(owner)), calldata)
+	})
+
+	t.Run("invalid signature", func(t *testing.T) {
+		// Act
+		_, err := encode.Calldata("balanceOf")
+
+		// Assert
+		assert.ErrorIs(t, err, constant.ErrInvalidABIType)
+	})
+}
+
+func TestABIPack(t *testing.T) {
+	t.Run("tuple with a dynamic member, intN and bytesN", func(t *testing.T) {
+		// Arrange
+		type order struct {
+			Amount *big.Int
+			Memo   string
+		}
+
+		// Act
+		packed, err := encode.ABIPack(
+			"(uint256,string),int24,bytes4",
+			order{Amount: big.NewInt(1), Memo: "hi"},
+			-1,
+			[4]byte{0xde, 0xad, 0xbe, 0xef},
+		)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, words(
+			"0000000000000000000000000000000000000000000000000000000000000060",
+			"ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff",
+			"deadbeef00000000000000000000000000000000000000000000000000000000",
+			"0000000000000000000000000000000000000000000000000000000000000001",
+			"0000000000000000000000000000000000000000000000000000000000000040",
+			"0000000000000000000000000000000000000000000000000000000000000002",
+			"6869000000000000000000000000000000000000000000000000000000000000",
+		), packed)
+	})
+
+	t.Run("a []any stands for a tuple", func(t *testing.T) {
+		// Act
+		fromStruct, err1 := encode.ABIPack("(address,uint8)[2]", [2]struct {
+			Token common.Address
+			Fee   uint8
+		}{{common.HexToAddress("0x01"), 1}, {common.HexToAddress("0x02"), 2}})
+		fromSlice, err2 := encode.ABIPack("(address,uint8)[2]", []any{
+			[]any{"0x0000000000000000000000000000000000000001", 1},
+			[]any{"0x0000000000000000000000000000000000000002", 2},
+		})
+
+		// Assert
+		assert.NoError(t, err1)
+		assert.NoError(t, err2)
+		assert.Equal(t, fromStruct, fromSlice)
+		assert.Len(t, fromSlice, 4*constant.ABIWordSize)
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		// Act
+		_, errCount := encode.ABIPack("uint256,uint256", 1)
+		_, errType := encode.ABIPack("uint256,", 1)
+		_, errOverflow := encode.ABIPack("uint8", 256)
+		_, errValue := encode.ABIPack("address", true)
+
+		// Assert
+		assert.ErrorIs(t, errCount, constant.ErrABIValueMismatch)
+		assert.ErrorIs(t, errType, constant.ErrInvalidABIType)
+		assert.ErrorIs(t, errOverflow, constant.ErrOverFlow)
+		assert.ErrorIs(t, errValue, constant.ErrABIValueMismatch)
+	})
+}
+
+func TestABIPackStruct(t *testing.T) {
+	t.Run("fields are the arguments, typed by tag or Go type", func(t *testing.T) {
+		// Arrange
+		type swap struct {
+			TokenIn common.Address
+			Fee     *big.Int `abi:"uint24"`
+			Path    []common.Address
+			note    string
+		}
+		v := swap{
+			TokenIn: common.HexToAddress("0x01"),
+			Fee:     big.NewInt(3000),
+			Path:    []common.Address{common.HexToAddress("0x02")},
+		}
+
+		// Act
+		packed, err := encode.ABIPackStruct(&v)
+
+		// Assert
+		assert.NoError(t, err)
+		expected, _ := encode.ABIPack("address,uint24,address[]", v.TokenIn, v.Fee, v.Path)
+		assert.Equal(t, expected, packed)
+	})
+
+	t.Run("not a struct", func(t *testing.T) {
+		// Act
+		_, err := encode.ABIPackStruct(1)
+
+		// Assert
+		assert.ErrorIs(t, err, constant.ErrABIValueMismatch)
+	})
+}
@@ -3,6 +3,7 @@ package ether
 import (
 	"context"
 	"errors"
+	"math"
 	"math/big"
 	"net/http"
 	"strings"
@@ -36,6 +37,7 @@ type Ether struct {
 	clientCreatedAt int64
 	mu              *sync.Mutex
 	httpClient      *http.Client // shared across all rpc.Client creations
+	wsEpoch         uint64       // bumped by Shutdown so ws subscriptions stop redialing
 
 	// simulated backend.
 	// Held as interfaces so this package never imports ethclient/simulated;
@@ -84,7 +86,7 @@ func NewSimulatedEtherApi(backend types.SimulatedBackend, client types.EthClient
 		// The simulated backend is in-process, so only the request timeout and
 		// backoff config (used by the geth-request dispatcher) matter here.
 		// A zero timeout would make every call deadline-exceed immediately.
-		config:     NewEtherApiConfig("", 0, 10*time.Second, &types.DefaultBackoffConfig, nil, nil, 0, nil),
+		config:     NewEtherApiConfig("", 0, 10*time.Second, &types.DefaultBackoffConfig, nil, nil, 0, nil, nil, nil),
 		simBackend: backend,
 		connCount:  0,
 		client:     nil,
@@ -292,6 +294,8 @@ func (ether *Ether) Shutdown() {
 	ether.mu.Lock()
 	defer ether.mu.Unlock()
 
+	ether.wsEpoch++
+
 	if ether.client == nil {
 		return
 	}
@@ -315,15 +319,32 @@ func (ether *Ether) Network() types.Network {
 	return ether.provider.Network()
 }
 
+// sender is the Send of the provider, with or without the caller's ctx, so
+// the Send backed methods share one body across their ctx and ctx-less forms.
+type sender func(method string, params types.RequestArgs) (any, error)
+
+func (ether *Ether) sendContext(ctx context.Context) sender {
+	return func(method string, params types.RequestArgs) (any, error) {
+		return ether.provider.SendContext(ctx, method, params)
+	}
+}
+
 /*
 BatchCall sends multiple JSON-RPC requests in a single HTTP round-trip using
 geth's underlying rpc.Client.
 
 Each element's Result/Error is populated in place (geth semantics): a per-request
-RPC error is stored on the element's Error field, while the returned error is only
-set for I/O level failures. Backoff retry therefore applies to I/O failures only.
+RPC error is stored on the element's Error field as a *types.RpcError, while the
+returned error is only set for I/O level failures. Backoff retry therefore applies to I/O failures only.
+
+Middlewares see the whole batch once as constant.Rpc_Batch, with elems as the
+only param.
 */
 func (ether *Ether) BatchCall(elems []rpc.BatchElem) error {
+	return ether.BatchCallContext(context.Background(), elems)
+}
+
+func (ether *Ether) BatchCallContext(ctx context.Context, elems []rpc.BatchElem) error {
 	if err := ether.SetEthClient(); err != nil {
 		return err
 	}
@@ -334,15 +355,24 @@ func (ether *Ether) BatchCall(elems []rpc.BatchElem) error {
 		return constant.ErrUnSupportSimulatedMethod
 	}
 
-	return internal.GethRequestSingleErrorWithBackOff(
-		ether.config.backoffConfig,
-		ether.config.requestTimeout,
+	err := gethRequestSingleError(
+		ctx,
+		ether,
+		constant.Rpc_Batch,
 		c.Client().BatchCallContext,
 		elems,
 	)
+	for i := range elems {
+		elems[i].Error = utils.RpcErrorOf(elems[i].Method, elems[i].Error)
+	}
+	return err
 }
 
 func (ether *Ether) BlockNumber() (uint64, error) {
+	return ether.BlockNumberContext(context.Background())
+}
+
+func (ether *Ether) BlockNumberContext(ctx context.Context) (uint64, error) {
 	err := ether.SetEthClient()
 	if err != nil {
 		return uint64(0), err
@@ -350,9 +380,10 @@ func (ether *Ether) BlockNumber() (uint64, error) {
 	defer ether.Close()
 
 	c := ether.Client()
-	res, err := internal.GethRequestWithBackOff(
-		ether.config.backoffConfig,
-		ether.config.requestTimeout,
+	res, err := gethRequest(
+		ctx,
+		ether,
+		constant.Eth_BlockNumber,
 		c.BlockNumber,
 	)
 	if err != nil {
@@ -363,6 +394,10 @@ func (ether *Ether) BlockNumber() (uint64, error) {
 }
 
 func (ether *Ether) GasPrice() (*big.Int, error) {
+	return ether.GasPriceContext(context.Background())
+}
+
+func (ether *Ether) GasPriceContext(ctx context.Context) (*big.Int, error) {
 	err := ether.SetEthClient()
 	if err != nil {
 		return nil, err
@@ -370,9 +405,10 @@ func (ether *Ether) GasPrice() (*big.Int, error) {
 	defer ether.Close()
 
 	c := ether.Client()
-	res, err := internal.GethRequestWithBackOff(
-		ether.config.backoffConfig,
-		ether.config.requestTimeout,
+	res, err := gethRequest(
+		ctx,
+		ether,
+		constant.Eth_GasPrice,
 		c.SuggestGasPrice,
 	)
 	if err != nil {
@@ -383,6 +419,10 @@ func (ether *Ether) GasPrice() (*big.Int, error) {
 }
 
 func (ether *Ether) GetBalance(address, blockTag string) (*big.Int, error) {
+	return ether.GetBalanceContext(context.Background(), address, blockTag)
+}
+
+func (ether *Ether) GetBalanceContext(ctx context.Context, address, blockTag string) (*big.Int, error) {
 	err := ether.SetEthClient()
 	if err != nil {
 		return nil, err
@@ -395,9 +435,10 @@ func (ether *Ether) GetBalance(address, blockTag string) (*big.Int, error) {
 	}
 
 	c := ether.Client()
-	res, err := internal.GethRequestTwoArgWithBackOff(
-		ether.config.backoffConfig,
-		ether.config.requestTimeout,
+	res, err := gethRequestTwoArg(
+		ctx,
+		ether,
+		constant.Eth_GetBalance,
 		c.BalanceAt,
 		common.HexToAddress(address),
 		blockNumber,
@@ -410,6 +451,10 @@ func (ether *Ether) GetBalance(address, blockTag string) (*big.Int, error) {
 }
 
 func (ether *Ether) CodeAt(address string, blockTag string) (string, error) {
+	return ether.CodeAtContext(context.Background(), address, blockTag)
+}
+
+func (ether *Ether) CodeAtContext(ctx context.Context, address string, blockTag string) (string, error) {
 	err := ether.SetEthClient()
 	if err != nil {
 		return "", err
@@ -422,9 +467,10 @@ func (ether *Ether) CodeAt(address string, blockTag string) (string, error) {
 	}
 
 	c := ether.Client()
-	code, err := internal.GethRequestTwoArgWithBackOff(
-		ether.config.backoffConfig,
-		ether.config.requestTimeout,
+	code, err := gethRequestTwoArg(
+		ctx,
+		ether,
+		constant.Eth_GetCode,
 		c.CodeAt,
 		common.HexToAddress(address),
 		blockNumber,
@@ -437,6 +483,10 @@ func (ether *Ether) CodeAt(address string, blockTag string) (string, error) {
 }
 
 func (ether *Ether) CodeAtHash(address string, blockHash string) (string, error) {
+	return ether.CodeAtHashContext(context.Background(), address, blockHash)
+}
+
+func (ether *Ether) CodeAtHashContext(ctx context.Context, address string, blockHash string) (string, error) {
 	err := ether.SetEthClient()
 	if err != nil {
 		return "", err
@@ -448,9 +498,10 @@ func (ether *Ether) CodeAtHash(address string, blockHash string) (string, error)
 		return "", constant.ErrUnSupportSimulatedMethod
 	}
 
-	code, err := internal.GethRequestTwoArgWithBackOff(
-		ether.config.backoffConfig,
-		ether.config.requestTimeout,
+	code, err := gethRequestTwoArg(
+		ctx,
+		ether,
+		constant.Eth_GetCode,
 		c.CodeAtHash,
 		common.HexToAddress(address),
 		common.HexToHash(blockHash),
@@ -463,6 +514,10 @@ func (ether *Ether) CodeAtHash(address string, blockHash string) (string, error)
 }
 
 func (ether *Ether) GetTransaction(hash string) (*gethTypes.Transaction, bool, error) {
+	return ether.GetTransactionContext(context.Background(), hash)
+}
+
+func (ether *Ether) GetTransactionContext(ctx context.Context, hash string) (*gethTypes.Transaction, bool, error) {
 	err := ether.SetEthClient()
 	if err != nil {
 		return nil, false, err
@@ -470,9 +525,10 @@ func (ether *Ether) GetTransaction(hash string) (*gethTypes.Transaction, bool, e
 	defer ether.Close()
 
 	c := ether.Client()
-	tx, isPending, err := internal.GethRequestArgWithBackOffTuple(
-		ether.config.backoffConfig,
-		ether.config.requestTimeout,
+	tx, isPending, err := gethRequestArgTuple(
+		ctx,
+		ether,
+		constant.Eth_GetTransactionByHash,
 		c.TransactionByHash,
 		common.HexToHash(hash),
 	)
@@ -484,6 +540,10 @@ func (ether *Ether) GetTransaction(hash string) (*gethTypes.Transaction, bool, e
 }
 
 func (ether *Ether) StorageAt(address, position, blockTag string) (string, error) {
+	return ether.StorageAtContext(context.Background(), address, position, blockTag)
+}
+
+func (ether *Ether) StorageAtContext(ctx context.Context, address, position, blockTag string) (string, error) {
 	err := ether.SetEthClient()
 	if err != nil {
 		return "", err
@@ -498,9 +558,10 @@ func (ether *Ether) StorageAt(address, position, blockTag string) (string, error
 	}
 
 	c := ether.Client()
-	res, err := internal.GethRequestThreeArgWithBackOff(
-		ether.config.backoffConfig,
-		ether.config.requestTimeout,
+	res, err := gethRequestThreeArg(
+		ctx,
+		ether,
+		constant.Eth_GetStorageAt,
 		c.StorageAt,
 		account,
 		key,
@@ -514,15 +575,49 @@ func (ether *Ether) StorageAt(address, position, blockTag string) (string, error
 }
 
 func (ether *Ether) GetTokenBalances(address string, params ...string) (types.TokenBalanceResponse, error) {
+	return getTokenBalances(ether.provider.Send, address, params...)
+}
+
+func (ether *Ether) GetTokenBalancesContext(ctx context.Context, address string, params ...string) (types.TokenBalanceResponse, error) {
+	return getTokenBalances(ether.sendContext(ctx), address, params...)
+}
+
+func getTokenBalances(send sender, address string, params ...string) (types.TokenBalanceResponse, error) {
 	paramsAny := make([]any, len(params)+1)
 	paramsAny[0] = strings.ToLower(address)
 	for i, param := range params {
 		paramsAny[i+1] = param
 	}
+	return sendTokenBalances(send, paramsAny)
+}
 
-	result, err := ether.provider.Send(
+// tokenBalancesOptions is the options object of alchemy_getTokenBalances.
+type tokenBalancesOptions struct {
+	PageKey  string `json:"pageKey,omitempty"`
+	MaxCount int    `json:"maxCount,omitempty"`
+}
+
+func (ether *Ether) GetTokenBalancesPage(address string, pageKey string, maxCount int) (types.TokenBalanceResponse, error) {
+	return getTokenBalancesPage(ether.provider.Send, address, pageKey, maxCount)
+}
+
+func (ether *Ether) GetTokenBalancesPageContext(ctx context.Context, address string, pageKey string, maxCount int) (types.TokenBalanceResponse, error) {
+	return getTokenBalancesPage(ether.sendContext(ctx), address, pageKey, maxCount)
+}
+
+func getTokenBalancesPage(send sender, address string, pageKey string, maxCount int) (types.TokenBalanceResponse, error) {
+	// only the "erc20" token spec pages
+	return sendTokenBalances(send, types.RequestArgs{
+		strings.ToLower(address),
+		"erc20",
+		tokenBalancesOptions{PageKey: pageKey, MaxCount: maxCount},
+	})
+}
+
+func sendTokenBalances(send sender, params types.RequestArgs) (types.TokenBalanceResponse, error) {
+	result, err := send(
 		constant.Alchemy_GetTokenBalances,
-		paramsAny,
+		params,
 	)
 	if err != nil {
 		return types.TokenBalanceResponse{}, err
@@ -561,7 +656,15 @@ func (ether *Ether) GetTokenBalances(address string, params ...string) (types.To
 }
 
 func (ether *Ether) GetTokenMetadata(address string) (types.TokenMetadataResponse, error) {
-	result, err := ether.provider.Send(
+	return getTokenMetadata(ether.provider.Send, address)
+}
+
+func (ether *Ether) GetTokenMetadataContext(ctx context.Context, address string) (types.TokenMetadataResponse, error) {
+	return getTokenMetadata(ether.sendContext(ctx), address)
+}
+
+func getTokenMetadata(send sender, address string) (types.TokenMetadataResponse, error) {
+	result, err := send(
 		constant.Alchemy_GetTokenMetadata,
 		types.RequestArgs{
 			strings.ToLower(address),
@@ -584,7 +687,15 @@ func (ether *Ether) GetTokenMetadata(address string) (types.TokenMetadataRespons
 }
 
 func (ether *Ether) GetLogs(filter types.Filter) ([]types.LogResponse, error) {
-	result, err := ether.provider.Send(
+	return getLogs(ether.provider.Send, filter)
+}
+
+func (ether *Ether) GetLogsContext(ctx context.Context, filter types.Filter) ([]types.LogResponse, error) {
+	return getLogs(ether.sendContext(ctx), filter)
+}
+
+func getLogs(send sender, filter types.Filter) ([]types.LogResponse, error) {
+	result, err := send(
 		constant.Eth_GetLogs,
 		types.RequestArgs{
 			filter,
@@ -615,6 +726,10 @@ func (ether *Ether) GetLogs(filter types.Filter) ([]types.LogResponse, error) {
 }
 
 func (ether *Ether) EstimateGas(tx types.TransactionRequest) (*big.Int, error) {
+	return ether.EstimateGasContext(context.Background(), tx)
+}
+
+func (ether *Ether) EstimateGasContext(ctx context.Context, tx types.TransactionRequest) (*big.Int, error) {
 	err := ether.SetEthClient()
 	if err != nil {
 		return nil, err
@@ -628,9 +743,10 @@ func (ether *Ether) EstimateGas(tx types.TransactionRequest) (*big.Int, error) {
 	}
 
 	c := ether.Client()
-	res, err := internal.GethRequestArgWithBackOff(
-		ether.config.backoffConfig,
-		ether.config.requestTimeout,
+	res, err := gethRequestArg(
+		ctx,
+		ether,
+		constant.Eth_EstimateGas,
 		c.EstimateGas,
 		ethereum.CallMsg{
 			From:  common.HexToAddress(tx.From),
@@ -640,7 +756,7 @@ func (ether *Ether) EstimateGas(tx types.TransactionRequest) (*big.Int, error) {
 		},
 	)
 	if err != nil {
-		return nil, err
+		return nil, ether.revertError(err)
 	}
 
 	// NOTE: this is false positive
@@ -649,6 +765,10 @@ func (ether *Ether) EstimateGas(tx types.TransactionRequest) (*big.Int, error) {
 }
 
 func (ether *Ether) SuggestGasPrice() (*big.Int, error) {
+	return ether.SuggestGasPriceContext(context.Background())
+}
+
+func (ether *Ether) SuggestGasPriceContext(ctx context.Context) (*big.Int, error) {
 	err := ether.SetEthClient()
 	if err != nil {
 		return nil, err
@@ -656,9 +776,10 @@ func (ether *Ether) SuggestGasPrice() (*big.Int, error) {
 	defer ether.Close()
 
 	c := ether.Client()
-	res, err := internal.GethRequestWithBackOff(
-		ether.config.backoffConfig,
-		ether.config.requestTimeout,
+	res, err := gethRequest(
+		ctx,
+		ether,
+		constant.Eth_GasPrice,
 		c.SuggestGasPrice,
 	)
 	if err != nil {
@@ -669,15 +790,20 @@ func (ether *Ether) SuggestGasPrice() (*big.Int, error) {
 }
 
 func (ether *Ether) SuggestGasTipCap() (*big.Int, error) {
+	return ether.SuggestGasTipCapContext(context.Background())
+}
+
+func (ether *Ether) SuggestGasTipCapContext(ctx context.Context) (*big.Int, error) {
 	if err := ether.SetEthClient(); err != nil {
 		return nil, err
 	}
 	defer ether.Close()
 
 	c := ether.Client()
-	tip, err := internal.GethRequestWithBackOff(
-		ether.config.backoffConfig,
-		ether.config.requestTimeout,
+	tip, err := gethRequest(
+		ctx,
+		ether,
+		constant.Eth_MaxPriorityFeePerGas,
 		c.SuggestGasTipCap,
 	)
 	if err != nil {
@@ -687,6 +813,10 @@ func (ether *Ether) SuggestGasTipCap() (*big.Int, error) {
 }
 
 func (ether *Ether) SuggestEIP1559Fees() (*big.Int, *big.Int, error) {
+	return ether.SuggestEIP1559FeesContext(context.Background())
+}
+
+func (ether *Ether) SuggestEIP1559FeesContext(ctx context.Context) (*big.Int, *big.Int, error) {
 	if err := ether.SetEthClient(); err != nil {
 		return nil, nil, err
 	}
@@ -694,20 +824,23 @@ func (ether *Ether) SuggestEIP1559Fees() (*big.Int, *big.Int, error) {
 
 	c := ether.Client()
 
-	tip, err := internal.GethRequestWithBackOff(
-		ether.config.backoffConfig,
-		ether.config.requestTimeout,
+	tip, err := gethRequest(
+		ctx,
+		ether,
+		constant.Eth_MaxPriorityFeePerGas,
 		c.SuggestGasTipCap,
 	)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	header, err := internal.GethRequestArgWithBackOff(
-		ether.config.backoffConfig,
-		ether.config.requestTimeout,
-		c.HeaderByNumber,
+	header, err := gethRequestTwoArg(
+		ctx,
+		ether,
+		constant.Eth_GetBlockByNumber,
+		withFullTx(c.HeaderByNumber),
 		(*big.Int)(nil),
+		false,
 	)
 	if err != nil {
 		return nil, nil, err
@@ -721,11 +854,21 @@ func (ether *Ether) SuggestEIP1559Fees() (*big.Int, *big.Int, error) {
 }
 
 func (ether *Ether) Call(tx types.TransactionRequest, blockTag string) (string, error) {
+	result, err := call(ether.provider.Send, tx, blockTag)
+	return result, ether.revertError(err)
+}
+
+func (ether *Ether) CallContext(ctx context.Context, tx types.TransactionRequest, blockTag string) (string, error) {
+	result, err := call(ether.sendContext(ctx), tx, blockTag)
+	return result, ether.revertError(err)
+}
+
+func call(send sender, tx types.TransactionRequest, blockTag string) (string, error) {
 	if err := validate.BlockTag(blockTag); err != nil {
 		return "", err
 	}
 
-	result, err := ether.provider.Send(constant.Eth_Call, types.RequestArgs{
+	result, err := send(constant.Eth_Call, types.RequestArgs{
 		tx,
 		blockTag,
 	})
@@ -743,6 +886,14 @@ func (ether *Ether) Call(tx types.TransactionRequest, blockTag string) (string,
 func (ether *Ether) CallContract(
 	msg ethereum.CallMsg,
 	blockTag string,
+) ([]byte, error) {
+	return ether.CallContractContext(context.Background(), msg, blockTag)
+}
+
+func (ether *Ether) CallContractContext(
+	ctx context.Context,
+	msg ethereum.CallMsg,
+	blockTag string,
 ) ([]byte, error) {
 	err := ether.SetEthClient()
 	if err != nil {
@@ -756,21 +907,26 @@ func (ether *Ether) CallContract(
 	}
 
 	c := ether.Client()
-	output, err := internal.GethRequestTwoArgWithBackOff(
-		ether.config.backoffConfig,
-		ether.config.requestTimeout,
+	output, err := gethRequestTwoArg(
+		ctx,
+		ether,
+		constant.Eth_Call,
 		c.CallContract,
 		msg,
 		blockNumber,
 	)
 	if err != nil {
-		return nil, err
+		return nil, ether.revertError(err)
 	}
 
 	return output, nil
 }
 
 func (ether *Ether) GetTransactionReceipt(hash string) (*gethTypes.Receipt, error) {
+	return ether.GetTransactionReceiptContext(context.Background(), hash)
+}
+
+func (ether *Ether) GetTransactionReceiptContext(ctx context.Context, hash string) (*gethTypes.Receipt, error) {
 	err := ether.SetEthClient()
 	if err != nil {
 		return nil, err
@@ -778,9 +934,10 @@ func (ether *Ether) GetTransactionReceipt(hash string) (*gethTypes.Receipt, erro
 	defer ether.Close()
 
 	c := ether.Client()
-	txReceipt, err := internal.GethRequestArgWithBackOff(
-		ether.config.backoffConfig,
-		ether.config.requestTimeout,
+	txReceipt, err := gethRequestArg(
+		ctx,
+		ether,
+		constant.Eth_GetTransactionReceipt,
 		c.TransactionReceipt,
 		common.HexToHash(hash),
 	)
@@ -792,7 +949,15 @@ func (ether *Ether) GetTransactionReceipt(hash string) (*gethTypes.Receipt, erro
 }
 
 func (ether *Ether) GetTransactionReceipts(arg types.BlockNumberOrHash) ([]*gethTypes.Receipt, error) {
-	result, err := ether.provider.Send(constant.Alchemy_TransactionReceipts, types.RequestArgs{
+	return getTransactionReceipts(ether.provider.Send, arg)
+}
+
+func (ether *Ether) GetTransactionReceiptsContext(ctx context.Context, arg types.BlockNumberOrHash) ([]*gethTypes.Receipt, error) {
+	return getTransactionReceipts(ether.sendContext(ctx), arg)
+}
+
+func getTransactionReceipts(send sender, arg types.BlockNumberOrHash) ([]*gethTypes.Receipt, error) {
+	result, err := send(constant.Alchemy_TransactionReceipts, types.RequestArgs{
 		arg,
 	})
 	if err != nil {
@@ -820,6 +985,10 @@ func (ether *Ether) GetTransactionReceipts(arg types.BlockNumberOrHash) ([]*geth
 }
 
 func (ether *Ether) GetBlockByNumber(blockNumber string) (*gethTypes.Block, error) {
+	return ether.GetBlockByNumberContext(context.Background(), blockNumber)
+}
+
+func (ether *Ether) GetBlockByNumberContext(ctx context.Context, blockNumber string) (*gethTypes.Block, error) {
 	err := ether.SetEthClient()
 	if err != nil {
 		return nil, err
@@ -832,11 +1001,13 @@ func (ether *Ether) GetBlockByNumber(blockNumber string) (*gethTypes.Block, erro
 	}
 
 	c := ether.Client()
-	res, err := internal.GethRequestArgWithBackOff(
-		ether.config.backoffConfig,
-		ether.config.requestTimeout,
-		c.BlockByNumber,
+	res, err := gethRequestTwoArg(
+		ctx,
+		ether,
+		constant.Eth_GetBlockByNumber,
+		withFullTx(c.BlockByNumber),
 		bigBlockNumber,
+		true,
 	)
 	if err != nil {
 		return nil, err
@@ -850,6 +1021,10 @@ func (ether *Ether) GetBlockByNumber(blockNumber string) (*gethTypes.Block, erro
 }
 
 func (ether *Ether) GetBlockByHash(blockHash string) (*gethTypes.Block, error) {
+	return ether.GetBlockByHashContext(context.Background(), blockHash)
+}
+
+func (ether *Ether) GetBlockByHashContext(ctx context.Context, blockHash string) (*gethTypes.Block, error) {
 	err := ether.SetEthClient()
 	if err != nil {
 		return nil, err
@@ -857,11 +1032,13 @@ func (ether *Ether) GetBlockByHash(blockHash string) (*gethTypes.Block, error) {
 	defer ether.Close()
 
 	c := ether.Client()
-	res, err := internal.GethRequestArgWithBackOff(
-		ether.config.backoffConfig,
-		ether.config.requestTimeout,
-		c.BlockByHash,
+	res, err := gethRequestTwoArg(
+		ctx,
+		ether,
+		constant.Eth_GetBlockByHash,
+		withFullTx(c.BlockByHash),
 		common.HexToHash(blockHash),
+		true,
 	)
 	if err != nil {
 		return nil, err
@@ -880,6 +1057,10 @@ This is the nonce that should be used for the next transaction.
 internal call geth
 */
 func (ether *Ether) PendingNonceAt(address string) (uint64, error) {
+	return ether.PendingNonceAtContext(context.Background(), address)
+}
+
+func (ether *Ether) PendingNonceAtContext(ctx context.Context, address string) (uint64, error) {
 	err := ether.SetEthClient()
 	if err != nil {
 		return uint64(0), err
@@ -887,9 +1068,10 @@ func (ether *Ether) PendingNonceAt(address string) (uint64, error) {
 	defer ether.Close()
 
 	c := ether.Client()
-	nonce, err := internal.GethRequestArgWithBackOff(
-		ether.config.backoffConfig,
-		ether.config.requestTimeout,
+	nonce, err := gethRequestArg(
+		ctx,
+		ether,
+		constant.Eth_GetTransactionCount,
 		c.PendingNonceAt,
 		common.HexToAddress(address),
 	)
@@ -900,8 +1082,50 @@ func (ether *Ether) PendingNonceAt(address string) (uint64, error) {
 	return nonce, nil
 }
 
+/*
+NonceAt returns the account nonce of the given account at blockTag:
+the number of its txs mined by then.
+
+internal call geth
+*/
+func (ether *Ether) NonceAt(address string, blockTag string) (uint64, error) {
+	return ether.NonceAtContext(context.Background(), address, blockTag)
+}
+
+func (ether *Ether) NonceAtContext(ctx context.Context, address string, blockTag string) (uint64, error) {
+	err := ether.SetEthClient()
+	if err != nil {
+		return uint64(0), err
+	}
+	defer ether.Close()
+
+	blockNumber, err := utils.ToBlockNumber(blockTag)
+	if err != nil {
+		return uint64(0), err
+	}
+
+	c := ether.Client()
+	nonce, err := gethRequestTwoArg(
+		ctx,
+		ether,
+		constant.Eth_GetTransactionCount,
+		c.NonceAt,
+		common.HexToAddress(address),
+		blockNumber,
+	)
+	if err != nil {
+		return uint64(0), err
+	}
+
+	return nonce, nil
+}
+
 // send signed tx into the pending pool for execution w/ geth
 func (ether *Ether) SendRawTransaction(signedTx *gethTypes.Transaction) error {
+	return ether.SendRawTransactionContext(context.Background(), signedTx)
+}
+
+func (ether *Ether) SendRawTransactionContext(ctx context.Context, signedTx *gethTypes.Transaction) error {
 	err := ether.SetEthClient()
 	if err != nil {
 		return err
@@ -909,9 +1133,10 @@ func (ether *Ether) SendRawTransaction(signedTx *gethTypes.Transaction) error {
 	defer ether.Close()
 
 	c := ether.Client()
-	err = internal.GethRequestSingleErrorWithBackOff(
-		ether.config.backoffConfig,
-		ether.config.requestTimeout,
+	err = gethRequestSingleError(
+		ctx,
+		ether,
+		constant.Eth_SendRawTransaction,
 		c.SendTransaction,
 		signedTx,
 	)
@@ -923,6 +1148,10 @@ func (ether *Ether) SendRawTransaction(signedTx *gethTypes.Transaction) error {
 }
 
 func (ether *Ether) ChainID() (*big.Int, error) {
+	return ether.ChainIDContext(context.Background())
+}
+
+func (ether *Ether) ChainIDContext(ctx context.Context) (*big.Int, error) {
 	err := ether.SetEthClient()
 	if err != nil {
 		return nil, err
@@ -930,9 +1159,10 @@ func (ether *Ether) ChainID() (*big.Int, error) {
 	defer ether.Close()
 
 	c := ether.Client()
-	res, err := internal.GethRequestWithBackOff(
-		ether.config.backoffConfig,
-		ether.config.requestTimeout,
+	res, err := gethRequest(
+		ctx,
+		ether,
+		constant.Eth_ChainId,
 		c.ChainID,
 	)
 	if err != nil {
@@ -943,6 +1173,10 @@ func (ether *Ether) ChainID() (*big.Int, error) {
 }
 
 func (ether *Ether) PeerCount() (uint64, error) {
+	return ether.PeerCountContext(context.Background())
+}
+
+func (ether *Ether) PeerCountContext(ctx context.Context) (uint64, error) {
 	err := ether.SetEthClient()
 	if err != nil {
 		return 0, err
@@ -954,9 +1188,10 @@ func (ether *Ether) PeerCount() (uint64, error) {
 		return 0, constant.ErrUnSupportSimulatedMethod
 	}
 
-	res, err := internal.GethRequestWithBackOff(
-		ether.config.backoffConfig,
-		ether.config.requestTimeout,
+	res, err := gethRequest(
+		ctx,
+		ether,
+		constant.Net_PeerCount,
 		c.PeerCount,
 	)
 	if err != nil {
@@ -966,7 +1201,6 @@ func (ether *Ether) PeerCount() (uint64, error) {
 	return res, nil
 }
 
-// TODO: backoff
 func (ether *Ether) DeployContract(
 	auth *bind.TransactOpts,
 	metaData *bind.MetaData,
@@ -981,8 +1215,7 @@ func (ether *Ether) DeployContract(
 	deployParams := bind.DeploymentParams{
 		Contracts: []*bind.MetaData{metaData},
 	}
-	c := ether.Client()
-	deployer := bind.DefaultDeployer(auth, c)
+	deployer := bind.DefaultDeployer(auth, ether.boundBackend())
 
 	// create and submit the contract deployment
 	deployRes, err := bind.LinkAndDeploy(&deployParams, deployer)
@@ -1000,7 +1233,6 @@ func rawBoundContract(addr common.Address, backend bind.ContractBackend) *bind.B
 	return bind.NewBoundContract(addr, abi.ABI{}, backend, backend, backend)
 }
 
-// TODO: backoff
 func (ether *Ether) ContractTransact(auth *bind.TransactOpts, contractAddress string, data []byte) (*gethTypes.Transaction, error) {
 	err := ether.SetEthClient()
 	if err != nil {
@@ -1008,13 +1240,14 @@ func (ether *Ether) ContractTransact(auth *bind.TransactOpts, contractAddress st
 	}
 	defer ether.Close()
 
-	instance := rawBoundContract(common.HexToAddress(contractAddress), ether.Client())
+	instance := rawBoundContract(common.HexToAddress(contractAddress), ether.boundBackend())
 
 	tx, err := bind.Transact(
 		instance, auth, data,
 	)
 	if err != nil {
-		return nil, err
+		// bind estimates the gas first: a revert surfaces from eth_estimateGas
+		return nil, ether.revertError(err)
 	}
 
 	return tx, nil
@@ -1045,7 +1278,6 @@ func (ether *Ether) simulatedMined(txHash common.Hash) (*gethTypes.Receipt, erro
 	return ether.GetTransactionReceipt(txHash.Hex())
 }
 
-// TODO: support backoff
 func (ether *Ether) WaitMined(ctx context.Context, txHash common.Hash) (*gethTypes.Receipt, error) {
 	if ether.simBackend != nil {
 		return ether.simulatedMined(txHash)
@@ -1057,8 +1289,7 @@ func (ether *Ether) WaitMined(ctx context.Context, txHash common.Hash) (*gethTyp
 	}
 	defer ether.Close()
 
-	c := ether.Client()
-	tx, err := bind.WaitMined(ctx, c, txHash)
+	tx, err := bind.WaitMined(ctx, ether.boundBackend(), txHash)
 	if err != nil {
 		return nil, err
 	}
@@ -1090,8 +1321,7 @@ func (ether *Ether) WaitDeployed(ctx context.Context, txHash common.Hash) (commo
 	}
 	defer ether.Close()
 
-	c := ether.Client()
-	address, err := bind.WaitDeployed(ctx, c, txHash)
+	address, err := bind.WaitDeployed(ctx, ether.boundBackend(), txHash)
 	if err != nil {
 		return common.Address{}, err
 	}
@@ -1113,11 +1343,11 @@ func (
 	}
 	defer ether.Close()
 
-	instance := rawBoundContract(contractAddress, ether.Client())
+	instance := rawBoundContract(contractAddress, ether.boundBackend())
 
 	val, err := bind.Call(instance, opts, callData, unpack)
 	if err != nil {
-		return nil, err
+		return nil, ether.revertError(err)
 	}
 
 	return val, nil
@@ -1128,36 +1358,58 @@ func (ether *Ether) CallReadMethod(
 	contractAddress string,
 	args ...[]byte,
 ) ([]byte, error) {
-	data := encode.ReadCalldata(method, args...)
-
-	contractAddr := common.HexToAddress(contractAddress)
-	msg := ethereum.CallMsg{
-		To:   &contractAddr,
-		Data: data,
+	output, err := ether.CallContract(readMethodMsg(method, contractAddress, args...), "latest")
+	if err != nil {
+		return []byte{}, err
 	}
+	return output, nil
+}
 
-	output, err := ether.CallContract(msg, "latest")
+func (ether *Ether) CallReadMethodContext(
+	ctx context.Context,
+	method []byte,
+	contractAddress string,
+	args ...[]byte,
+) ([]byte, error) {
+	output, err := ether.CallContractContext(ctx, readMethodMsg(method, contractAddress, args...), "latest")
 	if err != nil {
 		return []byte{}, err
 	}
 	return output, nil
 }
 
+func readMethodMsg(method []byte, contractAddress string, args ...[]byte) ethereum.CallMsg {
+	contractAddr := common.HexToAddress(contractAddress)
+	return ethereum.CallMsg{
+		To:   &contractAddr,
+		Data: encode.ReadCalldata(method, args...),
+	}
+}
+
 // assetTransfersReq is the JSON-serializable form sent to alchemy_getAssetTransfers.
 type assetTransfersReq struct {
-	FromBlock         string   `json:"fromBlock,omitempty"`
-	ToBlock           string   `json:"toBlock,omitempty"`
-	FromAddress       string   `json:"fromAddress,omitempty"`
-	ToAddress         string   `json:"toAddress,omitempty"`
-	ContractAddresses []string `json:"contractAddresses,omitempty"`
-	Category          []string `json:"category"`
-	WithMetadata      bool     `json:"withMetadata"`
-	ExcludeZeroValue  bool     `json:"excludeZeroValue"`
-	MaxCount          string   `json:"maxCount,omitempty"`
-	PageKey           string   `json:"pageKey,omitempty"`
+	FromBlock         string                        `json:"fromBlock,omitempty"`
+	ToBlock           string                        `json:"toBlock,omitempty"`
+	FromAddress       string                        `json:"fromAddress,omitempty"`
+	ToAddress         string                        `json:"toAddress,omitempty"`
+	ContractAddresses []string                      `json:"contractAddresses,omitempty"`
+	Category          []types.AssetTransferCategory `json:"category"`
+	Order             types.AssetTransferOrder      `json:"order,omitempty"`
+	WithMetadata      bool                          `json:"withMetadata"`
+	ExcludeZeroValue  bool                          `json:"excludeZeroValue"`
+	MaxCount          string                        `json:"maxCount,omitempty"`
+	PageKey           string                        `json:"pageKey,omitempty"`
 }
 
 func (ether *Ether) GetAssetTransfers(params types.AssetTransfersParams) (types.AssetTransfersResponse, error) {
+	return getAssetTransfers(ether.provider.Send, params)
+}
+
+func (ether *Ether) GetAssetTransfersContext(ctx context.Context, params types.AssetTransfersParams) (types.AssetTransfersResponse, error) {
+	return getAssetTransfers(ether.sendContext(ctx), params)
+}
+
+func getAssetTransfers(send sender, params types.AssetTransfersParams) (types.AssetTransfersResponse, error) {
 	req := assetTransfersReq{
 		FromBlock:         params.FromBlock,
 		ToBlock:           params.ToBlock,
@@ -1165,6 +1417,7 @@ func (ether *Ether) GetAssetTransfers(params types.AssetTransfersParams) (types.
 		ToAddress:         strings.ToLower(params.ToAddress),
 		ContractAddresses: params.ContractAddresses,
 		Category:          params.Category,
+		Order:             params.Order,
 		WithMetadata:      params.WithMetadata,
 		ExcludeZeroValue:  params.ExcludeZeroValue,
 		PageKey:           params.PageKey,
@@ -1173,7 +1426,7 @@ func (ether *Ether) GetAssetTransfers(params types.AssetTransfersParams) (types.
 		req.MaxCount = hexutil.EncodeUint64(uint64(params.MaxCount))
 	}
 
-	result, err := ether.provider.Send(
+	result, err := send(
 		constant.Alchemy_GetAssetTransfers,
 		types.RequestArgs{req},
 	)
@@ -1187,10 +1440,48 @@ func (ether *Ether) GetAssetTransfers(params types.AssetTransfersParams) (types.
 	}
 
 	var response types.AssetTransfersResponse
-	// WeakDecode is required: the Alchemy API returns null for some string fields
+	// WeaklyTypedInput is required: the Alchemy API returns null for some string fields
 	// (e.g. erc721TokenId, rawContract.address) which strict Decode cannot coerce to "".
-	if err := mapstructure.WeakDecode(resultMap, &response); err != nil {
+	decoder, err := mapstructure.NewDecoder(&mapstructure.DecoderConfig{
+		WeaklyTypedInput: true,
+		DecodeHook:       mapstructure.StringToTimeHookFunc(time.RFC3339),
+		Result:           &response,
+	})
+	if err != nil {
+		return types.AssetTransfersResponse{}, err
+	}
+	if err := decoder.Decode(resultMap); err != nil {
 		return types.AssetTransfersResponse{}, constant.ErrFailedToMapAssetTransfers
 	}
+
+	for i := range response.Transfers {
+		if err := setExactValue(&response.Transfers[i]); err != nil {
+			return types.AssetTransfersResponse{}, errors.Join(constant.ErrFailedToMapAssetTransfers, err)
+		}
+	}
 	return response, nil
 }
+
+// setExactValue sets RawValue and Decimals from the hex RawContract of
+// transfer.
+func setExactValue(transfer *types.AssetTransfer) error {
+	if value := transfer.RawContract.Value; value != "" {
+		rawValue, err := utils.FromBigHex(value)
+		if err != nil {
+			return err
+		}
+		transfer.RawValue = rawValue
+	}
+	if decimal := transfer.RawContract.Decimal; decimal != "" {
+		decimals, err := utils.FromHexU64(decimal)
+		if err != nil {
+			return err
+		}
+		if decimals > math.MaxUint8 {
+			return constant.ErrOverFlow
+		}
+		d := int(decimals)
+		transfer.Decimals = &d
+	}
+	return nil
+}
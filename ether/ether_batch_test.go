@@ -1,10 +1,12 @@
 package ether_test
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/poteto-go/go-alchemy-sdk/constant"
+	"github.com/poteto-go/go-alchemy-sdk/types"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -64,7 +66,11 @@ func TestEther_BatchCall(t *testing.T) {
 		assert.NoError(t, err)
 		assert.Equal(t, "0x1234", *blockNumber)
 		assert.NoError(t, elems[0].Error)
-		assert.Error(t, elems[1].Error)
+		rpcErr, ok := errors.AsType[*types.RpcError](elems[1].Error)
+		assert.True(t, ok)
+		assert.Equal(t, "eth_gasPrice", rpcErr.Method)
+		assert.Equal(t, -32000, rpcErr.Code)
+		assert.Equal(t, "boom", rpcErr.Message)
 	})
 
 	t.Run("returns error if client cannot be created", func(t *testing.T) {
@@ -0,0 +1,104 @@
+package ether
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/v2"
+	"github.com/ethereum/go-ethereum/common"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/poteto-go/go-alchemy-sdk/constant"
+	"github.com/poteto-go/go-alchemy-sdk/types"
+)
+
+var (
+	_ bind.ContractBackend       = (*boundBackend)(nil)
+	_ bind.DeployBackend         = (*boundBackend)(nil)
+	_ bind.PendingContractCaller = (*boundBackend)(nil)
+)
+
+/*
+boundBackend is the backend handed to bind (deploy, transact, call & wait):
+it runs each call of bind through the middlewares as its JSON-RPC method,
+like the methods of Ether, so a middleware (e.g. the rate limiter) sees the
+eth_estimateGas, eth_sendRawTransaction, eth_call, ... of bind too.
+
+Receipts are not backed off: bind polls them itself until the tx is mined.
+Log subscriptions are not requests, so they go to the client as-is.
+*/
+type boundBackend struct {
+	ether  *Ether
+	client types.EthClient
+}
+
+func (ether *Ether) boundBackend() *boundBackend {
+	return &boundBackend{ether: ether, client: ether.Client()}
+}
+
+func (b *boundBackend) CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) ([]byte, error) {
+	return gethRequestTwoArg(ctx, b.ether, constant.Eth_GetCode, b.client.CodeAt, contract, blockNumber)
+}
+
+func (b *boundBackend) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	return gethRequestTwoArg(ctx, b.ether, constant.Eth_Call, b.client.CallContract, call, blockNumber)
+}
+
+func (b *boundBackend) PendingCodeAt(ctx context.Context, account common.Address) ([]byte, error) {
+	return gethRequestArg(ctx, b.ether, constant.Eth_GetCode, b.client.PendingCodeAt, account)
+}
+
+func (b *boundBackend) PendingCallContract(ctx context.Context, call ethereum.CallMsg) ([]byte, error) {
+	caller, ok := b.client.(bind.PendingContractCaller)
+	if !ok {
+		return nil, bind.ErrNoPendingState
+	}
+	return gethRequestArg(ctx, b.ether, constant.Eth_Call, caller.PendingCallContract, call)
+}
+
+func (b *boundBackend) HeaderByNumber(ctx context.Context, number *big.Int) (*gethTypes.Header, error) {
+	return gethRequestTwoArg(ctx, b.ether, constant.Eth_GetBlockByNumber, withFullTx(b.client.HeaderByNumber), number, false)
+}
+
+func (b *boundBackend) PendingNonceAt(ctx context.Context, account common.Address) (uint64, error) {
+	return gethRequestArg(ctx, b.ether, constant.Eth_GetTransactionCount, b.client.PendingNonceAt, account)
+}
+
+func (b *boundBackend) TransactionByHash(ctx context.Context, hash common.Hash) (*gethTypes.Transaction, bool, error) {
+	return gethRequestArgTuple(ctx, b.ether, constant.Eth_GetTransactionByHash, b.client.TransactionByHash, hash)
+}
+
+func (b *boundBackend) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
+	return gethRequest(ctx, b.ether, constant.Eth_GasPrice, b.client.SuggestGasPrice)
+}
+
+func (b *boundBackend) SuggestGasTipCap(ctx context.Context) (*big.Int, error) {
+	return gethRequest(ctx, b.ether, constant.Eth_MaxPriorityFeePerGas, b.client.SuggestGasTipCap)
+}
+
+func (b *boundBackend) EstimateGas(ctx context.Context, call ethereum.CallMsg) (uint64, error) {
+	return gethRequestArg(ctx, b.ether, constant.Eth_EstimateGas, b.client.EstimateGas, call)
+}
+
+func (b *boundBackend) SendTransaction(ctx context.Context, tx *gethTypes.Transaction) error {
+	return gethRequestSingleError(ctx, b.ether, constant.Eth_SendRawTransaction, b.client.SendTransaction, tx)
+}
+
+func (b *boundBackend) FilterLogs(ctx context.Context, query ethereum.FilterQuery) ([]gethTypes.Log, error) {
+	return gethRequestArg(ctx, b.ether, constant.Eth_GetLogs, b.client.FilterLogs, query)
+}
+
+func (b *boundBackend) SubscribeFilterLogs(ctx context.Context, query ethereum.FilterQuery, ch chan<- gethTypes.Log) (ethereum.Subscription, error) {
+	return b.client.SubscribeFilterLogs(ctx, query, ch)
+}
+
+func (b *boundBackend) TransactionReceipt(ctx context.Context, txHash common.Hash) (*gethTypes.Receipt, error) {
+	res, err := b.ether.intercept(ctx, constant.Eth_GetTransactionReceipt, types.RequestArgs{txHash}, func(ctx context.Context, params types.RequestArgs) (any, error) {
+		txHash, err := paramAt[common.Hash](params, 0)
+		if err != nil {
+			return nil, err
+		}
+		return b.client.TransactionReceipt(ctx, txHash)
+	})
+	return resultAs[*gethTypes.Receipt](res, err)
+}
@@ -1,9 +1,12 @@
 package ether_test
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/poteto-go/go-alchemy-sdk/constant"
+	"github.com/poteto-go/go-alchemy-sdk/types"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -74,14 +77,19 @@ func TestEther_CallReadMethod(t *testing.T) {
 			// Mock
 			alchemyMock.RegisterResponderOnce(
 				"eth_call",
-				`{"jsonrpc":"2.0","id":1,"error":{"code":-32000,"message":"execution reverted"}}`,
+				`{"jsonrpc":"2.0","id":1,"error":{"code":3,"message":"execution reverted","data":"0x08c379a0"}}`,
 			)
 
 			// Act
 			_, err := e.CallReadMethod(method, contractAddress)
 
 			// Assert
-			assert.Error(t, err)
+			rpcErr, ok := errors.AsType[*types.RpcError](err)
+			assert.True(t, ok)
+			assert.Equal(t, constant.Eth_Call, rpcErr.Method)
+			assert.Equal(t, 3, rpcErr.Code)
+			assert.JSONEq(t, `"0x08c379a0"`, string(rpcErr.Data))
+			assert.True(t, rpcErr.IsExecutionReverted())
 		})
 	})
 }
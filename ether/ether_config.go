@@ -4,6 +4,7 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/ethereum/go-ethereum/accounts/abi"
 	"github.com/poteto-go/go-alchemy-sdk/types"
 )
 
@@ -18,6 +19,8 @@ type EtherApiConfig struct {
 	jwtSecret        []byte
 	maxResponseBytes int64
 	transport        http.RoundTripper
+	middlewares      []types.Middleware
+	errorABIs        []abi.ABI
 }
 
 func NewEtherApiConfig(
@@ -29,6 +32,8 @@ func NewEtherApiConfig(
 	jwtSecret []byte,
 	maxResponseBytes int64,
 	transport http.RoundTripper,
+	middlewares []types.Middleware,
+	errorABIs []abi.ABI,
 ) EtherApiConfig {
 	return EtherApiConfig{
 		url:              url,
@@ -39,6 +44,8 @@ func NewEtherApiConfig(
 		jwtSecret:        jwtSecret,
 		maxResponseBytes: maxResponseBytes,
 		transport:        transport,
+		middlewares:      middlewares,
+		errorABIs:        errorABIs,
 	}
 }
 
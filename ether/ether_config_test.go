@@ -31,6 +31,8 @@ func TestNewEtherApiConfig(t *testing.T) {
 		[]byte(""),
 		0,
 		nil,
+		nil,
+		nil,
 	)
 
 	// Assert
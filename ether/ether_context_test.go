@@ -0,0 +1,134 @@
+package ether_test
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/agiledragon/gomonkey"
+	"github.com/ethereum/go-ethereum"
+	"github.com/jarcoal/httpmock"
+	"github.com/poteto-go/go-alchemy-sdk/constant"
+	"github.com/poteto-go/go-alchemy-sdk/ether"
+	"github.com/poteto-go/go-alchemy-sdk/gas"
+	"github.com/poteto-go/go-alchemy-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+type ctxKey struct{}
+
+func callerCtx() context.Context {
+	return context.WithValue(context.Background(), ctxKey{}, "caller")
+}
+
+func TestEther_SendBackedContext(t *testing.T) {
+	t.Run("GetTokenMetadataContext calls SendContext with the caller ctx", func(t *testing.T) {
+		patches := gomonkey.NewPatches()
+		defer patches.Reset()
+
+		// Arrange
+		provider := newProviderForTest()
+		api := newNilEtherApiForTest(provider)
+		var got any
+
+		// Mock
+		patches.ApplyMethod(
+			reflect.TypeOf(provider),
+			"SendContext",
+			func(_ *gas.AlchemyProvider, ctx context.Context, method string, _ types.RequestArgs) (any, error) {
+				got = ctx.Value(ctxKey{})
+				assert.Equal(t, constant.Alchemy_GetTokenMetadata, method)
+				return map[string]any{"name": "USD Coin"}, nil
+			},
+		)
+
+		// Act
+		actual, err := api.GetTokenMetadataContext(callerCtx(), "0x123")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "USD Coin", actual.Name)
+		assert.Equal(t, "caller", got)
+	})
+
+	t.Run("ctx-less form keeps calling Send", func(t *testing.T) {
+		patches := gomonkey.NewPatches()
+		defer patches.Reset()
+
+		// Arrange
+		provider := newProviderForTest()
+		api := newNilEtherApiForTest(provider)
+
+		// Mock
+		patches.ApplyMethod(
+			reflect.TypeOf(provider),
+			"Send",
+			func(_ *gas.AlchemyProvider, _ string, _ types.RequestArgs) (any, error) {
+				return "0x1", nil
+			},
+		)
+
+		// Act
+		result, err := api.Call(types.TransactionRequest{}, "latest")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "0x1", result)
+	})
+}
+
+func TestEther_GethBackedContext(t *testing.T) {
+	t.Run("cancel reaches the in-flight geth request", func(t *testing.T) {
+		// Arrange
+		httpmock.Activate(t)
+		defer httpmock.DeactivateAndReset()
+		api := newEtherApiForTest()
+		httpmock.RegisterResponder(
+			"POST",
+			"https://fuga.g.alchemy.com/v2/hoge",
+			func(req *http.Request) (*http.Response, error) {
+				<-req.Context().Done()
+				return nil, req.Context().Err()
+			},
+		)
+		ctx, cancel := context.WithCancel(context.Background())
+		time.AfterFunc(10*time.Millisecond, cancel)
+
+		// Act
+		_, err := api.GetBalanceContext(ctx, "0x123", "latest")
+
+		// Assert
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Equal(t, 1, httpmock.GetTotalCallCount())
+	})
+
+	t.Run("CallReadMethodContext calls CallContractContext with the caller ctx", func(t *testing.T) {
+		patches := gomonkey.NewPatches()
+		defer patches.Reset()
+
+		// Arrange
+		api := newEtherApiForTest()
+		var got any
+
+		// Mock
+		patches.ApplyMethod(
+			reflect.TypeOf(api),
+			"CallContractContext",
+			func(_ *ether.Ether, ctx context.Context, msg ethereum.CallMsg, blockTag string) ([]byte, error) {
+				got = ctx.Value(ctxKey{})
+				assert.Equal(t, "latest", blockTag)
+				return []byte{1}, nil
+			},
+		)
+
+		// Act
+		output, err := api.CallReadMethodContext(callerCtx(), []byte("name()"), "0x1234567890abcdef1234567890abcdef12345678")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, []byte{1}, output)
+		assert.Equal(t, "caller", got)
+	})
+}
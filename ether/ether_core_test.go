@@ -57,6 +57,8 @@ func newEtherApiForTest() *eth.Ether {
 			[]byte(""),
 			0,
 			nil,
+			nil,
+			nil,
 		),
 	).(*eth.Ether)
 }
@@ -73,6 +75,8 @@ func newNilEtherApiForTest(provider *gas.AlchemyProvider) *eth.Ether {
 			[]byte(""),
 			0,
 			nil,
+			nil,
+			nil,
 		),
 	).(*eth.Ether)
 }
@@ -998,6 +1002,63 @@ func TestEther_GetTokenBalances(t *testing.T) {
 	})
 }
 
+func TestEther_GetTokenBalancesPage(t *testing.T) {
+	// Arrange
+	provider := newProviderForTest()
+	ether := newNilEtherApiForTest(provider)
+
+	t.Run("call alchemy_getTokenBalances w/ erc20 & page options", func(t *testing.T) {
+		patches := gomonkey.NewPatches()
+		defer patches.Reset()
+
+		// Mock & Assert
+		patches.ApplyMethod(
+			reflect.TypeOf(provider),
+			"Send",
+			func(_ *gas.AlchemyProvider, method string, params types.RequestArgs) (any, error) {
+				assert.Equal(t, constant.Alchemy_GetTokenBalances, method)
+				body, _ := json.Marshal(params)
+				assert.JSONEq(t, `["0xabc","erc20",{"pageKey":"key","maxCount":10}]`, string(body))
+				return map[string]any{
+					"address":       "0xabc",
+					"pageKey":       "next",
+					"tokenBalances": []any{map[string]any{"contractAddress": "0x456", "tokenBalance": "0x1"}},
+				}, nil
+			},
+		)
+
+		// Act
+		actual, err := ether.GetTokenBalancesPage("0xABC", "key", 10)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "next", actual.PageKey)
+		assert.Equal(t, []types.TokenBalance{{ContractAddress: "0x456", TokenBalance: "0x1"}}, actual.TokenBalances)
+	})
+
+	t.Run("first page omits the options", func(t *testing.T) {
+		patches := gomonkey.NewPatches()
+		defer patches.Reset()
+
+		// Mock & Assert
+		patches.ApplyMethod(
+			reflect.TypeOf(provider),
+			"Send",
+			func(_ *gas.AlchemyProvider, _ string, params types.RequestArgs) (any, error) {
+				body, _ := json.Marshal(params)
+				assert.JSONEq(t, `["0xabc","erc20",{}]`, string(body))
+				return map[string]any{}, nil
+			},
+		)
+
+		// Act
+		_, err := ether.GetTokenBalancesPage("0xabc", "", 0)
+
+		// Assert
+		assert.NoError(t, err)
+	})
+}
+
 func TestEther_GetTokenMetadata(t *testing.T) {
 	// Arrange
 	provider := newProviderForTest()
@@ -2190,6 +2251,7 @@ func TestEther_GetAssetTransfers(t *testing.T) {
 					Decimal: "",
 				},
 				Metadata: nil,
+				RawValue: big.NewInt(100000000000000000),
 			},
 		},
 		PageKey: "nextpage",
@@ -2210,12 +2272,60 @@ func TestEther_GetAssetTransfers(t *testing.T) {
 			)
 
 			actual, err := etherApi.GetAssetTransfers(types.AssetTransfersParams{
-				Category: []string{"external"},
+				Category: []types.AssetTransferCategory{types.CategoryExternal},
 			})
 
 			assert.NoError(t, err)
 			assert.Equal(t, expected, actual)
 		})
+
+		t.Run("sends the order & decodes exact values and the block timestamp", func(t *testing.T) {
+			patches := gomonkey.NewPatches()
+			defer patches.Reset()
+
+			patches.ApplyMethod(
+				reflect.TypeOf(provider),
+				"Send",
+				func(_ *gas.AlchemyProvider, _ string, params types.RequestArgs) (any, error) {
+					body, _ := json.Marshal(params)
+					assert.Contains(t, string(body), `"category":["erc20"],"order":"desc"`)
+					return map[string]any{
+						"transfers": []any{
+							map[string]any{
+								"blockNum": "0xf1d1c6",
+								"value":    0.1,
+								"category": "erc20",
+								"rawContract": map[string]any{
+									// 12345678.123456789012345678 of an 18 decimals token
+									"value":   "0x0000000000000000000000000000000000000000000a364c8d5745e58aa8f34e",
+									"address": "0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48",
+									"decimal": "0x12",
+								},
+								"metadata": map[string]any{
+									"blockTimestamp": "2021-09-30T17:28:13.000Z",
+								},
+							},
+						},
+					}, nil
+				},
+			)
+
+			actual, err := etherApi.GetAssetTransfers(types.AssetTransfersParams{
+				Category: []types.AssetTransferCategory{types.CategoryERC20},
+				Order:    types.OrderDescending,
+			})
+
+			assert.NoError(t, err)
+			transfer := actual.Transfers[0]
+			expectedRawValue, _ := new(big.Int).SetString("12345678123456789012345678", 10)
+			assert.Equal(t, expectedRawValue, transfer.RawValue)
+			assert.Equal(t, 18, *transfer.Decimals)
+			assert.Equal(t, "12345678.123456789012345678", transfer.Amount().FloatString(18))
+			assert.Equal(t, time.Date(2021, 9, 30, 17, 28, 13, 0, time.UTC), transfer.Metadata.BlockTimestamp)
+			assert.Equal(t, types.CategoryERC20, transfer.Category)
+			blockNumber, _ := transfer.BlockNumber()
+			assert.Equal(t, uint64(0xf1d1c6), blockNumber)
+		})
 	})
 
 	t.Run("error case:", func(t *testing.T) {
@@ -2233,7 +2343,7 @@ func TestEther_GetAssetTransfers(t *testing.T) {
 			)
 
 			_, err := etherApi.GetAssetTransfers(types.AssetTransfersParams{
-				Category: []string{"external"},
+				Category: []types.AssetTransferCategory{types.CategoryExternal},
 			})
 
 			assert.ErrorIs(t, err, expectedErr)
@@ -2252,7 +2362,7 @@ func TestEther_GetAssetTransfers(t *testing.T) {
 			)
 
 			_, err := etherApi.GetAssetTransfers(types.AssetTransfersParams{
-				Category: []string{"external"},
+				Category: []types.AssetTransferCategory{types.CategoryExternal},
 			})
 
 			assert.ErrorIs(t, err, constant.ErrUnexpectedResponseType)
@@ -2269,15 +2379,16 @@ func TestEther_GetAssetTransfers(t *testing.T) {
 					return expectedResponse, nil
 				},
 			)
-			patches.ApplyFunc(
-				mapstructure.WeakDecode,
-				func(_ any, _ any) error {
+			patches.ApplyMethod(
+				reflect.TypeOf(&mapstructure.Decoder{}),
+				"Decode",
+				func(_ *mapstructure.Decoder, _ any) error {
 					return errors.New("decode error")
 				},
 			)
 
 			_, err := etherApi.GetAssetTransfers(types.AssetTransfersParams{
-				Category: []string{"external"},
+				Category: []types.AssetTransferCategory{types.CategoryExternal},
 			})
 
 			assert.ErrorIs(t, err, constant.ErrFailedToMapAssetTransfers)
@@ -1,6 +1,7 @@
 package ether
 
 import (
+	"context"
 	"math/big"
 
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -10,7 +11,15 @@ import (
 )
 
 func (ether *Ether) Snapshot() (*big.Int, error) {
-	result, err := ether.provider.Send(constant.Evm_Snapshot, types.RequestArgs{})
+	return snapshot(ether.provider.Send)
+}
+
+func (ether *Ether) SnapshotContext(ctx context.Context) (*big.Int, error) {
+	return snapshot(ether.sendContext(ctx))
+}
+
+func snapshot(send sender) (*big.Int, error) {
+	result, err := send(constant.Evm_Snapshot, types.RequestArgs{})
 	if err != nil {
 		return nil, err
 	}
@@ -29,11 +38,19 @@ func (ether *Ether) Snapshot() (*big.Int, error) {
 }
 
 func (ether *Ether) RevertTo(snapshotId *big.Int) (bool, error) {
+	return revertTo(ether.provider.Send, snapshotId)
+}
+
+func (ether *Ether) RevertToContext(ctx context.Context, snapshotId *big.Int) (bool, error) {
+	return revertTo(ether.sendContext(ctx), snapshotId)
+}
+
+func revertTo(send sender, snapshotId *big.Int) (bool, error) {
 	if snapshotId == nil {
 		return false, constant.ErrNilSnapshotId
 	}
 
-	result, err := ether.provider.Send(constant.Evm_Revert, types.RequestArgs{
+	result, err := send(constant.Evm_Revert, types.RequestArgs{
 		hexutil.EncodeBig(snapshotId),
 	})
 	if err != nil {
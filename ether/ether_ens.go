@@ -1,6 +1,7 @@
 package ether
 
 import (
+	"context"
 	"strings"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -9,10 +10,19 @@ import (
 	"github.com/poteto-go/go-alchemy-sdk/validate"
 )
 
+// readCaller is CallReadMethod, with or without the caller's ctx.
+type readCaller func(method []byte, contractAddress string, args ...[]byte) ([]byte, error)
+
+func (ether *Ether) readContext(ctx context.Context) readCaller {
+	return func(method []byte, contractAddress string, args ...[]byte) ([]byte, error) {
+		return ether.CallReadMethodContext(ctx, method, contractAddress, args...)
+	}
+}
+
 // ensResolverFor calls registryAddr to get the resolver address for node.
 // Returns ErrENSResolverNotFound if the registry returns the zero address.
-func (ether *Ether) ensResolverFor(registryAddr common.Address, node [32]byte) (common.Address, error) {
-	out, err := ether.CallReadMethod(constant.ENSResolverFnSignature, registryAddr.Hex(), node[:])
+func ensResolverFor(read readCaller, registryAddr common.Address, node [32]byte) (common.Address, error) {
+	out, err := read(constant.ENSResolverFnSignature, registryAddr.Hex(), node[:])
 	if err != nil {
 		return common.Address{}, err
 	}
@@ -30,6 +40,14 @@ func (ether *Ether) ensResolverFor(registryAddr common.Address, node [32]byte) (
 // provided ENS registry contract address.
 // If name is already a valid hex address it is returned as-is (lowercased).
 func (ether *Ether) ResolveNameBy(registryAddress string, name string) (string, error) {
+	return resolveNameBy(ether.CallReadMethod, registryAddress, name)
+}
+
+func (ether *Ether) ResolveNameByContext(ctx context.Context, registryAddress string, name string) (string, error) {
+	return resolveNameBy(ether.readContext(ctx), registryAddress, name)
+}
+
+func resolveNameBy(read readCaller, registryAddress string, name string) (string, error) {
 	if err := validate.Address(registryAddress); err != nil {
 		return "", err
 	}
@@ -40,12 +58,12 @@ func (ether *Ether) ResolveNameBy(registryAddress string, name string) (string,
 	node := decode.ENSNamehash(name)
 	registry := common.HexToAddress(registryAddress)
 
-	resolver, err := ether.ensResolverFor(registry, node)
+	resolver, err := ensResolverFor(read, registry, node)
 	if err != nil {
 		return "", err
 	}
 
-	out, err := ether.CallReadMethod(constant.ENSAddrFnSignature, resolver.Hex(), node[:])
+	out, err := read(constant.ENSAddrFnSignature, resolver.Hex(), node[:])
 	if err != nil {
 		return "", err
 	}
@@ -60,6 +78,14 @@ func (ether *Ether) ResolveNameBy(registryAddress string, name string) (string,
 // provided ENS registry contract address.
 // Returns ErrENSNameNotFound when no reverse record is registered.
 func (ether *Ether) LookupAddressBy(registryAddress string, address string) (string, error) {
+	return lookupAddressBy(ether.CallReadMethod, registryAddress, address)
+}
+
+func (ether *Ether) LookupAddressByContext(ctx context.Context, registryAddress string, address string) (string, error) {
+	return lookupAddressBy(ether.readContext(ctx), registryAddress, address)
+}
+
+func lookupAddressBy(read readCaller, registryAddress string, address string) (string, error) {
 	if err := validate.Address(registryAddress); err != nil {
 		return "", err
 	}
@@ -71,12 +97,12 @@ func (ether *Ether) LookupAddressBy(registryAddress string, address string) (str
 	reverseNode := decode.ENSNamehash(lowered + ".addr.reverse")
 	registry := common.HexToAddress(registryAddress)
 
-	resolver, err := ether.ensResolverFor(registry, reverseNode)
+	resolver, err := ensResolverFor(read, registry, reverseNode)
 	if err != nil {
 		return "", err
 	}
 
-	out, err := ether.CallReadMethod(constant.ENSNameFnSignature, resolver.Hex(), reverseNode[:])
+	out, err := read(constant.ENSNameFnSignature, resolver.Hex(), reverseNode[:])
 	if err != nil {
 		return "", err
 	}
@@ -0,0 +1,245 @@
+package ether
+
+import (
+	"context"
+
+	"github.com/poteto-go/go-alchemy-sdk/constant"
+	"github.com/poteto-go/go-alchemy-sdk/internal"
+	"github.com/poteto-go/go-alchemy-sdk/types"
+	"github.com/poteto-go/go-alchemy-sdk/utils"
+)
+
+/*
+The gethRequest... helpers run a geth client call as method through the
+configured middlewares, then through internal.GethRequest...WithBackOff.
+
+Params and result cross the middlewares as the Go values of the geth call;
+the innermost handler takes the (possibly rewritten) params back, so a
+middleware may only replace them with values of the same types. A JSON-RPC
+error of the node comes out of it as a *types.RpcError.
+*/
+
+func (ether *Ether) intercept(
+	ctx context.Context,
+	method string,
+	params types.RequestArgs,
+	call func(ctx context.Context, params types.RequestArgs) (any, error),
+) (any, error) {
+	handler := types.ChainMiddlewares(
+		func(ctx context.Context, req types.RpcRequest) (any, error) {
+			res, err := call(ctx, req.Params)
+			return res, utils.RpcErrorOf(req.Method, err)
+		},
+		ether.config.middlewares...,
+	)
+	return handler(ctx, types.RpcRequest{Method: method, Params: params})
+}
+
+// withFullTx takes the fullTx flag of eth_getBlockBy* as a param, so a
+// middleware can tell a header call from a full block call.
+func withFullTx[T any, A any](handler func(context.Context, A) (T, error)) func(context.Context, A, bool) (T, error) {
+	return func(ctx context.Context, arg A, _ bool) (T, error) {
+		return handler(ctx, arg)
+	}
+}
+
+func paramAt[A any](params types.RequestArgs, i int) (A, error) {
+	var zero A
+	if i >= len(params) {
+		return zero, constant.ErrUnexpectedMiddlewareType
+	}
+	if params[i] == nil {
+		return zero, nil
+	}
+	arg, ok := params[i].(A)
+	if !ok {
+		return zero, constant.ErrUnexpectedMiddlewareType
+	}
+	return arg, nil
+}
+
+func resultAs[T any](res any, err error) (T, error) {
+	var zero T
+	if err != nil {
+		return zero, err
+	}
+	if res == nil {
+		return zero, nil
+	}
+	typed, ok := res.(T)
+	if !ok {
+		return zero, constant.ErrUnexpectedMiddlewareType
+	}
+	return typed, nil
+}
+
+func gethRequest[T any](
+	ctx context.Context,
+	ether *Ether,
+	method string,
+	handler func(context.Context) (T, error),
+) (T, error) {
+	res, err := ether.intercept(ctx, method, types.RequestArgs{}, func(ctx context.Context, _ types.RequestArgs) (any, error) {
+		return internal.GethRequestWithBackOff(
+			ctx,
+			ether.config.backoffConfig,
+			ether.config.requestTimeout,
+			handler,
+		)
+	})
+	return resultAs[T](res, err)
+}
+
+func gethRequestArg[T any, A any](
+	ctx context.Context,
+	ether *Ether,
+	method string,
+	handler func(context.Context, A) (T, error),
+	arg A,
+) (T, error) {
+	res, err := ether.intercept(ctx, method, types.RequestArgs{arg}, func(ctx context.Context, params types.RequestArgs) (any, error) {
+		arg, err := paramAt[A](params, 0)
+		if err != nil {
+			return nil, err
+		}
+		return internal.GethRequestArgWithBackOff(
+			ctx,
+			ether.config.backoffConfig,
+			ether.config.requestTimeout,
+			handler,
+			arg,
+		)
+	})
+	return resultAs[T](res, err)
+}
+
+func gethRequestTwoArg[T any, A any, B any](
+	ctx context.Context,
+	ether *Ether,
+	method string,
+	handler func(context.Context, A, B) (T, error),
+	arg1 A,
+	arg2 B,
+) (T, error) {
+	res, err := ether.intercept(ctx, method, types.RequestArgs{arg1, arg2}, func(ctx context.Context, params types.RequestArgs) (any, error) {
+		arg1, err := paramAt[A](params, 0)
+		if err != nil {
+			return nil, err
+		}
+		arg2, err := paramAt[B](params, 1)
+		if err != nil {
+			return nil, err
+		}
+		return internal.GethRequestTwoArgWithBackOff(
+			ctx,
+			ether.config.backoffConfig,
+			ether.config.requestTimeout,
+			handler,
+			arg1,
+			arg2,
+		)
+	})
+	return resultAs[T](res, err)
+}
+
+func gethRequestThreeArg[T any, A any, B any, C any](
+	ctx context.Context,
+	ether *Ether,
+	method string,
+	handler func(context.Context, A, B, C) (T, error),
+	arg1 A,
+	arg2 B,
+	arg3 C,
+) (T, error) {
+	res, err := ether.intercept(ctx, method, types.RequestArgs{arg1, arg2, arg3}, func(ctx context.Context, params types.RequestArgs) (any, error) {
+		arg1, err := paramAt[A](params, 0)
+		if err != nil {
+			return nil, err
+		}
+		arg2, err := paramAt[B](params, 1)
+		if err != nil {
+			return nil, err
+		}
+		arg3, err := paramAt[C](params, 2)
+		if err != nil {
+			return nil, err
+		}
+		return internal.GethRequestThreeArgWithBackOff(
+			ctx,
+			ether.config.backoffConfig,
+			ether.config.requestTimeout,
+			handler,
+			arg1,
+			arg2,
+			arg3,
+		)
+	})
+	return resultAs[T](res, err)
+}
+
+// gethRequestArgTuple hands both results to the middlewares as []any{T, O}.
+func gethRequestArgTuple[T any, A any, O any](
+	ctx context.Context,
+	ether *Ether,
+	method string,
+	handler func(context.Context, A) (T, O, error),
+	arg A,
+) (T, O, error) {
+	var (
+		zeroT T
+		zeroO O
+	)
+	res, err := ether.intercept(ctx, method, types.RequestArgs{arg}, func(ctx context.Context, params types.RequestArgs) (any, error) {
+		arg, err := paramAt[A](params, 0)
+		if err != nil {
+			return nil, err
+		}
+		first, second, err := internal.GethRequestArgWithBackOffTuple(
+			ctx,
+			ether.config.backoffConfig,
+			ether.config.requestTimeout,
+			handler,
+			arg,
+		)
+		if err != nil {
+			return nil, err
+		}
+		return []any{first, second}, nil
+	})
+	tuple, err := resultAs[[]any](res, err)
+	if err != nil {
+		return zeroT, zeroO, err
+	}
+	first, err := paramAt[T](tuple, 0)
+	if err != nil {
+		return zeroT, zeroO, err
+	}
+	second, err := paramAt[O](tuple, 1)
+	if err != nil {
+		return zeroT, zeroO, err
+	}
+	return first, second, nil
+}
+
+func gethRequestSingleError[A any](
+	ctx context.Context,
+	ether *Ether,
+	method string,
+	handler func(context.Context, A) error,
+	arg A,
+) error {
+	_, err := ether.intercept(ctx, method, types.RequestArgs{arg}, func(ctx context.Context, params types.RequestArgs) (any, error) {
+		arg, err := paramAt[A](params, 0)
+		if err != nil {
+			return nil, err
+		}
+		return nil, internal.GethRequestSingleErrorWithBackOff(
+			ctx,
+			ether.config.backoffConfig,
+			ether.config.requestTimeout,
+			handler,
+			arg,
+		)
+	})
+	return err
+}
@@ -0,0 +1,205 @@
+package ether_test
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/jarcoal/httpmock"
+	"github.com/poteto-go/go-alchemy-sdk/constant"
+	eth "github.com/poteto-go/go-alchemy-sdk/ether"
+	"github.com/poteto-go/go-alchemy-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func newEtherApiWithMiddlewaresForTest(middlewares ...types.Middleware) *eth.Ether {
+	return eth.NewEtherApi(
+		newProviderForTest(),
+		eth.NewEtherApiConfig(
+			"https://fuga.g.alchemy.com/v2/hoge",
+			0,
+			time.Second,
+			&types.BackoffConfig{MaxRetries: 0},
+			[]http.Header{},
+			nil,
+			0,
+			nil,
+			middlewares,
+			nil,
+		),
+	).(*eth.Ether)
+}
+
+func TestEther_Middlewares(t *testing.T) {
+	t.Run("middleware sees the geth call with its Go params and result", func(t *testing.T) {
+		// Arrange
+		alchemyMock := newAlchemyMockOnEtherTest(t)
+		defer alchemyMock.DeactivateAndReset()
+		alchemyMock.RegisterResponderOnce("eth_getBalance", `{"jsonrpc":"2.0","id":1,"result":"0x10"}`)
+		var (
+			gotReq types.RpcRequest
+			gotRes any
+		)
+		api := newEtherApiWithMiddlewaresForTest(func(next types.RpcHandler) types.RpcHandler {
+			return func(ctx context.Context, req types.RpcRequest) (any, error) {
+				res, err := next(ctx, req)
+				gotReq, gotRes = req, res
+				return res, err
+			}
+		})
+		address := "0x1234567890abcdef1234567890abcdef12345678"
+
+		// Act
+		balance, err := api.GetBalance(address, "latest")
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(16), balance)
+		assert.Equal(t, "eth_getBalance", gotReq.Method)
+		assert.Equal(t, types.RequestArgs{common.HexToAddress(address), (*big.Int)(nil)}, gotReq.Params)
+		assert.Equal(t, big.NewInt(16), gotRes)
+	})
+
+	t.Run("middleware can rewrite params with values of the same type", func(t *testing.T) {
+		// Arrange
+		httpmock.Activate(t)
+		defer httpmock.DeactivateAndReset()
+		var body struct {
+			Params []any `json:"params"`
+		}
+		httpmock.RegisterResponder(
+			"POST",
+			"https://fuga.g.alchemy.com/v2/hoge",
+			func(req *http.Request) (*http.Response, error) {
+				if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+					return nil, err
+				}
+				return httpmock.NewStringResponse(200, `{"jsonrpc":"2.0","id":1,"result":"0x10"}`), nil
+			},
+		)
+		api := newEtherApiWithMiddlewaresForTest(func(next types.RpcHandler) types.RpcHandler {
+			return func(ctx context.Context, req types.RpcRequest) (any, error) {
+				req.Params = types.RequestArgs{req.Params[0], big.NewInt(0x20)}
+				return next(ctx, req)
+			}
+		})
+
+		// Act
+		_, err := api.GetBalance("0x1234567890abcdef1234567890abcdef12345678", "latest")
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, body.Params, 2)
+		assert.Equal(t, "0x20", body.Params[1])
+	})
+
+	t.Run("middleware can answer without calling next", func(t *testing.T) {
+		// Arrange
+		httpmock.Activate(t)
+		defer httpmock.DeactivateAndReset()
+		api := newEtherApiWithMiddlewaresForTest(func(next types.RpcHandler) types.RpcHandler {
+			return func(ctx context.Context, req types.RpcRequest) (any, error) {
+				return uint64(7), nil
+			}
+		})
+
+		// Act
+		blockNumber, err := api.BlockNumber()
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, uint64(7), blockNumber)
+		assert.Equal(t, 0, httpmock.GetTotalCallCount())
+	})
+
+	t.Run("tuple results cross the middlewares as []any", func(t *testing.T) {
+		// Arrange
+		tx := gethTypes.NewTx(&gethTypes.LegacyTx{Nonce: 1})
+		var gotMethod string
+		api := newEtherApiWithMiddlewaresForTest(func(next types.RpcHandler) types.RpcHandler {
+			return func(ctx context.Context, req types.RpcRequest) (any, error) {
+				gotMethod = req.Method
+				return []any{tx, true}, nil
+			}
+		})
+
+		// Act
+		got, isPending, err := api.GetTransaction("0x1")
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, "eth_getTransactionByHash", gotMethod)
+		assert.Same(t, tx, got)
+		assert.True(t, isPending)
+	})
+
+	t.Run("a param of another type returns ErrUnexpectedMiddlewareType", func(t *testing.T) {
+		// Arrange
+		api := newEtherApiWithMiddlewaresForTest(func(next types.RpcHandler) types.RpcHandler {
+			return func(ctx context.Context, req types.RpcRequest) (any, error) {
+				req.Params = types.RequestArgs{"0x1234", "latest"}
+				return next(ctx, req)
+			}
+		})
+
+		// Act
+		_, err := api.GetBalance("0x1234567890abcdef1234567890abcdef12345678", "latest")
+
+		// Assert
+		assert.ErrorIs(t, err, constant.ErrUnexpectedMiddlewareType)
+	})
+
+	t.Run("a result of another type returns ErrUnexpectedMiddlewareType", func(t *testing.T) {
+		// Arrange
+		api := newEtherApiWithMiddlewaresForTest(func(next types.RpcHandler) types.RpcHandler {
+			return func(ctx context.Context, req types.RpcRequest) (any, error) {
+				return "0x7", nil
+			}
+		})
+
+		// Act
+		_, err := api.BlockNumber()
+
+		// Assert
+		assert.ErrorIs(t, err, constant.ErrUnexpectedMiddlewareType)
+	})
+
+	t.Run("block calls carry the fullTx flag", func(t *testing.T) {
+		// Arrange
+		var gotParams []types.RequestArgs
+		api := newEtherApiWithMiddlewaresForTest(func(next types.RpcHandler) types.RpcHandler {
+			return func(ctx context.Context, req types.RpcRequest) (any, error) {
+				switch req.Method {
+				case constant.Eth_MaxPriorityFeePerGas:
+					return big.NewInt(1), nil
+				case constant.Eth_GetBlockByNumber:
+					gotParams = append(gotParams, req.Params)
+					if req.Params[1] == false {
+						return &gethTypes.Header{BaseFee: big.NewInt(2)}, nil
+					}
+					return gethTypes.NewBlockWithHeader(&gethTypes.Header{Number: big.NewInt(16)}), nil
+				}
+				return next(ctx, req)
+			}
+		})
+
+		// Act
+		_, _, feeErr := api.SuggestEIP1559Fees()
+		block, blockErr := api.GetBlockByNumber("0x10")
+
+		// Assert
+		require.NoError(t, feeErr)
+		require.NoError(t, blockErr)
+		assert.Equal(t, big.NewInt(16), block.Number())
+		assert.Equal(t, []types.RequestArgs{
+			{(*big.Int)(nil), false},
+			{big.NewInt(16), true},
+		}, gotParams)
+	})
+}
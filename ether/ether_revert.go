@@ -0,0 +1,70 @@
+package ether
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/poteto-go/go-alchemy-sdk/decode"
+	"github.com/poteto-go/go-alchemy-sdk/types"
+)
+
+// revertError decodes a reverted call into a *types.RevertError, matching
+// custom errors against the configured error ABIs. Other errors are returned
+// as is.
+func (ether *Ether) revertError(err error) error {
+	if revertErr, ok := decode.RevertErrorOf(err, ether.config.errorABIs...); ok {
+		return revertErr
+	}
+	return err
+}
+
+/*
+RevertReason recovers why a mined transaction failed (status=0): the node
+does not keep the revert data, so the transaction is replayed with eth_call
+at its block and the revert decoded.
+
+It returns nil for a successful transaction. When the replay does not
+revert, e.g. it ran out of gas, the *types.RevertError has no reason.
+*/
+func (ether *Ether) RevertReason(ctx context.Context, txHash common.Hash) (*types.RevertError, error) {
+	receipt, err := ether.GetTransactionReceiptContext(ctx, txHash.Hex())
+	if err != nil {
+		return nil, err
+	}
+	if receipt.Status == gethTypes.ReceiptStatusSuccessful {
+		return nil, nil
+	}
+
+	tx, _, err := ether.GetTransactionContext(ctx, txHash.Hex())
+	if err != nil {
+		return nil, err
+	}
+	from, err := gethTypes.Sender(gethTypes.LatestSignerForChainID(tx.ChainId()), tx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = ether.CallContractContext(ctx, ethereum.CallMsg{
+		From:       from,
+		To:         tx.To(),
+		Gas:        tx.Gas(),
+		Value:      tx.Value(),
+		Data:       tx.Data(),
+		AccessList: tx.AccessList(),
+	}, hexutil.EncodeBig(receipt.BlockNumber))
+	if err == nil {
+		return &types.RevertError{TxHash: txHash}, nil
+	}
+
+	revertErr, ok := errors.AsType[*types.RevertError](err)
+	if !ok {
+		return nil, err
+	}
+	revertErr.TxHash = txHash
+	return revertErr, nil
+}
@@ -0,0 +1,168 @@
+package ether_test
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/poteto-go/go-alchemy-sdk/constant"
+	eth "github.com/poteto-go/go-alchemy-sdk/ether"
+	"github.com/poteto-go/go-alchemy-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// revertNopePayload is Error("nope").
+var revertNopePayload = common.FromHex(
+	"08c379a0" +
+		"0000000000000000000000000000000000000000000000000000000000000020" +
+		"0000000000000000000000000000000000000000000000000000000000000004" +
+		"6e6f706500000000000000000000000000000000000000000000000000000000",
+)
+
+// reverterInitCode deploys a contract that reverts every call with payload:
+// its runtime code copies payload to memory and reverts with it.
+func reverterInitCode(payload []byte) []byte {
+	// the runtime and the init code share one 12-byte prefix layout
+	const prefixSize = 12
+	runtime := append([]byte{
+		0x60, byte(len(payload)), // PUSH1 len
+		0x60, prefixSize, // PUSH1 offset of payload
+		0x60, 0x00, // PUSH1 0
+		0x39,                     // CODECOPY
+		0x60, byte(len(payload)), // PUSH1 len
+		0x60, 0x00, // PUSH1 0
+		0xfd, // REVERT
+	}, payload...)
+	return append([]byte{
+		0x60, byte(len(runtime)), // PUSH1 len
+		0x60, prefixSize, // PUSH1 offset of runtime
+		0x60, 0x00, // PUSH1 0
+		0x39,                     // CODECOPY
+		0x60, byte(len(runtime)), // PUSH1 len
+		0x60, 0x00, // PUSH1 0
+		0xf3, // RETURN
+	}, runtime...)
+}
+
+// deployReverterForTest deploys a contract reverting with Error("nope").
+func deployReverterForTest(t *testing.T, e *eth.Ether) common.Address {
+	t.Helper()
+	receipt, err := e.WaitMined(context.Background(), simSign(t, e, nil, reverterInitCode(revertNopePayload)))
+	require.NoError(t, err)
+	require.Equal(t, gethTypes.ReceiptStatusSuccessful, receipt.Status)
+	return receipt.ContractAddress
+}
+
+func TestEther_Revert(t *testing.T) {
+	t.Run("CallContract -> RevertError with the reason", func(t *testing.T) {
+		// Arrange
+		e, closeFn := newSimulatedEtherForTest(t)
+		defer closeFn()
+		contract := deployReverterForTest(t, e)
+
+		// Act
+		_, err := e.CallContract(ethereum.CallMsg{To: &contract}, "latest")
+
+		// Assert
+		revertErr, ok := errors.AsType[*types.RevertError](err)
+		require.True(t, ok)
+		assert.Equal(t, "nope", revertErr.Reason)
+		assert.Equal(t, revertNopePayload, revertErr.Data)
+		assert.Equal(t, "execution reverted: nope", revertErr.Error())
+	})
+
+	t.Run("EstimateGas -> RevertError with the reason", func(t *testing.T) {
+		// Arrange
+		e, closeFn := newSimulatedEtherForTest(t)
+		defer closeFn()
+		contract := deployReverterForTest(t, e)
+
+		// Act
+		_, err := e.EstimateGas(types.TransactionRequest{To: contract.Hex(), Value: "0x0"})
+
+		// Assert
+		revertErr, ok := errors.AsType[*types.RevertError](err)
+		require.True(t, ok)
+		assert.Equal(t, "nope", revertErr.Reason)
+	})
+
+	t.Run("RevertReason replays a mined tx with status=0", func(t *testing.T) {
+		// Arrange
+		e, closeFn := newSimulatedEtherForTest(t)
+		defer closeFn()
+		contract := deployReverterForTest(t, e)
+		key, err := crypto.HexToECDSA(simPrivateKeyHex)
+		require.NoError(t, err)
+		nonce, err := e.PendingNonceAt(crypto.PubkeyToAddress(key.PublicKey).Hex())
+		require.NoError(t, err)
+		gasPrice, err := e.SuggestGasPrice()
+		require.NoError(t, err)
+		// a fixed gas limit skips the estimate, so the tx is mined and fails
+		signed, err := gethTypes.SignTx(gethTypes.NewTx(&gethTypes.LegacyTx{
+			Nonce:    nonce,
+			GasPrice: gasPrice,
+			Gas:      100_000,
+			To:       &contract,
+			Value:    big.NewInt(0),
+		}), gethTypes.LatestSignerForChainID(simChainID), key)
+		require.NoError(t, err)
+		require.NoError(t, e.SendRawTransaction(signed))
+		receipt, err := e.WaitMined(context.Background(), signed.Hash())
+		require.NoError(t, err)
+		require.Equal(t, gethTypes.ReceiptStatusFailed, receipt.Status)
+
+		// Act
+		revertErr, err := e.RevertReason(context.Background(), signed.Hash())
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "nope", revertErr.Reason)
+		assert.Equal(t, signed.Hash(), revertErr.TxHash)
+		assert.Contains(t, revertErr.Error(), "(tx="+signed.Hash().Hex()+")")
+	})
+
+	t.Run("RevertReason of a successful tx -> nil", func(t *testing.T) {
+		// Arrange
+		e, closeFn := newSimulatedEtherForTest(t)
+		defer closeFn()
+		txHash := simSendValueTx(t, e)
+		_, err := e.WaitMined(context.Background(), txHash)
+		require.NoError(t, err)
+
+		// Act
+		revertErr, err := e.RevertReason(context.Background(), txHash)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Nil(t, revertErr)
+	})
+
+	t.Run("Call over Send -> RevertError from the RpcError data", func(t *testing.T) {
+		// Arrange
+		e := newEtherApiForTest()
+		alchemyMock := newAlchemyMockOnEtherTest(t)
+		defer alchemyMock.DeactivateAndReset()
+		alchemyMock.RegisterResponderOnce(
+			constant.Eth_Call,
+			`{"jsonrpc":"2.0","id":1,"error":{"code":3,"message":"execution reverted: nope","data":"0x`+hex.EncodeToString(revertNopePayload)+`"}}`,
+		)
+
+		// Act
+		_, err := e.Call(types.TransactionRequest{To: "0x1234567890123456789012345678901234567890"}, "latest")
+
+		// Assert
+		revertErr, ok := errors.AsType[*types.RevertError](err)
+		require.True(t, ok)
+		assert.Equal(t, "nope", revertErr.Reason)
+		rpcErr, ok := errors.AsType[*types.RpcError](err)
+		require.True(t, ok)
+		assert.Equal(t, constant.Eth_Call, rpcErr.Method)
+	})
+}
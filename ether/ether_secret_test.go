@@ -40,6 +40,8 @@ func newEtherApiWSecretForTest() *eth.Ether {
 			decoded,
 			0,
 			nil,
+			nil,
+			nil,
 		),
 	).(*eth.Ether)
 }
@@ -62,6 +64,8 @@ func newEtherApiWIvalidSecretForTest() *eth.Ether {
 			[]byte("invalid"),
 			0,
 			nil,
+			nil,
+			nil,
 		),
 	).(*eth.Ether)
 }
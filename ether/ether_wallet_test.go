@@ -11,6 +11,7 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	gethTypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/poteto-go/go-alchemy-sdk/_fixture/artifacts"
+	"github.com/poteto-go/go-alchemy-sdk/constant"
 	eth "github.com/poteto-go/go-alchemy-sdk/ether"
 	"github.com/stretchr/testify/assert"
 )
@@ -74,6 +75,34 @@ func TestEther_PendingNonceAt(t *testing.T) {
 	})
 }
 
+func TestEther_NonceAt(t *testing.T) {
+	t.Run("returns the nonce at the block", func(t *testing.T) {
+		// Arrange
+		ether := newEtherApiForTest()
+		alchemyMock := newAlchemyMockOnEtherTest(t)
+		defer alchemyMock.DeactivateAndReset()
+		alchemyMock.RegisterResponderOnce("eth_getTransactionCount", `{"jsonrpc":"2.0","id":1,"result":"0x7"}`)
+
+		// Act
+		result, err := ether.NonceAt("0xa7d9ddbe1f17865597fbd27ec712455208b6b76d", "0x10")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(7), result)
+	})
+
+	t.Run("if invalid block tag, return ErrInvalidBlockTag", func(t *testing.T) {
+		// Arrange
+		ether := newEtherApiForTest()
+
+		// Act
+		_, err := ether.NonceAt("0xa7d9ddbe1f17865597fbd27ec712455208b6b76d", "")
+
+		// Assert
+		assert.ErrorIs(t, err, constant.ErrInvalidBlockTag)
+	})
+}
+
 func Test_DeployContract(t *testing.T) {
 	metaData := &artifacts.PotetoStorageMetaData
 
@@ -2,6 +2,8 @@ package ether
 
 import (
 	"context"
+	"fmt"
+	"math/big"
 
 	"github.com/ethereum/go-ethereum"
 	gethTypes "github.com/ethereum/go-ethereum/core/types"
@@ -9,75 +11,100 @@ import (
 	"github.com/poteto-go/go-alchemy-sdk/constant"
 )
 
-// TODO: backoff or
+// Subscribe is resumed after a socket drop, without backfill.
 func (ether *Ether) Subscribe(ctx context.Context, channel any, params ...any) (ethereum.Subscription, error) {
-	if !ether.isWebSocket() {
-		return nil, constant.ErrUnsupportedNotWebsocketProvider
+	r := &wsResubscriber[any]{
+		ether: ether,
+		subscribe: func(ctx context.Context, c *ethclient.Client) (ethereum.Subscription, error) {
+			return c.Client().EthSubscribe(ctx, channel, params...)
+		},
 	}
-
-	if err := ether.SetEthClient(); err != nil {
-		return nil, err
-	}
-	defer ether.Close()
-
-	c, ok := ether.Client().(*ethclient.Client)
-	if !ok {
-		return nil, constant.ErrUnSupportSimulatedMethod
-	}
-
-	return c.Client().EthSubscribe(ctx, channel, params...)
+	return r.start(ctx)
 }
 
+// SubscribeNewHead is resumed after a socket drop; missed heads are fetched
+// with eth_getBlockByNumber and delivered in order before the new stream. A
+// head already delivered, by hash, is not delivered again.
 func (ether *Ether) SubscribeNewHead(ctx context.Context, headerChan chan<- *gethTypes.Header) (ethereum.Subscription, error) {
-	if !ether.isWebSocket() {
-		return nil, constant.ErrUnsupportedNotWebsocketProvider
+	in := make(chan *gethTypes.Header)
+	r := &wsResubscriber[*gethTypes.Header]{
+		ether: ether,
+		subscribe: func(ctx context.Context, c *ethclient.Client) (ethereum.Subscription, error) {
+			return c.SubscribeNewHead(ctx, in)
+		},
+		in:  in,
+		out: headerChan,
+		blockOf: func(header *gethTypes.Header) uint64 {
+			return header.Number.Uint64()
+		},
+		keyOf: func(header *gethTypes.Header) string {
+			return header.Hash().Hex()
+		},
+		backfill: func(ctx context.Context, c *ethclient.Client, from, to uint64) ([]*gethTypes.Header, error) {
+			headers := make([]*gethTypes.Header, 0, to-from+1)
+			for n := from; n <= to; n++ {
+				header, err := gethRequestTwoArg(
+					ctx,
+					ether,
+					constant.Eth_GetBlockByNumber,
+					withFullTx(c.HeaderByNumber),
+					new(big.Int).SetUint64(n),
+					false,
+				)
+				if err != nil {
+					return nil, err
+				}
+				headers = append(headers, header)
+			}
+			return headers, nil
+		},
 	}
-
-	if err := ether.SetEthClient(); err != nil {
-		return nil, err
-	}
-	defer ether.Close()
-
-	c, ok := ether.Client().(*ethclient.Client)
-	if !ok {
-		return nil, constant.ErrUnSupportSimulatedMethod
-	}
-
-	return c.SubscribeNewHead(ctx, headerChan)
+	return r.start(ctx)
 }
 
+// SubscribeFilterLogs is resumed after a socket drop; missed logs are fetched
+// with eth_getLogs over the missed blocks and delivered before the new stream.
+// The last block is fetched again, its logs already delivered skipped by
+// (blockHash, logIndex).
 func (ether *Ether) SubscribeFilterLogs(ctx context.Context, query ethereum.FilterQuery, logChan chan<- gethTypes.Log) (ethereum.Subscription, error) {
-	if !ether.isWebSocket() {
-		return nil, constant.ErrUnsupportedNotWebsocketProvider
+	in := make(chan gethTypes.Log)
+	r := &wsResubscriber[gethTypes.Log]{
+		ether: ether,
+		subscribe: func(ctx context.Context, c *ethclient.Client) (ethereum.Subscription, error) {
+			return c.SubscribeFilterLogs(ctx, query, in)
+		},
+		in:  in,
+		out: logChan,
+		blockOf: func(log gethTypes.Log) uint64 {
+			return log.BlockNumber
+		},
+		keyOf: func(log gethTypes.Log) string {
+			return fmt.Sprintf("%s:%d", log.BlockHash.Hex(), log.Index)
+		},
+		backfill: func(ctx context.Context, c *ethclient.Client, from, to uint64) ([]gethTypes.Log, error) {
+			missed := query
+			missed.BlockHash = nil
+			missed.FromBlock = new(big.Int).SetUint64(from)
+			missed.ToBlock = new(big.Int).SetUint64(to)
+			return gethRequestArg(
+				ctx,
+				ether,
+				constant.Eth_GetLogs,
+				c.FilterLogs,
+				missed,
+			)
+		},
 	}
-
-	if err := ether.SetEthClient(); err != nil {
-		return nil, err
-	}
-	defer ether.Close()
-
-	c, ok := ether.Client().(*ethclient.Client)
-	if !ok {
-		return nil, constant.ErrUnSupportSimulatedMethod
-	}
-
-	return c.SubscribeFilterLogs(ctx, query, logChan)
+	return r.start(ctx)
 }
 
+// SubscribeTxReceipts is resumed after a socket drop, without backfill.
 func (ether *Ether) SubscribeTxReceipts(ctx context.Context, q *ethereum.TransactionReceiptsQuery, receiptsChan chan<- []*gethTypes.Receipt) (ethereum.Subscription, error) {
-	if !ether.isWebSocket() {
-		return nil, constant.ErrUnsupportedNotWebsocketProvider
+	r := &wsResubscriber[[]*gethTypes.Receipt]{
+		ether: ether,
+		subscribe: func(ctx context.Context, c *ethclient.Client) (ethereum.Subscription, error) {
+			return c.SubscribeTransactionReceipts(ctx, q, receiptsChan)
+		},
 	}
-
-	if err := ether.SetEthClient(); err != nil {
-		return nil, err
-	}
-	defer ether.Close()
-
-	c, ok := ether.Client().(*ethclient.Client)
-	if !ok {
-		return nil, constant.ErrUnSupportSimulatedMethod
-	}
-
-	return c.SubscribeTransactionReceipts(ctx, q, receiptsChan)
+	return r.start(ctx)
 }
@@ -0,0 +1,269 @@
+package ether
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"sync"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/ethclient"
+
+	"github.com/poteto-go/go-alchemy-sdk/constant"
+	"github.com/poteto-go/go-alchemy-sdk/internal"
+	"github.com/poteto-go/go-alchemy-sdk/types"
+)
+
+/*
+wsResubscriber keeps an eth_subscribe stream alive across ws socket drops.
+
+When the inner geth subscription fails it redials the socket (shared by every
+subscription of the Ether, see redialWs), re-issues eth_subscribe on the new
+socket and keeps delivering on the same user channel. Redial attempts follow
+BackoffConfig; once they are exhausted the last error is sent on Err().
+
+Streams with a block number (newHeads, logs) are forwarded through in so the
+resubscriber knows the last delivered block: after a reconnect, backfill fetches
+what was missed in [last, head] before the new stream is forwarded again, and
+items of the new stream at or below the backfilled head are dropped once. The
+last block is fetched again as the drop may have cut it short (e.g. its later
+logs); its items already delivered, told apart by keyOf, are skipped.
+Other streams are subscribed straight onto the user channel and only resumed.
+
+Unlike the inner geth subscription, the ctx given to Subscribe only bounds the
+first eth_subscribe; resubscribes and backfills end with Unsubscribe.
+*/
+type wsResubscriber[T any] struct {
+	ether     *Ether
+	subscribe func(ctx context.Context, c *ethclient.Client) (ethereum.Subscription, error)
+
+	// in, out and blockOf are nil when subscribe writes to the user channel.
+	in       chan T
+	out      chan<- T
+	blockOf  func(T) uint64
+	keyOf    func(T) string
+	backfill func(ctx context.Context, c *ethclient.Client, from, to uint64) ([]T, error)
+
+	// last is the block number of the last delivered item, and lastKeys the
+	// keys of the items delivered at it.
+	last      uint64
+	lastKeys  map[string]bool
+	delivered bool
+	// floor drops items of the new stream already covered by a backfill.
+	floor    uint64
+	hasFloor bool
+
+	epoch  uint64
+	errCh  chan error
+	cancel context.CancelFunc
+	done   chan struct{}
+	once   sync.Once
+}
+
+// wsClient returns the persistent ws client, dialing it on first use.
+func (ether *Ether) wsClient() (*ethclient.Client, error) {
+	if !ether.isWebSocket() {
+		return nil, constant.ErrUnsupportedNotWebsocketProvider
+	}
+
+	if err := ether.SetEthClient(); err != nil {
+		return nil, err
+	}
+
+	c, ok := ether.Client().(*ethclient.Client)
+	if !ok {
+		return nil, constant.ErrUnSupportSimulatedMethod
+	}
+	return c, nil
+}
+
+// redialWs replaces dead with a new ws client, unless another subscription
+// recovering from the same drop already did so; then its client is reused.
+// It refuses to dial after Shutdown (a new epoch).
+func (ether *Ether) redialWs(dead *ethclient.Client, epoch uint64) (*ethclient.Client, error) {
+	ether.mu.Lock()
+	defer ether.mu.Unlock()
+
+	if ether.wsEpoch != epoch {
+		return nil, constant.ErrWsClientShutdown
+	}
+	if ether.client == dead {
+		ether.kill()
+	}
+	if err := ether.setWsEthClient(); err != nil {
+		return nil, err
+	}
+	return ether.client, nil
+}
+
+func (ether *Ether) currentWsEpoch() uint64 {
+	ether.mu.Lock()
+	defer ether.mu.Unlock()
+	return ether.wsEpoch
+}
+
+// start opens the first subscription with the caller's ctx and hands the
+// stream over to run.
+func (r *wsResubscriber[T]) start(ctx context.Context) (ethereum.Subscription, error) {
+	c, err := r.ether.wsClient()
+	if err != nil {
+		return nil, err
+	}
+
+	sub, err := r.subscribe(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+
+	var runCtx context.Context
+	runCtx, r.cancel = context.WithCancel(context.Background())
+	r.epoch = r.ether.currentWsEpoch()
+	r.errCh = make(chan error, 1)
+	r.done = make(chan struct{})
+	go r.run(runCtx, c, sub)
+	return r, nil
+}
+
+func (r *wsResubscriber[T]) Err() <-chan error {
+	return r.errCh
+}
+
+// Unsubscribe stops the stream and closes Err(). It is safe to call more than once.
+func (r *wsResubscriber[T]) Unsubscribe() {
+	r.once.Do(func() {
+		r.cancel()
+		<-r.done
+	})
+}
+
+func (r *wsResubscriber[T]) run(ctx context.Context, c *ethclient.Client, sub ethereum.Subscription) {
+	defer close(r.done)
+	defer close(r.errCh)
+
+	for {
+		select {
+		case <-ctx.Done():
+			sub.Unsubscribe()
+			return
+		case item := <-r.in:
+			if !r.forward(ctx, item) {
+				sub.Unsubscribe()
+				return
+			}
+		case cause := <-sub.Err():
+			var err error
+			c, sub, err = r.resubscribe(ctx, c, cause)
+			if err != nil {
+				if ctx.Err() == nil {
+					r.errCh <- err
+				}
+				return
+			}
+		}
+	}
+}
+
+func (r *wsResubscriber[T]) forward(ctx context.Context, item T) bool {
+	if r.blockOf != nil {
+		number := r.blockOf(item)
+		if r.hasFloor {
+			if number <= r.floor {
+				return true
+			}
+			r.hasFloor = false
+		}
+		if !r.delivered || number != r.last {
+			r.lastKeys = map[string]bool{}
+		}
+		r.lastKeys[r.keyOf(item)] = true
+		r.last, r.delivered = number, true
+	}
+
+	select {
+	case r.out <- item:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
+
+// resubscribe redials and re-subscribes with backoff, then backfills the gap.
+func (r *wsResubscriber[T]) resubscribe(
+	ctx context.Context,
+	dead *ethclient.Client,
+	cause error,
+) (*ethclient.Client, ethereum.Subscription, error) {
+	backoffConfig := r.ether.config.backoffConfig
+	if backoffConfig == nil {
+		backoffConfig = &types.DefaultBackoffConfig
+	}
+	backoffManager := internal.NewBackoffManager(*backoffConfig)
+
+	lastErr := cause
+	for {
+		if err := backoffManager.BackoffContext(ctx); err != nil {
+			return nil, nil, lastErr
+		}
+
+		c, err := r.ether.redialWs(dead, r.epoch)
+		if errors.Is(err, constant.ErrWsClientShutdown) {
+			return nil, nil, err
+		}
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		dead = c
+
+		sub, err := r.subscribe(ctx, c)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+
+		if err := r.fillGap(ctx, c); err != nil {
+			sub.Unsubscribe()
+			lastErr = err
+			continue
+		}
+		return c, sub, nil
+	}
+}
+
+// fillGap delivers what was missed since the last delivered item.
+func (r *wsResubscriber[T]) fillGap(ctx context.Context, c *ethclient.Client) error {
+	if r.backfill == nil || !r.delivered {
+		return nil
+	}
+
+	head, err := gethRequest(
+		ctx,
+		r.ether,
+		constant.Eth_BlockNumber,
+		c.BlockNumber,
+	)
+	if err != nil {
+		return err
+	}
+	if head < r.last {
+		return nil
+	}
+
+	items, err := r.backfill(ctx, c, r.last, head)
+	if err != nil {
+		return err
+	}
+	last, delivered := r.last, maps.Clone(r.lastKeys)
+	for _, item := range items {
+		if r.blockOf(item) == last && delivered[r.keyOf(item)] {
+			continue
+		}
+		if !r.forward(ctx, item) {
+			return ctx.Err()
+		}
+	}
+	// the whole range is covered even when it held no log
+	r.last = head
+	r.floor, r.hasFloor = head, true
+	return nil
+}
@@ -0,0 +1,238 @@
+package ether_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"testing"
+	"time"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/poteto-go/go-alchemy-sdk/alchemymock"
+	"github.com/poteto-go/go-alchemy-sdk/constant"
+	eth "github.com/poteto-go/go-alchemy-sdk/ether"
+	"github.com/poteto-go/go-alchemy-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newEtherWsApiForResubscribeTest builds a ws Ether whose redials back off
+// for 10ms only, up to maxRetries times.
+func newEtherWsApiForResubscribeTest(wsUrl string, maxRetries int) *eth.Ether {
+	provider := newWsProviderForTest()
+	return eth.NewWsEtherApi(
+		provider,
+		eth.NewEtherApiConfig(
+			wsUrl,
+			0,
+			2*time.Second,
+			&types.BackoffConfig{
+				MaxRetries:     maxRetries,
+				InitialDelayMs: 10,
+				MaxDelayMs:     10,
+			},
+			[]http.Header{},
+			nil,
+			5<<20,
+			nil,
+			nil,
+			nil,
+		),
+	).(*eth.Ether)
+}
+
+func wsResultEnvelope(t *testing.T, result any) string {
+	t.Helper()
+	data, err := json.Marshal(result)
+	require.NoError(t, err)
+	return fmt.Sprintf(`{"jsonrpc":"2.0","id":1,"result":%s}`, data)
+}
+
+func waitResubscribed(t *testing.T, mock *alchemymock.AlchemyWsMock, kind string) {
+	t.Helper()
+	require.Eventually(t, func() bool {
+		return mock.SubscriptionCount(kind) == 1
+	}, 3*time.Second, 10*time.Millisecond)
+}
+
+func receiveWithin[T any](t *testing.T, ch <-chan T, sub ethereum.Subscription) T {
+	t.Helper()
+	select {
+	case got := <-ch:
+		return got
+	case err := <-sub.Err():
+		t.Fatalf("subscription errored: %v", err)
+	case <-time.After(3 * time.Second):
+		t.Fatal("timed out waiting for subscription notification")
+	}
+	var zero T
+	return zero
+}
+
+func Test_EtherWsResubscribe(t *testing.T) {
+	t.Run("newHeads resubscribes and backfills the missed heads in order", func(t *testing.T) {
+		// Arrange
+		mock := alchemymock.NewAlchemyWsMock(utAlchemySetting, t)
+		wsEther := newEtherWsApiForResubscribeTest(mock.URL(), 3)
+
+		ch := make(chan *gethTypes.Header, 8)
+		sub, err := wsEther.SubscribeNewHead(context.Background(), ch)
+		require.NoError(t, err)
+		defer sub.Unsubscribe()
+
+		mock.EmitNewHeads(newWsMinimalHeader(1))
+		assert.Equal(t, big.NewInt(1), receiveWithin(t, ch, sub).Number)
+
+		mock.RegisterResponderOnce("eth_blockNumber", `{"jsonrpc":"2.0","id":1,"result":"0x3"}`)
+		mock.RegisterResponderOnce("eth_getBlockByNumber", wsResultEnvelope(t, newWsMinimalHeader(1)))
+		mock.RegisterResponderOnce("eth_getBlockByNumber", wsResultEnvelope(t, newWsMinimalHeader(2)))
+		mock.RegisterResponderOnce("eth_getBlockByNumber", wsResultEnvelope(t, newWsMinimalHeader(3)))
+
+		// Act
+		mock.DropConnections()
+
+		// Assert: the gap [1, 3] arrives on the same channel, without the
+		// head 1 already delivered
+		assert.Equal(t, big.NewInt(2), receiveWithin(t, ch, sub).Number)
+		assert.Equal(t, big.NewInt(3), receiveWithin(t, ch, sub).Number)
+
+		// a head already backfilled is not delivered twice
+		waitResubscribed(t, mock, "newHeads")
+		mock.EmitNewHeads(newWsMinimalHeader(3), newWsMinimalHeader(4))
+		assert.Equal(t, big.NewInt(4), receiveWithin(t, ch, sub).Number)
+	})
+
+	t.Run("logs resubscribe and backfill the missed blocks with eth_getLogs", func(t *testing.T) {
+		// Arrange
+		mock := alchemymock.NewAlchemyWsMock(utAlchemySetting, t)
+		wsEther := newEtherWsApiForResubscribeTest(mock.URL(), 3)
+
+		addr := common.HexToAddress("0xdeadbeef00000000000000000000000000000042")
+		logAt := func(number uint64) gethTypes.Log {
+			log := newWsMinimalLog(addr)
+			log.BlockNumber = number
+			log.BlockHash = common.BigToHash(new(big.Int).SetUint64(number))
+			return log
+		}
+		emitLog := func(number uint64) {
+			data, err := json.Marshal(logAt(number))
+			require.NoError(t, err)
+			mock.Emit("logs", data)
+		}
+
+		ch := make(chan gethTypes.Log, 8)
+		sub, err := wsEther.SubscribeFilterLogs(context.Background(), ethereum.FilterQuery{}, ch)
+		require.NoError(t, err)
+		defer sub.Unsubscribe()
+
+		emitLog(5)
+		assert.Equal(t, uint64(5), receiveWithin(t, ch, sub).BlockNumber)
+
+		// the drop cut block 5 short: its second log is only in the backfill
+		secondAt5 := logAt(5)
+		secondAt5.Index = 1
+		mock.RegisterResponderOnce("eth_blockNumber", `{"jsonrpc":"2.0","id":1,"result":"0x7"}`)
+		mock.RegisterResponderOnce("eth_getLogs", wsResultEnvelope(t, []gethTypes.Log{logAt(5), secondAt5, logAt(6), logAt(7)}))
+
+		// Act
+		mock.DropConnections()
+
+		// Assert
+		second := receiveWithin(t, ch, sub)
+		assert.Equal(t, uint64(5), second.BlockNumber)
+		assert.Equal(t, uint(1), second.Index)
+		assert.Equal(t, uint64(6), receiveWithin(t, ch, sub).BlockNumber)
+		assert.Equal(t, uint64(7), receiveWithin(t, ch, sub).BlockNumber)
+
+		waitResubscribed(t, mock, "logs")
+		emitLog(7)
+		emitLog(8)
+		assert.Equal(t, uint64(8), receiveWithin(t, ch, sub).BlockNumber)
+	})
+
+	t.Run("raw subscriptions are resumed without backfill", func(t *testing.T) {
+		// Arrange
+		mock := alchemymock.NewAlchemyWsMock(utAlchemySetting, t)
+		wsEther := newEtherWsApiForResubscribeTest(mock.URL(), 3)
+
+		ch := make(chan *gethTypes.Header, 1)
+		sub, err := wsEther.Subscribe(context.Background(), ch, "newHeads")
+		require.NoError(t, err)
+		defer sub.Unsubscribe()
+
+		// Act
+		mock.DropConnections()
+		waitResubscribed(t, mock, "newHeads")
+		mock.EmitNewHeads(newWsMinimalHeader(9))
+
+		// Assert
+		assert.Equal(t, big.NewInt(9), receiveWithin(t, ch, sub).Number)
+	})
+
+	t.Run("reports an error once the redial retries are exhausted", func(t *testing.T) {
+		// Arrange
+		mock := alchemymock.NewAlchemyWsMock(utAlchemySetting, t)
+		wsEther := newEtherWsApiForResubscribeTest(mock.URL(), 2)
+
+		sub, err := wsEther.SubscribeNewHead(context.Background(), make(chan *gethTypes.Header))
+		require.NoError(t, err)
+		defer sub.Unsubscribe()
+
+		// Act: the server is gone, every redial fails
+		mock.Close()
+
+		// Assert
+		select {
+		case err := <-sub.Err():
+			assert.Error(t, err)
+		case <-time.After(3 * time.Second):
+			t.Fatal("timed out waiting for the subscription error")
+		}
+	})
+
+	t.Run("does not redial after Shutdown", func(t *testing.T) {
+		// Arrange
+		mock := alchemymock.NewAlchemyWsMock(utAlchemySetting, t)
+		wsEther := newEtherWsApiForResubscribeTest(mock.URL(), 3)
+
+		sub, err := wsEther.SubscribeNewHead(context.Background(), make(chan *gethTypes.Header))
+		require.NoError(t, err)
+		defer sub.Unsubscribe()
+
+		// Act
+		wsEther.Shutdown()
+
+		// Assert
+		select {
+		case err := <-sub.Err():
+			assert.ErrorIs(t, err, constant.ErrWsClientShutdown)
+		case <-time.After(3 * time.Second):
+			t.Fatal("timed out waiting for the subscription error")
+		}
+		assert.Nil(t, wsEther.Client())
+	})
+
+	t.Run("Unsubscribe closes Err and is idempotent", func(t *testing.T) {
+		// Arrange
+		mock := alchemymock.NewAlchemyWsMock(utAlchemySetting, t)
+		wsEther := newEtherWsApiForResubscribeTest(mock.URL(), 3)
+
+		sub, err := wsEther.SubscribeNewHead(context.Background(), make(chan *gethTypes.Header))
+		require.NoError(t, err)
+
+		// Act
+		sub.Unsubscribe()
+
+		// Assert
+		_, ok := <-sub.Err()
+		assert.False(t, ok)
+		assert.NotPanics(t, sub.Unsubscribe)
+		assert.Eventually(t, func() bool {
+			return mock.SubscriptionCount("newHeads") == 0
+		}, 3*time.Second, 10*time.Millisecond)
+	})
+}
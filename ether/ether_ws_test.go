@@ -41,6 +41,8 @@ func newEtherWsApiForTest() *eth.Ether {
 			[]byte(""),
 			5<<20,
 			nil,
+			nil,
+			nil,
 		),
 	).(*eth.Ether)
 }
@@ -61,6 +63,8 @@ func newEtherWsApiForTestWithUrl(wsUrl string) *eth.Ether {
 			nil,
 			5<<20,
 			nil,
+			nil,
+			nil,
 		),
 	).(*eth.Ether)
 }
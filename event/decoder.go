@@ -0,0 +1,255 @@
+/*
+Package event decodes contract event logs into Go values, so callers stop
+hand-parsing Topics[1].
+
+A Decoder knows the standard ERC-20, ERC-721, ERC-1155 and FiatToken events,
+decoded into the typed structs of this package (*Transfer, *Approval,
+*TransferSingle, *Blacklisted, ...), plus the events of any ABI given to
+NewDecoder, decoded into an *Event:
+
+	decoder := event.NewDecoder(myContractAbi)
+	for _, log := range receipt.Logs {
+		switch e, _ := decoder.DecodeLog(*log); e := e.(type) {
+		case *event.Transfer:
+			fmt.Println(e.From, e.To, e.Value)
+		case *event.Event:
+			fmt.Println(e.Name, e.Args)
+		}
+	}
+
+Core.GetEvents and WS.SubscribeEvents take a Decoder too.
+*/
+package event
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/poteto-go/go-alchemy-sdk/constant"
+	"github.com/poteto-go/go-alchemy-sdk/internal"
+	"github.com/poteto-go/go-alchemy-sdk/types"
+	"github.com/poteto-go/go-alchemy-sdk/utils"
+)
+
+// Event is a log of an event declared in an ABI given to NewDecoder.
+type Event struct {
+	Name string
+
+	// Values are the parameters in declaration order. An indexed string,
+	// bytes, array or tuple is only its keccak256 hash, as a common.Hash.
+	Values []any
+
+	// Args are Values by parameter name.
+	Args map[string]any
+
+	Raw gethTypes.Log
+}
+
+type definition struct {
+	event abi.Event
+
+	// typ is the struct the event decodes into; nil for an *Event.
+	typ reflect.Type
+}
+
+/*
+Decoder decodes event logs. It is safe for concurrent use.
+
+An event is matched by its topic and its number of indexed parameters, which
+tells apart e.g. the ERC-20 and ERC-721 Transfer events.
+*/
+type Decoder struct {
+	definitions map[common.Hash][]definition
+}
+
+var standardDecoder = NewDecoder()
+
+// Standard returns the Decoder of the standard events only.
+func Standard() *Decoder {
+	return standardDecoder
+}
+
+/*
+NewDecoder returns a Decoder of the standard events and the events of abis.
+A standard event keeps its typed struct even if one of abis declares it too.
+Anonymous events have no topic to match, and are skipped.
+*/
+func NewDecoder(abis ...abi.ABI) *Decoder {
+	d := &Decoder{definitions: map[common.Hash][]definition{}}
+	for _, def := range standardDefinitions {
+		d.add(def)
+	}
+	for _, contractAbi := range abis {
+		for _, e := range contractAbi.Events {
+			if !e.Anonymous {
+				d.add(definition{event: e})
+			}
+		}
+	}
+	return d
+}
+
+func (d *Decoder) add(def definition) {
+	if _, ok := d.lookup(def.event.ID, indexedCount(def.event)); ok {
+		return
+	}
+	d.definitions[def.event.ID] = append(d.definitions[def.event.ID], def)
+}
+
+func (d *Decoder) lookup(topic common.Hash, indexed int) (definition, bool) {
+	for _, def := range d.definitions[topic] {
+		if indexedCount(def.event) == indexed {
+			return def, true
+		}
+	}
+	return definition{}, false
+}
+
+/*
+DecodeLog decodes log into the typed struct of a standard event, or an
+*Event. It returns constant.ErrUnknownEvent for a log of an event the
+Decoder does not know.
+*/
+func (d *Decoder) DecodeLog(log gethTypes.Log) (any, error) {
+	if len(log.Topics) == 0 {
+		return nil, errors.Join(constant.ErrUnknownEvent, errors.New("log has no topic"))
+	}
+	def, ok := d.lookup(log.Topics[0], len(log.Topics)-1)
+	if !ok {
+		return nil, errors.Join(
+			constant.ErrUnknownEvent,
+			fmt.Errorf("topic %s with %d indexed", log.Topics[0].Hex(), len(log.Topics)-1),
+		)
+	}
+
+	values, err := unpack(def.event, log)
+	if err != nil {
+		return nil, err
+	}
+
+	if def.typ == nil {
+		args := make(map[string]any, len(values))
+		for i, input := range def.event.Inputs {
+			args[input.Name] = values[i]
+		}
+		return &Event{Name: def.event.Name, Values: values, Args: args, Raw: log}, nil
+	}
+
+	v := reflect.New(def.typ)
+	if err := internal.ConvertABIValue(v.Elem(), reflect.ValueOf(values)); err != nil {
+		return nil, err
+	}
+	v.Elem().FieldByName("Raw").Set(reflect.ValueOf(log))
+	return v.Interface(), nil
+}
+
+// DecodeLogResponse is DecodeLog for a log of the JSON-RPC API, e.g. of
+// Core.GetLogs or types.TransactionReceipt.
+func (d *Decoder) DecodeLogResponse(log types.LogResponse) (any, error) {
+	gethLog, err := utils.TransformAlchemyLogToGeth(log)
+	if err != nil {
+		return nil, err
+	}
+	return d.DecodeLog(*gethLog)
+}
+
+/*
+DecodeLogResponses decodes logs in order, skipping the logs of events the
+Decoder does not know; any other error fails the whole call.
+*/
+func (d *Decoder) DecodeLogResponses(logs []types.LogResponse) ([]any, error) {
+	return decodeKnown(len(logs), func(i int) (any, error) {
+		return d.DecodeLogResponse(logs[i])
+	})
+}
+
+/*
+DecodeReceipt decodes the logs of receipt, e.g. one returned by a wallet
+method, as DecodeLogResponses does.
+*/
+func (d *Decoder) DecodeReceipt(receipt *gethTypes.Receipt) ([]any, error) {
+	return decodeKnown(len(receipt.Logs), func(i int) (any, error) {
+		return d.DecodeLog(*receipt.Logs[i])
+	})
+}
+
+func decodeKnown(n int, decode func(i int) (any, error)) ([]any, error) {
+	events := make([]any, 0, n)
+	for i := range n {
+		e, err := decode(i)
+		if errors.Is(err, constant.ErrUnknownEvent) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, e)
+	}
+	return events, nil
+}
+
+// unpack returns the parameters of e in declaration order, read from the
+// topics of log if indexed and from its data otherwise.
+func unpack(e abi.Event, log gethTypes.Log) ([]any, error) {
+	nonIndexed, err := e.Inputs.NonIndexed().Unpack(log.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([]any, 0, len(e.Inputs))
+	topics := log.Topics[1:]
+	for _, input := range e.Inputs {
+		if !input.Indexed {
+			values = append(values, nonIndexed[0])
+			nonIndexed = nonIndexed[1:]
+			continue
+		}
+
+		topic := topics[0]
+		topics = topics[1:]
+		switch input.Type.T {
+		case abi.StringTy, abi.BytesTy, abi.SliceTy, abi.ArrayTy, abi.TupleTy:
+			// only the keccak256 hash of a dynamic value is in its topic
+			values = append(values, topic)
+		default:
+			out := map[string]any{}
+			input.Name = "value"
+			if err := abi.ParseTopicsIntoMap(out, abi.Arguments{input}, []common.Hash{topic}); err != nil {
+				return nil, err
+			}
+			values = append(values, out["value"])
+		}
+	}
+	return values, nil
+}
+
+func indexedCount(e abi.Event) int {
+	n := 0
+	for _, input := range e.Inputs {
+		if input.Indexed {
+			n++
+		}
+	}
+	return n
+}
+
+// define declares the standard event of signature, e.g.
+// "Transfer(address indexed from,address indexed to,uint256 value)",
+// decoded into a T.
+func define[T any](signature string) definition {
+	open := strings.Index(signature, "(")
+	inputs, err := internal.ParseABIArguments(signature[open+1 : len(signature)-1])
+	if err != nil {
+		panic(err)
+	}
+	name := signature[:open]
+	return definition{
+		event: abi.NewEvent(name, name, false, inputs),
+		typ:   reflect.TypeFor[T](),
+	}
+}
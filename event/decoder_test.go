@@ -0,0 +1,262 @@
+package event_test
+
+import (
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/poteto-go/go-alchemy-sdk/constant"
+	"github.com/poteto-go/go-alchemy-sdk/encode"
+	"github.com/poteto-go/go-alchemy-sdk/event"
+	"github.com/poteto-go/go-alchemy-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	alice    = common.HexToAddress("0x00000000000000000000000000000000000000a1")
+	bob      = common.HexToAddress("0x00000000000000000000000000000000000000b0")
+	contract = common.HexToAddress("0x00000000000000000000000000000000000000cc")
+)
+
+// newLog builds the log of the event of signature with the given indexed
+// topics and ABI-encoded data.
+func newLog(signature string, data []byte, indexed ...common.Hash) gethTypes.Log {
+	return gethTypes.Log{
+		Address: contract,
+		Topics:  append([]common.Hash{crypto.Keccak256Hash([]byte(signature))}, indexed...),
+		Data:    data,
+	}
+}
+
+// topicOf is the topic of an indexed address.
+func topicOf(address common.Address) common.Hash {
+	return common.BytesToHash(address.Bytes())
+}
+
+func pack(types string, values ...any) []byte {
+	data, err := encode.ABIPack(types, values...)
+	if err != nil {
+		panic(err)
+	}
+	return data
+}
+
+func TestDecoder_DecodeLog_Standard(t *testing.T) {
+	decoder := event.Standard()
+
+	t.Run("ERC-20 Transfer", func(t *testing.T) {
+		// Arrange
+		log := newLog("Transfer(address,address,uint256)", pack("uint256", 100), topicOf(alice), topicOf(bob))
+
+		// Act
+		decoded, err := decoder.DecodeLog(log)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, &event.Transfer{From: alice, To: bob, Value: big.NewInt(100), Raw: log}, decoded)
+	})
+
+	t.Run("ERC-721 Transfer has the same topic with the token id indexed", func(t *testing.T) {
+		// Arrange
+		log := newLog("Transfer(address,address,uint256)", nil, topicOf(alice), topicOf(bob), common.BigToHash(big.NewInt(7)))
+
+		// Act
+		decoded, err := decoder.DecodeLog(log)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, &event.NftTransfer{From: alice, To: bob, TokenId: big.NewInt(7), Raw: log}, decoded)
+	})
+
+	t.Run("ERC-1155 TransferBatch", func(t *testing.T) {
+		// Arrange
+		log := newLog(
+			"TransferBatch(address,address,address,uint256[],uint256[])",
+			pack("uint256[],uint256[]", []int{1, 2}, []int{10, 20}),
+			topicOf(alice), topicOf(alice), topicOf(bob),
+		)
+
+		// Act
+		decoded, err := decoder.DecodeLog(log)
+
+		// Assert
+		assert.NoError(t, err)
+		transferBatch := decoded.(*event.TransferBatch)
+		assert.Equal(t, bob, transferBatch.To)
+		assert.Equal(t, []*big.Int{big.NewInt(1), big.NewInt(2)}, transferBatch.Ids)
+		assert.Equal(t, []*big.Int{big.NewInt(10), big.NewInt(20)}, transferBatch.Values)
+	})
+
+	t.Run("ERC-1155 URI with an indexed parameter last", func(t *testing.T) {
+		// Arrange
+		log := newLog("URI(string,uint256)", pack("string", "ipfs://x"), common.BigToHash(big.NewInt(3)))
+
+		// Act
+		decoded, err := decoder.DecodeLog(log)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, &event.URI{Value: "ipfs://x", Id: big.NewInt(3), Raw: log}, decoded)
+	})
+
+	t.Run("FiatToken events", func(t *testing.T) {
+		// Arrange
+		blacklisted := newLog("Blacklisted(address)", nil, topicOf(bob))
+		blackListed := newLog("BlackListed(address)", nil, topicOf(bob))
+		configured := newLog("MinterConfigured(address,uint256)", pack("uint256", 5), topicOf(alice))
+		pause := newLog("Pause()", nil)
+		ownership := newLog("OwnershipTransferred(address,address)", pack("address,address", alice, bob))
+
+		// Act
+		decodedBlacklisted, err1 := decoder.DecodeLog(blacklisted)
+		decodedBlackListed, err2 := decoder.DecodeLog(blackListed)
+		decodedConfigured, err3 := decoder.DecodeLog(configured)
+		decodedPause, err4 := decoder.DecodeLog(pause)
+		decodedOwnership, err5 := decoder.DecodeLog(ownership)
+
+		// Assert
+		assert.NoError(t, err1)
+		assert.Equal(t, &event.Blacklisted{Account: bob, Raw: blacklisted}, decodedBlacklisted)
+		assert.NoError(t, err2)
+		assert.Equal(t, &event.Blacklisted{Account: bob, Raw: blackListed}, decodedBlackListed)
+		assert.NoError(t, err3)
+		assert.Equal(t, &event.MinterConfigured{Minter: alice, MinterAllowedAmount: big.NewInt(5), Raw: configured}, decodedConfigured)
+		assert.NoError(t, err4)
+		assert.Equal(t, &event.Pause{Raw: pause}, decodedPause)
+		assert.NoError(t, err5)
+		assert.Equal(t, &event.OwnershipTransferred{PreviousOwner: alice, NewOwner: bob, Raw: ownership}, decodedOwnership)
+	})
+
+	t.Run("unknown event", func(t *testing.T) {
+		// Act
+		_, err1 := decoder.DecodeLog(newLog("Swap(address,uint256)", pack("uint256", 1), topicOf(alice)))
+		_, err2 := decoder.DecodeLog(gethTypes.Log{})
+
+		// Assert
+		assert.ErrorIs(t, err1, constant.ErrUnknownEvent)
+		assert.ErrorIs(t, err2, constant.ErrUnknownEvent)
+	})
+
+	t.Run("malformed data", func(t *testing.T) {
+		// Act
+		_, err := decoder.DecodeLog(newLog("Transfer(address,address,uint256)", []byte{0x01}, topicOf(alice), topicOf(bob)))
+
+		// Assert
+		assert.Error(t, err)
+		assert.NotErrorIs(t, err, constant.ErrUnknownEvent)
+	})
+}
+
+const swapAbi = `[{"anonymous":false,"type":"event","name":"Swap","inputs":[
+	{"indexed":true,"name":"sender","type":"address"},
+	{"indexed":true,"name":"memo","type":"string"},
+	{"indexed":false,"name":"amount","type":"int256"},
+	{"indexed":false,"name":"path","type":"address[]"}
+]}]`
+
+func TestDecoder_DecodeLog_ABI(t *testing.T) {
+	t.Run("an event of a given ABI decodes into an *Event", func(t *testing.T) {
+		// Arrange
+		contractAbi, err := abi.JSON(strings.NewReader(swapAbi))
+		assert.NoError(t, err)
+		decoder := event.NewDecoder(contractAbi)
+		memoHash := crypto.Keccak256Hash([]byte("hello"))
+		log := newLog(
+			"Swap(address,string,int256,address[])",
+			pack("int256,address[]", -5, []common.Address{alice, bob}),
+			topicOf(alice), memoHash,
+		)
+
+		// Act
+		decoded, err := decoder.DecodeLog(log)
+
+		// Assert
+		assert.NoError(t, err)
+		swap := decoded.(*event.Event)
+		assert.Equal(t, "Swap", swap.Name)
+		assert.Equal(t, []any{alice, memoHash, big.NewInt(-5), []common.Address{alice, bob}}, swap.Values)
+		assert.Equal(t, big.NewInt(-5), swap.Args["amount"])
+		assert.Equal(t, memoHash, swap.Args["memo"])
+		assert.Equal(t, log, swap.Raw)
+	})
+
+	t.Run("a standard event keeps its typed struct", func(t *testing.T) {
+		// Arrange
+		erc20Abi, err := abi.JSON(strings.NewReader(`[{"anonymous":false,"type":"event","name":"Transfer","inputs":[
+			{"indexed":true,"name":"from","type":"address"},
+			{"indexed":true,"name":"to","type":"address"},
+			{"indexed":false,"name":"value","type":"uint256"}
+		]}]`))
+		assert.NoError(t, err)
+
+		// Act
+		decoded, err := event.NewDecoder(erc20Abi).DecodeLog(
+			newLog("Transfer(address,address,uint256)", pack("uint256", 1), topicOf(alice), topicOf(bob)),
+		)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.IsType(t, &event.Transfer{}, decoded)
+	})
+}
+
+func TestDecoder_DecodeLogResponses(t *testing.T) {
+	t.Run("decodes JSON-RPC logs, skipping unknown events", func(t *testing.T) {
+		// Arrange
+		transfer := newLog("Transfer(address,address,uint256)", pack("uint256", 100), topicOf(alice), topicOf(bob))
+		unknown := newLog("Swap(address,uint256)", pack("uint256", 1), topicOf(alice))
+		logs := make([]types.LogResponse, 0, 2)
+		for _, log := range []gethTypes.Log{unknown, transfer} {
+			topics := make([]string, len(log.Topics))
+			for i, topic := range log.Topics {
+				topics[i] = topic.Hex()
+			}
+			logs = append(logs, types.LogResponse{
+				LogIndex:         "0x0",
+				Topics:           topics,
+				Data:             hexutil.Encode(log.Data),
+				Address:          log.Address.Hex(),
+				BlockNumber:      "0x10",
+				TransactionIndex: "0x0",
+			})
+		}
+
+		// Act
+		decoded, err := event.Standard().DecodeLogResponses(logs)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Len(t, decoded, 1)
+		transferEvent := decoded[0].(*event.Transfer)
+		assert.Equal(t, big.NewInt(100), transferEvent.Value)
+		assert.Equal(t, uint64(16), transferEvent.Raw.BlockNumber)
+	})
+
+	t.Run("a malformed log fails the call", func(t *testing.T) {
+		// Act
+		_, err := event.Standard().DecodeLogResponses([]types.LogResponse{{BlockNumber: "zz"}})
+
+		// Assert
+		assert.Error(t, err)
+	})
+}
+
+func TestDecoder_DecodeReceipt(t *testing.T) {
+	t.Run("decodes the logs of a receipt", func(t *testing.T) {
+		// Arrange
+		approval := newLog("Approval(address,address,uint256)", pack("uint256", 9), topicOf(alice), topicOf(bob))
+		receipt := &gethTypes.Receipt{Logs: []*gethTypes.Log{&approval}}
+
+		// Act
+		decoded, err := event.Standard().DecodeReceipt(receipt)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, []any{&event.Approval{Owner: alice, Spender: bob, Value: big.NewInt(9), Raw: approval}}, decoded)
+	})
+}
@@ -0,0 +1,184 @@
+package event
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+// standardDefinitions are the events every Decoder knows.
+var standardDefinitions = []definition{
+	// ERC-20
+	define[Transfer]("Transfer(address indexed from,address indexed to,uint256 value)"),
+	define[Approval]("Approval(address indexed owner,address indexed spender,uint256 value)"),
+
+	// ERC-721: the topics of ERC-20's, with the token id indexed
+	define[NftTransfer]("Transfer(address indexed from,address indexed to,uint256 indexed tokenId)"),
+	define[NftApproval]("Approval(address indexed owner,address indexed approved,uint256 indexed tokenId)"),
+	define[ApprovalForAll]("ApprovalForAll(address indexed owner,address indexed operator,bool approved)"),
+
+	// ERC-1155
+	define[TransferSingle]("TransferSingle(address indexed operator,address indexed from,address indexed to,uint256 id,uint256 value)"),
+	define[TransferBatch]("TransferBatch(address indexed operator,address indexed from,address indexed to,uint256[] ids,uint256[] values)"),
+	define[URI]("URI(string value,uint256 indexed id)"),
+
+	// FiatToken
+	define[Mint]("Mint(address indexed minter,address indexed to,uint256 amount)"),
+	define[Burn]("Burn(address indexed burner,uint256 amount)"),
+	define[Blacklisted]("Blacklisted(address indexed account)"),
+	define[Blacklisted]("BlackListed(address indexed account)"),
+	define[UnBlacklisted]("UnBlacklisted(address indexed account)"),
+	define[MinterConfigured]("MinterConfigured(address indexed minter,uint256 minterAllowedAmount)"),
+	define[MinterRemoved]("MinterRemoved(address indexed oldMinter)"),
+	define[MasterMinterChanged]("MasterMinterChanged(address indexed newMasterMinter)"),
+	define[Pause]("Pause()"),
+	define[Unpause]("Unpause()"),
+	define[PauserChanged]("PauserChanged(address indexed newAddress)"),
+	define[BlacklisterChanged]("BlacklisterChanged(address indexed newBlacklister)"),
+	define[OwnershipTransferred]("OwnershipTransferred(address previousOwner,address newOwner)"),
+	define[OwnershipTransferred]("OwnershipTransferred(address indexed previousOwner,address indexed newOwner)"),
+}
+
+// Transfer is the ERC-20 Transfer event.
+type Transfer struct {
+	From  common.Address
+	To    common.Address
+	Value *big.Int
+	Raw   gethTypes.Log `abi:"-"`
+}
+
+// Approval is the ERC-20 Approval event.
+type Approval struct {
+	Owner   common.Address
+	Spender common.Address
+	Value   *big.Int
+	Raw     gethTypes.Log `abi:"-"`
+}
+
+// NftTransfer is the ERC-721 Transfer event.
+type NftTransfer struct {
+	From    common.Address
+	To      common.Address
+	TokenId *big.Int
+	Raw     gethTypes.Log `abi:"-"`
+}
+
+// NftApproval is the ERC-721 Approval event.
+type NftApproval struct {
+	Owner    common.Address
+	Approved common.Address
+	TokenId  *big.Int
+	Raw      gethTypes.Log `abi:"-"`
+}
+
+// ApprovalForAll is the ApprovalForAll event of ERC-721 and ERC-1155.
+type ApprovalForAll struct {
+	Owner    common.Address
+	Operator common.Address
+	Approved bool
+	Raw      gethTypes.Log `abi:"-"`
+}
+
+// TransferSingle is the ERC-1155 TransferSingle event.
+type TransferSingle struct {
+	Operator common.Address
+	From     common.Address
+	To       common.Address
+	Id       *big.Int
+	Value    *big.Int
+	Raw      gethTypes.Log `abi:"-"`
+}
+
+// TransferBatch is the ERC-1155 TransferBatch event.
+type TransferBatch struct {
+	Operator common.Address
+	From     common.Address
+	To       common.Address
+	Ids      []*big.Int
+	Values   []*big.Int
+	Raw      gethTypes.Log `abi:"-"`
+}
+
+// URI is the ERC-1155 URI event.
+type URI struct {
+	Value string
+	Id    *big.Int
+	Raw   gethTypes.Log `abi:"-"`
+}
+
+// Mint is the FiatToken Mint event.
+type Mint struct {
+	Minter common.Address
+	To     common.Address
+	Amount *big.Int
+	Raw    gethTypes.Log `abi:"-"`
+}
+
+// Burn is the FiatToken Burn event.
+type Burn struct {
+	Burner common.Address
+	Amount *big.Int
+	Raw    gethTypes.Log `abi:"-"`
+}
+
+// Blacklisted is the FiatToken Blacklisted event.
+type Blacklisted struct {
+	Account common.Address
+	Raw     gethTypes.Log `abi:"-"`
+}
+
+// UnBlacklisted is the FiatToken UnBlacklisted event.
+type UnBlacklisted struct {
+	Account common.Address
+	Raw     gethTypes.Log `abi:"-"`
+}
+
+// MinterConfigured is the FiatToken MinterConfigured event.
+type MinterConfigured struct {
+	Minter              common.Address
+	MinterAllowedAmount *big.Int
+	Raw                 gethTypes.Log `abi:"-"`
+}
+
+// MinterRemoved is the FiatToken MinterRemoved event.
+type MinterRemoved struct {
+	OldMinter common.Address
+	Raw       gethTypes.Log `abi:"-"`
+}
+
+// MasterMinterChanged is the FiatToken MasterMinterChanged event.
+type MasterMinterChanged struct {
+	NewMasterMinter common.Address
+	Raw             gethTypes.Log `abi:"-"`
+}
+
+// Pause is the FiatToken Pause event.
+type Pause struct {
+	Raw gethTypes.Log `abi:"-"`
+}
+
+// Unpause is the FiatToken Unpause event.
+type Unpause struct {
+	Raw gethTypes.Log `abi:"-"`
+}
+
+// PauserChanged is the FiatToken PauserChanged event.
+type PauserChanged struct {
+	NewAddress common.Address
+	Raw        gethTypes.Log `abi:"-"`
+}
+
+// BlacklisterChanged is the FiatToken BlacklisterChanged event.
+type BlacklisterChanged struct {
+	NewBlacklister common.Address
+	Raw            gethTypes.Log `abi:"-"`
+}
+
+// OwnershipTransferred is the OwnershipTransferred event of FiatToken and
+// Ownable contracts.
+type OwnershipTransferred struct {
+	PreviousOwner common.Address
+	NewOwner      common.Address
+	Raw           gethTypes.Log `abi:"-"`
+}
@@ -0,0 +1,366 @@
+/*
+Package follower follows the chain block by block, over plain HTTP or a
+websocket, and tells what changes when it reorganizes.
+
+A Follower polls eth_blockNumber and eth_getBlockByNumber (New), or wakes up
+on every newHeads notification (NewWs). It keeps the hashes of the last
+Config.Window blocks it emitted; a block whose parent hash is not the last
+one's means a reorg, and the Follower walks back to the common ancestor.
+Events come in chain order:
+
+  - *BlockAdded, then *LogsAdded if Config.Logs matched any of its logs
+  - on a reorg, from the old tip down: *LogsRemoved, then *BlockRemoved;
+    the blocks of the new chain are added after
+
+For example:
+
+	f := follower.New(alchemy.GetProvider().Eth(), follower.Config{
+		Confirmations: 3,
+		Logs:          &types.Filter{Address: "0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48"},
+	})
+	events := make(chan follower.Event)
+	errc := make(chan error, 1)
+	go func() {
+		errc <- f.Run(ctx, events)
+		close(events) // Run does not close events
+	}()
+	for e := range events {
+		switch e := e.(type) {
+		case *follower.BlockAdded:
+			fmt.Println("added", e.Block.Number())
+		case *follower.BlockRemoved:
+			fmt.Println("removed", e.Block.Number())
+		}
+	}
+	if err := <-errc; !errors.Is(err, context.Canceled) {
+		log.Println("follower stopped:", err)
+	}
+*/
+package follower
+
+import (
+	"context"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/poteto-go/go-alchemy-sdk/constant"
+	"github.com/poteto-go/go-alchemy-sdk/types"
+	"github.com/poteto-go/go-alchemy-sdk/utils"
+)
+
+const (
+	DefaultPollInterval = 2 * time.Second
+	DefaultWindow       = 128
+)
+
+type Config struct {
+	// Tag is the head followed: "latest" (default), "safe" or "finalized".
+	Tag string
+
+	// Confirmations is how many blocks must be on top of a block before it
+	// is added. A reorg no deeper than it is never seen.
+	Confirmations uint64
+
+	// PollInterval is the time between two polls of New; 0 uses
+	// DefaultPollInterval.
+	PollInterval time.Duration
+
+	// Window is how many added blocks are kept to find the common ancestor
+	// of a reorg; 0 uses DefaultWindow.
+	Window int
+
+	// Logs, if set, fetches the logs of every added block matching it.
+	// FromBlock and ToBlock are ignored.
+	Logs *types.Filter
+}
+
+// Event is a *BlockAdded, *BlockRemoved, *LogsAdded or *LogsRemoved.
+type Event interface {
+	isEvent()
+}
+
+type BlockAdded struct {
+	Block *gethTypes.Block
+}
+
+type BlockRemoved struct {
+	Block *gethTypes.Block
+}
+
+type LogsAdded struct {
+	Block *gethTypes.Block
+	Logs  []gethTypes.Log
+}
+
+// LogsRemoved are the logs of a LogsAdded, with Removed set.
+type LogsRemoved struct {
+	Block *gethTypes.Block
+	Logs  []gethTypes.Log
+}
+
+func (*BlockAdded) isEvent()   {}
+func (*BlockRemoved) isEvent() {}
+func (*LogsAdded) isEvent()    {}
+func (*LogsRemoved) isEvent()  {}
+
+type followed struct {
+	block *gethTypes.Block
+	logs  []gethTypes.Log
+}
+
+type Follower struct {
+	ether      types.EtherApi
+	subscriber types.Subscriber
+
+	tag           string
+	confirmations uint64
+	pollInterval  time.Duration
+	window        int
+	logs          *types.Filter
+
+	// blocks are the last added blocks, oldest first
+	blocks []followed
+
+	// next is the number of the next block to add, whose parent hash must
+	// be parent; unset until started.
+	started bool
+	next    uint64
+	parent  common.Hash
+}
+
+// New returns a Follower that polls ether every Config.PollInterval.
+func New(ether types.EtherApi, config Config) *Follower {
+	tag := config.Tag
+	if tag == "" {
+		tag = "latest"
+	}
+	pollInterval := config.PollInterval
+	if pollInterval <= 0 {
+		pollInterval = DefaultPollInterval
+	}
+	window := config.Window
+	if window <= 0 {
+		window = DefaultWindow
+	}
+	return &Follower{
+		ether:         ether,
+		tag:           tag,
+		confirmations: config.Confirmations,
+		pollInterval:  pollInterval,
+		window:        window,
+		logs:          config.Logs,
+	}
+}
+
+// NewWs returns a Follower that catches up on every new head of ether's
+// SubscribeNewHead instead of polling.
+func NewWs(ether types.WsEtherApi, config Config) *Follower {
+	f := New(ether, config)
+	f.subscriber = ether
+	return f
+}
+
+/*
+Run sends the events of the chain to events until ctx is done or a request
+fails, and returns why. It starts from the head the first time; run it again
+after an error to resume from the last added block. A reorg deeper than
+Config.Window fails with constant.ErrReorgTooDeep, and the next Run starts
+from the head again.
+
+A reorg is seen once the new chain has a block past the last added one.
+Run must not be called concurrently. It does not close events, so that it
+can be run again on the same channel.
+*/
+func (f *Follower) Run(ctx context.Context, events chan<- Event) error {
+	switch f.tag {
+	case "latest", "safe", "finalized":
+	default:
+		return constant.ErrInvalidBlockTag
+	}
+	if f.subscriber != nil {
+		return f.follow(ctx, events)
+	}
+	return f.poll(ctx, events)
+}
+
+func (f *Follower) poll(ctx context.Context, events chan<- Event) error {
+	ticker := time.NewTicker(f.pollInterval)
+	defer ticker.Stop()
+	for {
+		if err := f.sync(ctx, events); err != nil {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+func (f *Follower) follow(ctx context.Context, events chan<- Event) error {
+	heads := make(chan *gethTypes.Header)
+	sub, err := f.subscriber.SubscribeNewHead(ctx, heads)
+	if err != nil {
+		return err
+	}
+	defer sub.Unsubscribe()
+
+	if err := f.sync(ctx, events); err != nil {
+		return err
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case err := <-sub.Err():
+			return err
+		case <-heads:
+			if err := f.sync(ctx, events); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// sync adds the blocks after the last added one up to the head less the
+// confirmations, rewinding first if the chain reorganized under them.
+func (f *Follower) sync(ctx context.Context, events chan<- Event) error {
+	head, err := f.head(ctx)
+	if err != nil {
+		return err
+	}
+	if head < f.confirmations {
+		return nil
+	}
+	target := head - f.confirmations
+
+	if !f.started {
+		f.next = target
+	}
+	for f.next <= target {
+		block, err := f.ether.GetBlockByNumberContext(ctx, hexutil.EncodeUint64(f.next))
+		if err != nil {
+			return err
+		}
+		if f.started && block.ParentHash() != f.parent {
+			if err := f.rewind(ctx, events); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := f.add(ctx, events, block); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// rewind removes the added blocks the chain no longer has, from the tip
+// down to the common ancestor.
+func (f *Follower) rewind(ctx context.Context, events chan<- Event) error {
+	for len(f.blocks) > 0 {
+		last := f.blocks[len(f.blocks)-1]
+		canonical, err := f.ether.GetBlockByNumberContext(ctx, hexutil.EncodeUint64(last.block.NumberU64()))
+		if err != nil {
+			return err
+		}
+		if canonical.Hash() == last.block.Hash() {
+			f.next, f.parent = last.block.NumberU64()+1, last.block.Hash()
+			return nil
+		}
+
+		if len(last.logs) > 0 {
+			removed := make([]gethTypes.Log, len(last.logs))
+			for i, log := range last.logs {
+				log.Removed = true
+				removed[i] = log
+			}
+			if err := emit(ctx, events, &LogsRemoved{Block: last.block, Logs: removed}); err != nil {
+				return err
+			}
+		}
+		if err := emit(ctx, events, &BlockRemoved{Block: last.block}); err != nil {
+			return err
+		}
+		f.blocks = f.blocks[:len(f.blocks)-1]
+
+		// the parent of the oldest block is the last one the window knows
+		if len(f.blocks) == 0 && canonical.ParentHash() == last.block.ParentHash() {
+			f.next, f.parent = last.block.NumberU64(), last.block.ParentHash()
+			return nil
+		}
+	}
+	f.started = false
+	return constant.ErrReorgTooDeep
+}
+
+func (f *Follower) add(ctx context.Context, events chan<- Event, block *gethTypes.Block) error {
+	var logs []gethTypes.Log
+	if f.logs != nil {
+		var err error
+		if logs, err = f.logsOf(ctx, block); err != nil {
+			return err
+		}
+	}
+
+	if err := emit(ctx, events, &BlockAdded{Block: block}); err != nil {
+		return err
+	}
+	if len(logs) > 0 {
+		if err := emit(ctx, events, &LogsAdded{Block: block, Logs: logs}); err != nil {
+			return err
+		}
+	}
+
+	f.blocks = append(f.blocks, followed{block: block, logs: logs})
+	if len(f.blocks) > f.window {
+		f.blocks = f.blocks[1:]
+	}
+	f.started, f.next, f.parent = true, block.NumberU64()+1, block.Hash()
+	return nil
+}
+
+// logsOf fetches the logs of block matching Config.Logs by its hash, so they
+// cannot be of another block at the same height.
+func (f *Follower) logsOf(ctx context.Context, block *gethTypes.Block) ([]gethTypes.Log, error) {
+	filter := *f.logs
+	filter.FromBlock, filter.ToBlock = "", ""
+	filter.BlockHash = block.Hash().Hex()
+	responses, err := f.ether.GetLogsContext(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	logs := make([]gethTypes.Log, len(responses))
+	for i, response := range responses {
+		log, err := utils.TransformAlchemyLogToGeth(response)
+		if err != nil {
+			return nil, err
+		}
+		logs[i] = *log
+	}
+	return logs, nil
+}
+
+func (f *Follower) head(ctx context.Context) (uint64, error) {
+	if f.tag == "latest" {
+		return f.ether.BlockNumberContext(ctx)
+	}
+	block, err := f.ether.GetBlockByNumberContext(ctx, f.tag)
+	if err != nil {
+		return 0, err
+	}
+	return block.NumberU64(), nil
+}
+
+func emit(ctx context.Context, events chan<- Event, e Event) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case events <- e:
+		return nil
+	}
+}
@@ -0,0 +1,272 @@
+package follower_test
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	gethEvent "github.com/ethereum/go-ethereum/event"
+	"github.com/poteto-go/go-alchemy-sdk/constant"
+	"github.com/poteto-go/go-alchemy-sdk/follower"
+	"github.com/poteto-go/go-alchemy-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// chain is a node whose blocks the test can extend and reorganize. Every
+// block has one log.
+type chain struct {
+	types.WsEtherApi
+
+	mu     sync.Mutex
+	blocks []*gethTypes.Block
+	heads  chan<- *gethTypes.Header
+}
+
+func newChain(length int) *chain {
+	c := &chain{}
+	c.extend(length, 0)
+	return c
+}
+
+// extend appends n blocks, fork telling apart the blocks of different forks.
+func (c *chain) extend(n int, fork byte) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for range n {
+		header := &gethTypes.Header{
+			Number: big.NewInt(int64(len(c.blocks))),
+			Extra:  []byte{fork},
+		}
+		if len(c.blocks) > 0 {
+			header.ParentHash = c.blocks[len(c.blocks)-1].Hash()
+		}
+		c.blocks = append(c.blocks, gethTypes.NewBlockWithHeader(header))
+	}
+}
+
+// reorg replaces the last depth blocks by n blocks of fork.
+func (c *chain) reorg(depth, n int, fork byte) {
+	c.mu.Lock()
+	c.blocks = c.blocks[:len(c.blocks)-depth]
+	c.mu.Unlock()
+	c.extend(n, fork)
+}
+
+func (c *chain) block(n int) *gethTypes.Block {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.blocks[n]
+}
+
+func (c *chain) BlockNumberContext(_ context.Context) (uint64, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return uint64(len(c.blocks) - 1), nil
+}
+
+func (c *chain) GetBlockByNumberContext(_ context.Context, blockNumber string) (*gethTypes.Block, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if blockNumber == "safe" {
+		return c.blocks[len(c.blocks)-3], nil
+	}
+	n, err := hexutil.DecodeUint64(blockNumber)
+	if err != nil {
+		return nil, err
+	}
+	return c.blocks[n], nil
+}
+
+func (c *chain) GetLogsContext(_ context.Context, filter types.Filter) ([]types.LogResponse, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	for _, block := range c.blocks {
+		if block.Hash().Hex() == filter.BlockHash {
+			return []types.LogResponse{{
+				Address:          filter.Address,
+				BlockNumber:      hexutil.EncodeUint64(block.NumberU64()),
+				BlockHash:        filter.BlockHash,
+				TransactionIndex: "0x0",
+				LogIndex:         "0x0",
+				Data:             "0x",
+			}}, nil
+		}
+	}
+	return []types.LogResponse{}, nil
+}
+
+func (c *chain) SubscribeNewHead(_ context.Context, headerChan chan<- *gethTypes.Header) (ethereum.Subscription, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.heads = headerChan
+	return gethEvent.NewSubscription(func(quit <-chan struct{}) error {
+		<-quit
+		return nil
+	}), nil
+}
+
+func next(t *testing.T, events <-chan follower.Event) follower.Event {
+	t.Helper()
+	select {
+	case e := <-events:
+		return e
+	case <-time.After(2 * time.Second):
+		t.Fatal("no event")
+		return nil
+	}
+}
+
+func run(f *follower.Follower) (chan follower.Event, chan error, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(context.Background())
+	events := make(chan follower.Event)
+	errs := make(chan error, 1)
+	go func() {
+		errs <- f.Run(ctx, events)
+	}()
+	return events, errs, cancel
+}
+
+func TestFollower_Run(t *testing.T) {
+	config := follower.Config{
+		PollInterval: time.Millisecond,
+		Logs:         &types.Filter{Address: "0x0000000000000000000000000000000000000001"},
+	}
+
+	t.Run("adds the blocks & logs from the head on", func(t *testing.T) {
+		// Arrange
+		c := newChain(3)
+		events, _, cancel := run(follower.New(c, config))
+		defer cancel()
+
+		// Act & Assert
+		assert.Equal(t, &follower.BlockAdded{Block: c.block(2)}, next(t, events))
+		logs := next(t, events).(*follower.LogsAdded)
+		assert.Equal(t, c.block(2), logs.Block)
+		assert.Equal(t, uint64(2), logs.Logs[0].BlockNumber)
+		assert.Equal(t, common.HexToAddress("0x1"), logs.Logs[0].Address)
+
+		c.extend(2, 0)
+		assert.Equal(t, &follower.BlockAdded{Block: c.block(3)}, next(t, events))
+		assert.IsType(t, &follower.LogsAdded{}, next(t, events))
+		assert.Equal(t, &follower.BlockAdded{Block: c.block(4)}, next(t, events))
+		assert.IsType(t, &follower.LogsAdded{}, next(t, events))
+	})
+
+	t.Run("removes the blocks & logs of a reorg, then adds the new chain", func(t *testing.T) {
+		// Arrange
+		c := newChain(4)
+		events, errs, cancel := run(follower.New(c, follower.Config{PollInterval: time.Millisecond, Logs: config.Logs}))
+		defer cancel()
+		next(t, events)
+		next(t, events)
+		c.extend(1, 0)
+		next(t, events)
+		next(t, events)
+		old3, old4 := c.block(3), c.block(4)
+
+		// Act
+		c.reorg(2, 3, 1)
+
+		// Assert
+		removed := next(t, events).(*follower.LogsRemoved)
+		assert.Equal(t, old4, removed.Block)
+		assert.True(t, removed.Logs[0].Removed)
+		assert.Equal(t, &follower.BlockRemoved{Block: old4}, next(t, events))
+		assert.Equal(t, old3, next(t, events).(*follower.LogsRemoved).Block)
+		assert.Equal(t, &follower.BlockRemoved{Block: old3}, next(t, events))
+		for n := 3; n <= 5; n++ {
+			select {
+			case err := <-errs:
+				t.Log(err)
+			default:
+			}
+			assert.Equal(t, &follower.BlockAdded{Block: c.block(n)}, next(t, events))
+			assert.Equal(t, c.block(n), next(t, events).(*follower.LogsAdded).Block)
+		}
+	})
+
+	t.Run("adds a block once it has the confirmations", func(t *testing.T) {
+		// Arrange
+		c := newChain(6)
+		events, _, cancel := run(follower.New(c, follower.Config{PollInterval: time.Millisecond, Confirmations: 2}))
+		defer cancel()
+
+		// Act & Assert
+		assert.Equal(t, &follower.BlockAdded{Block: c.block(3)}, next(t, events))
+		c.extend(1, 0)
+		assert.Equal(t, &follower.BlockAdded{Block: c.block(4)}, next(t, events))
+	})
+
+	t.Run("follows the safe head", func(t *testing.T) {
+		// Arrange
+		c := newChain(6)
+		events, _, cancel := run(follower.New(c, follower.Config{PollInterval: time.Millisecond, Tag: "safe"}))
+		defer cancel()
+
+		// Act & Assert
+		assert.Equal(t, &follower.BlockAdded{Block: c.block(3)}, next(t, events))
+	})
+
+	t.Run("if the reorg is deeper than the window, return ErrReorgTooDeep", func(t *testing.T) {
+		// Arrange
+		c := newChain(3)
+		events, errs, cancel := run(follower.New(c, follower.Config{PollInterval: time.Millisecond, Window: 2}))
+		defer cancel()
+		next(t, events)
+		c.extend(1, 0)
+		next(t, events)
+
+		// Act
+		c.reorg(3, 4, 1)
+
+		// Assert
+		assert.IsType(t, &follower.BlockRemoved{}, next(t, events))
+		assert.IsType(t, &follower.BlockRemoved{}, next(t, events))
+		assert.ErrorIs(t, <-errs, constant.ErrReorgTooDeep)
+	})
+
+	t.Run("if invalid tag, return ErrInvalidBlockTag", func(t *testing.T) {
+		// Act
+		err := follower.New(newChain(1), follower.Config{Tag: "hoge"}).Run(context.Background(), nil)
+
+		// Assert
+		assert.ErrorIs(t, err, constant.ErrInvalidBlockTag)
+	})
+
+	t.Run("returns ctx.Err() once ctx is done", func(t *testing.T) {
+		// Arrange
+		c := newChain(1)
+		events, errs, cancel := run(follower.New(c, follower.Config{PollInterval: time.Millisecond}))
+		next(t, events)
+
+		// Act
+		cancel()
+
+		// Assert
+		assert.ErrorIs(t, <-errs, context.Canceled)
+	})
+}
+
+func TestFollower_RunWs(t *testing.T) {
+	// Arrange
+	c := newChain(2)
+	events, _, cancel := run(follower.NewWs(c, follower.Config{PollInterval: time.Hour}))
+	defer cancel()
+	assert.Equal(t, &follower.BlockAdded{Block: c.block(1)}, next(t, events))
+
+	// Act
+	c.extend(1, 0)
+	c.mu.Lock()
+	heads := c.heads
+	c.mu.Unlock()
+	heads <- c.block(2).Header()
+
+	// Assert
+	assert.Equal(t, &follower.BlockAdded{Block: c.block(2)}, next(t, events))
+}
@@ -3,6 +3,8 @@ package gas
 import (
 	"github.com/poteto-go/go-alchemy-sdk/ether"
 	"github.com/poteto-go/go-alchemy-sdk/namespace"
+	"github.com/poteto-go/go-alchemy-sdk/ratelimit"
+	"github.com/poteto-go/go-alchemy-sdk/telemetry"
 	"github.com/poteto-go/go-alchemy-sdk/types"
 )
 
@@ -16,7 +18,17 @@ type Alchemy struct {
 	StableCoin namespace.IStableCoin
 	Debug      namespace.IDebug
 	WS         namespace.IWS
-	provider   types.IAlchemyProvider
+
+	// ctx-first counterparts of the namespaces above: ctx bounds the whole
+	// call, backoff retries included.
+	CoreCtx       namespace.ICoreCtx
+	NftCtx        namespace.INftCtx
+	ERC1155Ctx    namespace.IErc1155Ctx
+	ERC20Ctx      namespace.IERC20Ctx
+	StableCoinCtx namespace.IStableCoinCtx
+	DebugCtx      namespace.IDebugCtx
+
+	provider types.IAlchemyProvider
 }
 
 func NewAlchemy(setting AlchemySetting) (Alchemy, error) {
@@ -49,6 +61,9 @@ func NewWsAlchemy(setting AlchemySetting) (Alchemy, error) {
 
 	alchemy := newAlchemy(alchemyConfig, alchemyProvider, eth)
 	alchemy.WS = namespace.NewWSNamespace(eth)
+	if t := alchemyConfig.telemetry; t != nil {
+		alchemy.WS = t.WS(alchemy.WS)
+	}
 	return alchemy, nil
 }
 
@@ -61,7 +76,7 @@ func newAlchemy(
 ) Alchemy {
 	provider.SetEth(eth)
 
-	return Alchemy{
+	alchemy := Alchemy{
 		config:     config,
 		Core:       namespace.NewCore(eth),
 		Transact:   namespace.NewTransactNamespace(eth),
@@ -70,19 +85,66 @@ func newAlchemy(
 		ERC20:      namespace.NewERC20Namespace(eth),
 		StableCoin: namespace.NewStableCoinNamespace(eth),
 		Debug:      namespace.NewDebugNamespace(eth),
-		provider:   provider,
+
+		CoreCtx:       namespace.NewCoreCtx(eth),
+		NftCtx:        namespace.NewNftCtxNamespace(eth),
+		ERC1155Ctx:    namespace.NewErc1155CtxNamespace(eth),
+		ERC20Ctx:      namespace.NewERC20CtxNamespace(eth),
+		StableCoinCtx: namespace.NewStableCoinCtxNamespace(eth),
+		DebugCtx:      namespace.NewDebugCtxNamespace(eth),
+
+		provider: provider,
 	}
+
+	if t := config.telemetry; t != nil {
+		alchemy.traceNamespaces(t, eth)
+	}
+	return alchemy
+}
+
+// traceNamespaces puts a span around every namespace call; the JSON-RPC
+// spans of the middleware are its children.
+func (gas *Alchemy) traceNamespaces(t *telemetry.Telemetry, eth types.EtherApi) {
+	core := namespace.NewCoreCtx(eth)
+	gas.Core, gas.CoreCtx = t.Core(core), t.CoreCtx(core)
+
+	gas.Transact = t.Transact(gas.Transact)
+
+	nft := namespace.NewNftCtxNamespace(eth)
+	gas.Nft, gas.NftCtx = t.Nft(nft), t.NftCtx(nft)
+
+	erc1155 := namespace.NewErc1155CtxNamespace(eth)
+	gas.ERC1155, gas.ERC1155Ctx = t.ERC1155(erc1155), t.ERC1155Ctx(erc1155)
+
+	erc20 := namespace.NewERC20CtxNamespace(eth)
+	gas.ERC20, gas.ERC20Ctx = t.ERC20(erc20), t.ERC20Ctx(erc20)
+
+	stableCoin := namespace.NewStableCoinCtxNamespace(eth)
+	gas.StableCoin, gas.StableCoinCtx = t.StableCoin(stableCoin), t.StableCoinCtx(stableCoin)
+
+	debug := namespace.NewDebugCtxNamespace(eth)
+	gas.Debug, gas.DebugCtx = t.Debug(debug), t.DebugCtx(debug)
 }
 
 func (gas *Alchemy) GetProvider() types.IAlchemyProvider {
 	return gas.provider
 }
 
+// RateLimiter returns the compute-unit limiter of AlchemySetting.RateLimit,
+// e.g. to read the CUs spent today; nil when rate limiting is disabled.
+func (gas *Alchemy) RateLimiter() *ratelimit.Limiter {
+	return gas.config.rateLimiter
+}
+
 // newProvider picks the transport-appropriate provider: ws/wss endpoints route
-// over the persistent websocket socket, everything else over HTTP.
+// over the persistent websocket socket, everything else over HTTP (with
+// failover when fallback urls are set).
 func newProvider(config AlchemyConfig) types.IAlchemyProvider {
 	if config.isWebSocket() {
 		return NewWsAlchemyProvider(config)
 	}
+	if config.failover != nil {
+		return NewFailoverAlchemyProvider(config)
+	}
 	return NewAlchemyProvider(config)
 }
@@ -7,8 +7,12 @@ import (
 	"strings"
 	"time"
 
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/poteto-go/go-alchemy-sdk/cache"
 	"github.com/poteto-go/go-alchemy-sdk/ether"
 	"github.com/poteto-go/go-alchemy-sdk/internal"
+	"github.com/poteto-go/go-alchemy-sdk/ratelimit"
+	"github.com/poteto-go/go-alchemy-sdk/telemetry"
 	"github.com/poteto-go/go-alchemy-sdk/types"
 	"github.com/poteto-go/go-alchemy-sdk/validate"
 )
@@ -17,15 +21,24 @@ type AlchemyConfig struct {
 	apiKey               string
 	network              types.Network
 	url                  string
+	urls                 []string
 	maxRetries           int
 	requestTimeout       time.Duration
 	isRequestBatch       bool
+	requestBatchSize     int
+	requestBatchWindow   time.Duration
 	backoffConfig        *types.BackoffConfig
 	privateNetworkConfig PrivateNetworkConfig
 	customHeaders        []http.Header
 	jwtSecret            []byte
 	maxResponseBytes     int64
 	transport            http.RoundTripper
+	failoverCooldown     time.Duration
+	failover             *internal.FailoverTransport
+	middlewares          []types.Middleware
+	errorABIs            []abi.ABI
+	telemetry            *telemetry.Telemetry
+	rateLimiter          *ratelimit.Limiter
 }
 
 func NewAlchemyConfig(setting AlchemySetting) (AlchemyConfig, error) {
@@ -33,8 +46,21 @@ func NewAlchemyConfig(setting AlchemySetting) (AlchemyConfig, error) {
 	if err := validate.Url(resolvedUrl); err != nil {
 		return AlchemyConfig{}, err
 	}
+	for _, failoverUrl := range setting.FailoverUrls {
+		if err := validate.Url(failoverUrl); err != nil {
+			return AlchemyConfig{}, err
+		}
+	}
 
-	return newAlchemyConfig(setting, resolvedUrl)
+	config, err := newAlchemyConfig(setting, resolvedUrl)
+	if err != nil {
+		return AlchemyConfig{}, err
+	}
+
+	if err := config.setFailover(setting.FailoverUrls); err != nil {
+		return AlchemyConfig{}, err
+	}
+	return config, nil
 }
 
 func NewWsAlchemyConfig(setting AlchemySetting) (AlchemyConfig, error) {
@@ -60,15 +86,21 @@ func newAlchemyConfig(setting AlchemySetting, rpcUrl string) (AlchemyConfig, err
 		apiKey:               setting.ApiKey,
 		network:              setting.Network,
 		url:                  rpcUrl,
+		urls:                 []string{rpcUrl},
 		maxRetries:           setting.MaxRetries,
 		requestTimeout:       setting.RequestTimeout,
 		isRequestBatch:       setting.IsRequestBatch,
+		requestBatchSize:     setting.RequestBatchSize,
+		requestBatchWindow:   setting.RequestBatchWindow,
 		backoffConfig:        setting.BackoffConfig,
 		privateNetworkConfig: setting.PrivateNetworkConfig,
 		customHeaders:        setting.CustomHeaders,
 		jwtSecret:            decodedJwt,
 		maxResponseBytes:     setting.MaxResponseBytes,
 		transport:            setting.Transport,
+		failoverCooldown:     setting.FailoverCooldown,
+		middlewares:          setting.Middlewares,
+		errorABIs:            setting.ErrorABIs,
 	}
 
 	if config.requestTimeout == 0 {
@@ -83,9 +115,70 @@ func newAlchemyConfig(setting AlchemySetting, rpcUrl string) (AlchemyConfig, err
 		config.maxResponseBytes = types.DefaultMaxResponseBytes
 	}
 
+	if config.requestBatchSize <= 0 {
+		config.requestBatchSize = 100
+	}
+
+	if config.requestBatchWindow <= 0 {
+		config.requestBatchWindow = time.Millisecond * 10
+	}
+
+	if config.failoverCooldown == 0 {
+		config.failoverCooldown = time.Second * 30
+	}
+
+	// sdk middlewares run before the user ones: telemetry outermost so its
+	// span covers cache hits, then the cache so neither the rate limiter nor
+	// user middlewares see calls that never reach the node.
+	var sdkMiddlewares []types.Middleware
+	if setting.Telemetry != nil {
+		t, err := telemetry.New(*setting.Telemetry, setting.Network)
+		if err != nil {
+			return AlchemyConfig{}, err
+		}
+		config.telemetry = t
+		sdkMiddlewares = append(sdkMiddlewares, t.Middleware())
+	}
+	if setting.Cache != nil {
+		sdkMiddlewares = append(sdkMiddlewares, cache.New(*setting.Cache).Middleware())
+	}
+	if setting.RateLimit != nil {
+		config.rateLimiter = ratelimit.New(*setting.RateLimit)
+		sdkMiddlewares = append(sdkMiddlewares, config.rateLimiter.Middleware())
+	}
+	if len(sdkMiddlewares) > 0 {
+		config.middlewares = append(sdkMiddlewares, setting.Middlewares...)
+	}
+
 	return config, nil
 }
 
+// setFailover puts the failover transport under every http call when fallback
+// urls are given. Provider and ether both build their http.Client from
+// config.transport, so they share one endpoint pool.
+func (config *AlchemyConfig) setFailover(failoverUrls []string) error {
+	if len(failoverUrls) == 0 {
+		return nil
+	}
+
+	config.urls = append(config.urls, failoverUrls...)
+	pool, err := internal.NewEndpointPool(config.urls, config.failoverCooldown)
+	if err != nil {
+		return err
+	}
+
+	// custom headers may carry the credentials of the primary url
+	var authHeaders []string
+	for _, header := range config.customHeaders {
+		for key := range header {
+			authHeaders = append(authHeaders, key)
+		}
+	}
+	config.failover = internal.NewFailoverTransport(pool, config.transport, authHeaders...)
+	config.transport = config.failover
+	return nil
+}
+
 func settingToUrl(setting AlchemySetting) string {
 	if isPrivateNetwork(setting) {
 		return resolvePrivateNetUrl(setting)
@@ -127,6 +220,11 @@ func (config *AlchemyConfig) GetUrl() string {
 	return config.url
 }
 
+// GetUrls returns every endpoint in failover order; the first one is GetUrl.
+func (config *AlchemyConfig) GetUrls() []string {
+	return config.urls
+}
+
 // isWebSocket reports whether the resolved url is a ws/wss endpoint, mirroring
 // Ether.isWebSocket so provider selection and transport selection agree.
 func (config *AlchemyConfig) isWebSocket() bool {
@@ -144,5 +242,7 @@ func (config *AlchemyConfig) toEtherApiConfig() ether.EtherApiConfig {
 		config.jwtSecret,
 		config.maxResponseBytes,
 		config.transport,
+		config.middlewares,
+		config.errorABIs,
 	)
 }
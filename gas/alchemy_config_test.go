@@ -3,9 +3,14 @@ package gas
 import (
 	"net/http"
 	"testing"
+	"time"
 
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/poteto-go/go-alchemy-sdk/cache"
 	"github.com/poteto-go/go-alchemy-sdk/constant"
 	"github.com/poteto-go/go-alchemy-sdk/ether"
+	"github.com/poteto-go/go-alchemy-sdk/ratelimit"
+	"github.com/poteto-go/go-alchemy-sdk/telemetry"
 	"github.com/poteto-go/go-alchemy-sdk/types"
 	"github.com/stretchr/testify/assert"
 )
@@ -217,6 +222,44 @@ func TestNewAlchemyConfig_Transport(t *testing.T) {
 	})
 }
 
+func TestNewAlchemyConfig_Middlewares(t *testing.T) {
+	// Arrange
+	var called bool
+	middleware := func(next types.RpcHandler) types.RpcHandler {
+		called = true
+		return next
+	}
+
+	// Act
+	config, err := NewAlchemyConfig(AlchemySetting{
+		ApiKey:      "api-key",
+		Network:     types.MaticMainnet,
+		Middlewares: []types.Middleware{middleware},
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Len(t, config.middlewares, 1)
+	config.middlewares[0](nil)
+	assert.True(t, called)
+}
+
+func TestNewAlchemyConfig_ErrorABIs(t *testing.T) {
+	// Arrange
+	errorAbi := abi.ABI{Errors: map[string]abi.Error{"Unauthorized": abi.NewError("Unauthorized", abi.Arguments{})}}
+
+	// Act
+	config, err := NewAlchemyConfig(AlchemySetting{
+		ApiKey:    "api-key",
+		Network:   types.MaticMainnet,
+		ErrorABIs: []abi.ABI{errorAbi},
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, []abi.ABI{errorAbi}, config.errorABIs)
+}
+
 func TestAlchemyConfig_GetUrl(t *testing.T) {
 	t.Run("can resolve alchemy rpc url", func(t *testing.T) {
 		// Arrange
@@ -270,3 +313,151 @@ func TestAlchemyConfig_toEtherApiConfig(t *testing.T) {
 	// Assert
 	assert.IsType(t, etherConfig, ether.EtherApiConfig{})
 }
+
+func TestNewAlchemyConfig_Failover(t *testing.T) {
+	t.Run("no failover urls -> single endpoint w/o failover transport", func(t *testing.T) {
+		config, err := NewAlchemyConfig(AlchemySetting{
+			ApiKey:  "api-key",
+			Network: types.MaticMainnet,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"https://matic-mainnet.g.alchemy.com/v2/api-key"}, config.GetUrls())
+		assert.Nil(t, config.failover)
+		assert.Nil(t, config.transport)
+	})
+
+	t.Run("failover urls follow the resolved url in order", func(t *testing.T) {
+		custom := &http.Transport{}
+		config, err := NewAlchemyConfig(AlchemySetting{
+			ApiKey:       "api-key",
+			Network:      types.MaticMainnet,
+			FailoverUrls: []string{"http://node-a:8545", "https://node-b.example.com"},
+			Transport:    custom,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []string{
+			"https://matic-mainnet.g.alchemy.com/v2/api-key",
+			"http://node-a:8545",
+			"https://node-b.example.com",
+		}, config.GetUrls())
+		assert.Equal(t, config.url, config.GetUrls()[0])
+		assert.Same(t, config.failover, config.transport)
+		assert.Equal(t, 3, config.failover.Pool().Len())
+	})
+
+	t.Run("defaults cooldown to 30s", func(t *testing.T) {
+		config, err := NewAlchemyConfig(AlchemySetting{
+			ApiKey:  "api-key",
+			Network: types.MaticMainnet,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, time.Second*30, config.failoverCooldown)
+	})
+
+	t.Run("invalid failover url returns error", func(t *testing.T) {
+		_, err := NewAlchemyConfig(AlchemySetting{
+			ApiKey:       "api-key",
+			Network:      types.MaticMainnet,
+			FailoverUrls: []string{"ftp://bad-scheme.com"},
+		})
+
+		assert.ErrorIs(t, err, constant.ErrInvalidPrivateNetworkUrl)
+	})
+}
+
+func TestNewAlchemyConfig_RequestBatch(t *testing.T) {
+	t.Run("defaults batch size & window", func(t *testing.T) {
+		config, err := NewAlchemyConfig(AlchemySetting{
+			ApiKey:         "api-key",
+			Network:        types.MaticMainnet,
+			IsRequestBatch: true,
+		})
+
+		assert.NoError(t, err)
+		assert.True(t, config.isRequestBatch)
+		assert.Equal(t, 100, config.requestBatchSize)
+		assert.Equal(t, time.Millisecond*10, config.requestBatchWindow)
+	})
+
+	t.Run("uses configured values when set", func(t *testing.T) {
+		config, err := NewAlchemyConfig(AlchemySetting{
+			ApiKey:             "api-key",
+			Network:            types.MaticMainnet,
+			IsRequestBatch:     true,
+			RequestBatchSize:   20,
+			RequestBatchWindow: time.Millisecond * 5,
+		})
+
+		assert.NoError(t, err)
+		assert.Equal(t, 20, config.requestBatchSize)
+		assert.Equal(t, time.Millisecond*5, config.requestBatchWindow)
+	})
+}
+
+func TestNewAlchemyConfig_Telemetry(t *testing.T) {
+	t.Run("telemetry middleware is the outermost one", func(t *testing.T) {
+		// Arrange
+		var called bool
+		middleware := func(next types.RpcHandler) types.RpcHandler {
+			called = true
+			return next
+		}
+
+		// Act
+		config, err := NewAlchemyConfig(AlchemySetting{
+			ApiKey:      "api-key",
+			Network:     types.MaticMainnet,
+			Middlewares: []types.Middleware{middleware},
+			Telemetry:   &telemetry.Config{},
+		})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.NotNil(t, config.telemetry)
+		assert.Len(t, config.middlewares, 2)
+		config.middlewares[1](nil)
+		assert.True(t, called)
+	})
+
+	t.Run("nil telemetry is disabled", func(t *testing.T) {
+		// Act
+		config, err := NewAlchemyConfig(AlchemySetting{
+			ApiKey:  "api-key",
+			Network: types.MaticMainnet,
+		})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Nil(t, config.telemetry)
+		assert.Empty(t, config.middlewares)
+	})
+}
+
+func TestNewAlchemyConfig_SdkMiddlewares(t *testing.T) {
+	// Arrange
+	var called bool
+	middleware := func(next types.RpcHandler) types.RpcHandler {
+		called = true
+		return next
+	}
+
+	// Act
+	config, err := NewAlchemyConfig(AlchemySetting{
+		ApiKey:      "api-key",
+		Network:     types.MaticMainnet,
+		Middlewares: []types.Middleware{middleware},
+		Telemetry:   &telemetry.Config{},
+		Cache:       &cache.Config{},
+		RateLimit:   &ratelimit.Config{ComputeUnitsPerSecond: 300},
+	})
+
+	// Assert
+	assert.NoError(t, err)
+	assert.NotNil(t, config.rateLimiter)
+	assert.Len(t, config.middlewares, 4)
+	config.middlewares[3](nil)
+	assert.True(t, called)
+}
@@ -4,6 +4,10 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/poteto-go/go-alchemy-sdk/cache"
+	"github.com/poteto-go/go-alchemy-sdk/ratelimit"
+	"github.com/poteto-go/go-alchemy-sdk/telemetry"
 	"github.com/poteto-go/go-alchemy-sdk/types"
 )
 
@@ -21,9 +25,16 @@ type AlchemySetting struct {
 	Network    types.Network `yaml:"network"`
 	MaxRetries int           `yaml:"max_retries"`
 
-	// currently not working
+	// Combine concurrent Send calls into one JSON-RPC array request.
+	// Each caller still gets its own result or *types.RpcError.
 	IsRequestBatch bool `yaml:"is_request_batch"`
 
+	// Max number of requests in one auto batch (default: 100).
+	RequestBatchSize int `yaml:"request_batch_size"`
+
+	// How long the auto batch waits to collect requests (default: 10ms).
+	RequestBatchWindow time.Duration `yaml:"request_batch_window"`
+
 	// config for backoff retry
 	BackoffConfig *types.BackoffConfig `yaml:"backoff_config"`
 
@@ -33,6 +44,16 @@ type AlchemySetting struct {
 	// You should set if you want to use p8 network
 	PrivateNetworkConfig PrivateNetworkConfig `yaml:"private_network_config"`
 
+	// Ordered fallback RPC endpoints (e.g. private nodes) tried after the
+	// resolved Alchemy / private url when it fails on transport errors, 5xx or
+	// rate limits. Applies to both Send and geth client calls.
+	// Only used over HTTP.
+	FailoverUrls []string `yaml:"failover_urls"`
+
+	// How long a failed endpoint is skipped before it is tried again
+	// (default: 30s).
+	FailoverCooldown time.Duration `yaml:"failover_cooldown"`
+
 	CustomHeaders []http.Header `yaml:"custom_headers"`
 
 	// Maximum bytes to read from an RPC response body (default: 32 MiB).
@@ -47,6 +68,28 @@ type AlchemySetting struct {
 	// http.DefaultTransport. The SDK always applies its response-size cap on top of it.
 	Transport http.RoundTripper `yaml:"-"`
 
+	// Middlewares wrap every JSON-RPC call, both Send and the geth client
+	// calls of Ether, over HTTP and ws. The first one is the outermost.
+	// See types.Middleware.
+	Middlewares []types.Middleware `yaml:"-"`
+
+	// Telemetry turns on OpenTelemetry spans and metrics for every namespace
+	// and JSON-RPC call. nil means disabled. See package telemetry.
+	Telemetry *telemetry.Config `yaml:"-"`
+
+	// Cache serves immutable and block-scoped results without asking the
+	// node again. nil means disabled. See package cache.
+	Cache *cache.Config `yaml:"-"`
+
+	// RateLimit keeps calls within a compute-unit rate and daily budget,
+	// per Alchemy instance. nil means disabled. See package ratelimit.
+	RateLimit *ratelimit.Config `yaml:"-"`
+
+	// ErrorABIs declare the Solidity custom errors to match when a call,
+	// gas estimate or transaction reverts. Error(string) and Panic(uint256)
+	// are always decoded. See types.RevertError.
+	ErrorABIs []abi.ABI `yaml:"-"`
+
 	/*
 		return true => p8net is selected
 
@@ -1,9 +1,19 @@
 package gas
 
 import (
+	"errors"
+	"net/http"
 	"testing"
+	"time"
 
+	"github.com/jarcoal/httpmock"
+	"github.com/poteto-go/go-alchemy-sdk/cache"
+	"github.com/poteto-go/go-alchemy-sdk/ratelimit"
+	"github.com/poteto-go/go-alchemy-sdk/telemetry"
+	"github.com/poteto-go/go-alchemy-sdk/types"
 	"github.com/stretchr/testify/assert"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 )
 
 func TestNewAlchemy(t *testing.T) {
@@ -57,3 +67,184 @@ func TestAlchemy_GetProvider(t *testing.T) {
 	// Assert
 	assert.NotNil(t, provider)
 }
+
+func TestNewAlchemy_Telemetry(t *testing.T) {
+	// Arrange
+	recorder := tracetest.NewSpanRecorder()
+	setting := AlchemySetting{
+		ApiKey:        "hoge",
+		Network:       types.EthMainnet,
+		BackoffConfig: &types.BackoffConfig{MaxRetries: 0},
+		Telemetry: &telemetry.Config{
+			TracerProvider: sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)),
+		},
+	}
+	alchemy, err := NewAlchemy(setting)
+	assert.NoError(t, err)
+	httpmock.Activate(t)
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder(
+		"POST",
+		alchemy.config.GetUrl(),
+		httpmock.NewStringResponder(200, `{"jsonrpc":"2.0","id":1,"result":"0x10"}`),
+	)
+
+	// Act
+	blockNumber, err := alchemy.Core.GetBlockNumber()
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(16), blockNumber)
+	spans := recorder.Ended()
+	assert.Len(t, spans, 2)
+	rpcSpan, namespaceSpan := spans[0], spans[1]
+	assert.Equal(t, "eth_blockNumber", rpcSpan.Name())
+	assert.Equal(t, "Core.GetBlockNumber", namespaceSpan.Name())
+	assert.Equal(t, namespaceSpan.SpanContext().SpanID(), rpcSpan.Parent().SpanID())
+}
+
+func TestNewAlchemy_Cache(t *testing.T) {
+	// Arrange
+	setting := AlchemySetting{
+		ApiKey:        "hoge",
+		Network:       types.EthMainnet,
+		BackoffConfig: &types.BackoffConfig{MaxRetries: 0},
+		Cache:         &cache.Config{},
+	}
+	alchemy, err := NewAlchemy(setting)
+	assert.NoError(t, err)
+	httpmock.Activate(t)
+	defer httpmock.DeactivateAndReset()
+	httpmock.RegisterResponder(
+		"POST",
+		alchemy.config.GetUrl(),
+		httpmock.NewStringResponder(200, `{"jsonrpc":"2.0","id":1,"result":"0x0000000000000000000000000000000000000000000000000000000000000012"}`),
+	)
+	contractAddress := "0x1234567890abcdef1234567890abcdef12345678"
+
+	// Act
+	first, err1 := alchemy.ERC20.Decimals(contractAddress)
+	second, err2 := alchemy.ERC20.Decimals(contractAddress)
+
+	// Assert
+	assert.NoError(t, err1)
+	assert.NoError(t, err2)
+	assert.Equal(t, uint8(18), first)
+	assert.Equal(t, uint8(18), second)
+	assert.Equal(t, 1, httpmock.GetTotalCallCount())
+}
+
+func TestAlchemy_RateLimiter(t *testing.T) {
+	t.Run("nil when disabled", func(t *testing.T) {
+		alchemy, err := NewAlchemy(AlchemySetting{ApiKey: "hoge", Network: "fuga"})
+		assert.NoError(t, err)
+		assert.Nil(t, alchemy.RateLimiter())
+	})
+
+	t.Run("counts the compute units spent", func(t *testing.T) {
+		// Arrange
+		alchemy, err := NewAlchemy(AlchemySetting{
+			ApiKey:        "hoge",
+			Network:       types.EthMainnet,
+			BackoffConfig: &types.BackoffConfig{MaxRetries: 0},
+			RateLimit:     &ratelimit.Config{DailyBudget: 1000},
+		})
+		assert.NoError(t, err)
+		httpmock.Activate(t)
+		defer httpmock.DeactivateAndReset()
+		httpmock.RegisterResponder(
+			"POST",
+			alchemy.config.GetUrl(),
+			httpmock.NewStringResponder(200, `{"jsonrpc":"2.0","id":1,"result":"0x10"}`),
+		)
+
+		// Act
+		_, err = alchemy.Core.GetBlockNumber()
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, int64(10), alchemy.RateLimiter().Used())
+	})
+}
+
+func TestNewAlchemy_RetryAfter(t *testing.T) {
+	rateLimited := func() *http.Response {
+		res := httpmock.NewStringResponse(
+			http.StatusTooManyRequests,
+			`{"jsonrpc":"2.0","id":1,"error":{"code":429,"message":"Your app has exceeded its compute units per second capacity."}}`,
+		)
+		res.Header.Set("Retry-After", "1")
+		res.Header.Set("X-RateLimit-Limit", "330")
+		res.Header.Set("X-RateLimit-Remaining", "0")
+		return res
+	}
+	newAlchemyForTest := func(maxRetries int) Alchemy {
+		alchemy, err := NewAlchemy(AlchemySetting{
+			ApiKey:  "hoge",
+			Network: types.EthMainnet,
+			BackoffConfig: &types.BackoffConfig{
+				Mode:            "exponential",
+				MaxRetries:      maxRetries,
+				InitialDelayMs:  1,
+				MaxDelayMs:      1,
+				MaxRetryAfterMs: 2000,
+			},
+		})
+		assert.NoError(t, err)
+		return alchemy
+	}
+
+	for name, call := range map[string]func(Alchemy) error{
+		"provider Send": func(alchemy Alchemy) error {
+			_, err := alchemy.Core.GetTokenMetadata("0x1234567890abcdef1234567890abcdef12345678")
+			return err
+		},
+		"geth client": func(alchemy Alchemy) error {
+			_, err := alchemy.Core.GetBlockNumber()
+			return err
+		},
+	} {
+		t.Run(name+": waits Retry-After before retrying", func(t *testing.T) {
+			// Arrange
+			alchemy := newAlchemyForTest(1)
+			httpmock.Activate(t)
+			defer httpmock.DeactivateAndReset()
+			httpmock.RegisterResponder(
+				"POST",
+				alchemy.config.GetUrl(),
+				httpmock.ResponderFromMultipleResponses([]*http.Response{
+					rateLimited(),
+					httpmock.NewStringResponse(200, `{"jsonrpc":"2.0","id":1,"result":"0x10"}`),
+				}),
+			)
+
+			// Act
+			start := time.Now()
+			call(alchemy)
+			elapsed := time.Since(start)
+
+			// Assert
+			assert.Equal(t, 2, httpmock.GetTotalCallCount())
+			assert.GreaterOrEqual(t, elapsed, time.Second)
+		})
+
+		t.Run(name+": out of retries -> RateLimitError", func(t *testing.T) {
+			// Arrange
+			alchemy := newAlchemyForTest(0)
+			httpmock.Activate(t)
+			defer httpmock.DeactivateAndReset()
+			httpmock.RegisterResponder("POST", alchemy.config.GetUrl(), httpmock.ResponderFromResponse(rateLimited()))
+
+			// Act
+			err := call(alchemy)
+
+			// Assert
+			rateLimitErr, ok := errors.AsType[*types.RateLimitError](err)
+			assert.True(t, ok)
+			assert.Equal(t, time.Second, rateLimitErr.RetryAfter)
+			assert.Equal(t, 330, rateLimitErr.Limit)
+			assert.Equal(t, 0, rateLimitErr.Remaining)
+			assert.Equal(t, 429, rateLimitErr.Code)
+		})
+	}
+}
@@ -0,0 +1,40 @@
+package gas
+
+import (
+	"github.com/poteto-go/go-alchemy-sdk/types"
+)
+
+/*
+FailoverAlchemyProvider is the AlchemyProvider used when
+AlchemySetting.FailoverUrls is set.
+
+Every request goes to the first healthy endpoint in order (the resolved
+Alchemy / private url first, then FailoverUrls). An endpoint failing with a
+transport error, 5xx or 429 is skipped for FailoverCooldown and the request
+moves on to the next one; once the cooldown elapses the endpoint is tried
+again and preferred as soon as it answers.
+
+The switch happens in the shared http transport, so geth client calls made by
+Ether follow the same endpoint as Send.
+*/
+type FailoverAlchemyProvider struct {
+	*AlchemyProvider
+}
+
+func NewFailoverAlchemyProvider(config AlchemyConfig) types.IAlchemyProvider {
+	provider := NewAlchemyProvider(config).(*AlchemyProvider)
+	return &FailoverAlchemyProvider{
+		AlchemyProvider: provider,
+	}
+}
+
+// Endpoints returns every endpoint in failover order.
+func (provider *FailoverAlchemyProvider) Endpoints() []string {
+	return provider.config.GetUrls()
+}
+
+// ActiveEndpoint returns the endpoint the next request goes to first.
+func (provider *FailoverAlchemyProvider) ActiveEndpoint() string {
+	pool := provider.config.failover.Pool()
+	return pool.Endpoint(pool.Active()).String()
+}
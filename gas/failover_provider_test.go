@@ -0,0 +1,77 @@
+package gas
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/poteto-go/go-alchemy-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+const failoverUrl = "http://127.0.0.1:8545"
+
+var failoverSetting = AlchemySetting{
+	ApiKey:       "hoge",
+	Network:      "fuga",
+	FailoverUrls: []string{failoverUrl},
+	BackoffConfig: &types.BackoffConfig{
+		MaxRetries: 0,
+	},
+}
+
+func TestNewAlchemy_SelectsFailoverProvider(t *testing.T) {
+	// Act
+	alchemy, err := NewAlchemy(failoverSetting)
+
+	// Assert
+	assert.NoError(t, err)
+	provider, ok := alchemy.GetProvider().(*FailoverAlchemyProvider)
+	assert.True(t, ok)
+	assert.Equal(t, []string{"https://fuga.g.alchemy.com/v2/hoge", failoverUrl}, provider.Endpoints())
+	assert.Equal(t, "https://fuga.g.alchemy.com/v2/hoge", provider.ActiveEndpoint())
+}
+
+func TestFailoverAlchemyProvider(t *testing.T) {
+	primaryUrl := "https://fuga.g.alchemy.com/v2/hoge"
+
+	t.Run("Send moves to the next endpoint on 5xx", func(t *testing.T) {
+		// Arrange
+		httpmock.Activate(t)
+		defer httpmock.DeactivateAndReset()
+		alchemy, _ := NewAlchemy(failoverSetting)
+		provider := alchemy.GetProvider().(*FailoverAlchemyProvider)
+		httpmock.RegisterResponder("POST", primaryUrl, httpmock.NewStringResponder(http.StatusServiceUnavailable, ""))
+		httpmock.RegisterResponder("POST", failoverUrl, httpmock.NewStringResponder(200, `{"jsonrpc":"2.0","id":1,"result":"0x1234"}`))
+
+		// Act
+		result, err := provider.Send("hoge", types.RequestArgs{})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "0x1234", result)
+		assert.Equal(t, failoverUrl, provider.ActiveEndpoint())
+	})
+
+	t.Run("geth client calls share the failed over endpoint", func(t *testing.T) {
+		// Arrange
+		httpmock.Activate(t)
+		defer httpmock.DeactivateAndReset()
+		alchemy, _ := NewAlchemy(failoverSetting)
+		provider := alchemy.GetProvider().(*FailoverAlchemyProvider)
+		httpmock.RegisterResponder("POST", primaryUrl, httpmock.NewStringResponder(http.StatusTooManyRequests, ""))
+		httpmock.RegisterResponder("POST", failoverUrl, httpmock.NewStringResponder(200, `{"jsonrpc":"2.0","id":1,"result":"0x10"}`))
+
+		// Act
+		_, sendErr := provider.Send("hoge", types.RequestArgs{})
+		blockNumber, err := alchemy.Core.GetBlockNumber()
+
+		// Assert
+		assert.NoError(t, sendErr)
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(16), blockNumber)
+		info := httpmock.GetCallCountInfo()
+		assert.Equal(t, 1, info["POST "+primaryUrl])
+		assert.Equal(t, 2, info["POST "+failoverUrl])
+	})
+}
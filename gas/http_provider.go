@@ -4,7 +4,6 @@ import (
 	"context"
 	"net/http"
 	"sync/atomic"
-	"time"
 
 	"github.com/poteto-go/go-alchemy-sdk/constant"
 	"github.com/poteto-go/go-alchemy-sdk/internal"
@@ -27,12 +26,12 @@ func NewAlchemyProvider(config AlchemyConfig) types.IAlchemyProvider {
 	}
 	provider.id.Store(1)
 
-	if config.maxRetries > 0 {
+	if config.isRequestBatch {
 		provider.batcher = internal.NewRequestBatcher(
 			context.Background(),
 			internal.BatcherConfig{
-				MaxBatchSize: 100,
-				MaxBatchTime: time.Millisecond * 10,
+				MaxBatchSize: config.requestBatchSize,
+				MaxBatchTime: config.requestBatchWindow,
 				Client:       provider.client,
 				Fetch:        utils.AlchemyBatchFetch,
 			},
@@ -60,17 +59,32 @@ func (provider *AlchemyProvider) Network() types.Network {
 
 /* Send raw transaction */
 func (provider *AlchemyProvider) Send(method string, params types.RequestArgs) (any, error) {
+	return provider.SendContext(context.Background(), method, params)
+}
+
+func (provider *AlchemyProvider) SendContext(ctx context.Context, method string, params types.RequestArgs) (any, error) {
+	handler := types.ChainMiddlewares(provider.sendRequest, provider.config.middlewares...)
+	return handler(ctx, types.RpcRequest{Method: method, Params: params})
+}
+
+func (provider *AlchemyProvider) sendRequest(ctx context.Context, req types.RpcRequest) (any, error) {
 	// fetch-and-add: take the current id for this request, then advance the counter atomically.
 	id := provider.id.Add(1) - 1
-	body, err := utils.CreateRequestBodyToBytes(int(id), method, params)
+	body, err := utils.CreateRequestBodyToBytes(int(id), req.Method, req.Params)
 	if err != nil {
 		return nil, err
 	}
-	return send(provider, body)
+	return send(ctx, provider, body)
 }
 
-func send(provider *AlchemyProvider, body []byte) (any, error) {
-	req, err := generateAlchemyRequest(provider.config)
+func send(ctx context.Context, provider *AlchemyProvider, body []byte) (any, error) {
+	// a batched request shares one http request with its batch, so ctx only
+	// bounds the wait for its own answer there.
+	reqCtx := ctx
+	if provider.batcher != nil {
+		reqCtx = context.Background()
+	}
+	req, err := generateAlchemyRequest(reqCtx, provider.config)
 	if err != nil {
 		return nil, err
 	}
@@ -79,24 +93,25 @@ func send(provider *AlchemyProvider, body []byte) (any, error) {
 		Request: req,
 	}
 
-	// TODO: not support generics in batch request for now.
-	/*
-		if provider.batcher != nil {
-			response, err := provider.batcher.QueueRequest(context.Background(), request)
-			if err != nil {
-				return "", err
-			}
-			return fmt.Sprintf("%v", response.Result), nil
-		}
-	*/
-
-	response, err := internal.RequestHttpWithBackoff(
-		*provider.config.backoffConfig,
-		provider.client,
-		utils.AlchemyFetch,
-		request,
-		body,
-	)
+	var response types.AlchemyResponse
+	if provider.batcher != nil {
+		response, err = internal.RequestBatchWithBackoff(
+			ctx,
+			*provider.config.backoffConfig,
+			provider.batcher,
+			request,
+			body,
+		)
+	} else {
+		response, err = internal.RequestHttpWithBackoff(
+			ctx,
+			*provider.config.backoffConfig,
+			provider.client,
+			utils.AlchemyFetch,
+			request,
+			body,
+		)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -109,8 +124,8 @@ func send(provider *AlchemyProvider, body []byte) (any, error) {
 	return result, nil
 }
 
-func generateAlchemyRequest(config AlchemyConfig) (*http.Request, error) {
-	req, err := http.NewRequest("POST", config.GetUrl(), nil)
+func generateAlchemyRequest(ctx context.Context, config AlchemyConfig) (*http.Request, error) {
+	req, err := http.NewRequestWithContext(ctx, "POST", config.GetUrl(), nil)
 	if err != nil {
 		return &http.Request{}, constant.ErrFailedToCreateRequest
 	}
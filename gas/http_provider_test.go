@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/agiledragon/gomonkey"
 	"github.com/jarcoal/httpmock"
@@ -211,3 +212,275 @@ func TestAlchemyProvider_Send(t *testing.T) {
 		})
 	})
 }
+
+func TestAlchemyProvider_Send_RequestBatch(t *testing.T) {
+	newBatchProvider := func(batchSize int) *AlchemyProvider {
+		config, _ := NewAlchemyConfig(
+			AlchemySetting{
+				ApiKey:             "hoge",
+				Network:            "fuga",
+				IsRequestBatch:     true,
+				RequestBatchSize:   batchSize,
+				RequestBatchWindow: time.Millisecond * 50,
+				BackoffConfig:      &types.BackoffConfig{MaxRetries: 0},
+			},
+		)
+		return NewAlchemyProvider(config).(*AlchemyProvider)
+	}
+
+	t.Run("no batcher unless IsRequestBatch", func(t *testing.T) {
+		assert.Nil(t, newProviderForTest().batcher)
+		assert.NotNil(t, newBatchProvider(10).batcher)
+	})
+
+	t.Run("concurrent Send calls share one array request, each gets its own result", func(t *testing.T) {
+		// Arrange
+		provider := newBatchProvider(4)
+		httpmock.Activate(t)
+		defer httpmock.DeactivateAndReset()
+
+		var (
+			mu         sync.Mutex
+			batchSizes []int
+		)
+		httpmock.RegisterResponder(
+			"POST",
+			provider.config.GetUrl(),
+			func(req *http.Request) (*http.Response, error) {
+				var bodies []types.AlchemyRequestBody
+				if err := json.NewDecoder(req.Body).Decode(&bodies); err != nil {
+					return nil, err
+				}
+				mu.Lock()
+				batchSizes = append(batchSizes, len(bodies))
+				mu.Unlock()
+
+				responses := make([]map[string]any, len(bodies))
+				for i, body := range bodies {
+					response := map[string]any{"jsonrpc": "2.0", "id": body.Id}
+					if body.Method == "fail" {
+						response["error"] = map[string]any{"code": -32000, "message": "nonce too low"}
+					} else {
+						response["result"] = body.Params[0]
+					}
+					responses[i] = response
+				}
+				return httpmock.NewJsonResponse(200, responses)
+			},
+		)
+
+		// Act
+		results := make([]any, 3)
+		var failErr error
+		var wg sync.WaitGroup
+		for i := range results {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				results[i], _ = provider.Send("echo", types.RequestArgs{i})
+			}()
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, failErr = provider.Send("fail", types.RequestArgs{})
+		}()
+		wg.Wait()
+
+		// Assert
+		assert.Equal(t, []int{4}, batchSizes)
+		assert.Equal(t, []any{float64(0), float64(1), float64(2)}, results)
+		rpcErr, ok := errors.AsType[*types.RpcError](failErr)
+		assert.True(t, ok)
+		assert.Equal(t, "fail", rpcErr.Method)
+		assert.Equal(t, -32000, rpcErr.Code)
+		assert.Equal(t, "nonce too low", rpcErr.Message)
+	})
+}
+
+func TestAlchemyProvider_SendContext(t *testing.T) {
+	t.Run("http request carries the caller ctx", func(t *testing.T) {
+		// Arrange
+		provider := newProviderForTest()
+		provider.config.backoffConfig.MaxRetries = 0
+		httpmock.Activate(t)
+		defer httpmock.DeactivateAndReset()
+		type ctxKey struct{}
+		ctx := context.WithValue(context.Background(), ctxKey{}, "caller")
+		var got any
+		httpmock.RegisterResponder(
+			"POST",
+			provider.config.GetUrl(),
+			func(req *http.Request) (*http.Response, error) {
+				got = req.Context().Value(ctxKey{})
+				return httpmock.NewStringResponse(200, `{"jsonrpc":"2.0","id":1,"result":"0x1"}`), nil
+			},
+		)
+
+		// Act
+		result, err := provider.SendContext(ctx, "hoge", types.RequestArgs{})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "0x1", result)
+		assert.Equal(t, "caller", got)
+	})
+
+	t.Run("cancel stops the backoff loop", func(t *testing.T) {
+		// Arrange
+		config, _ := NewAlchemyConfig(
+			AlchemySetting{
+				ApiKey:  "hoge",
+				Network: "fuga",
+				BackoffConfig: &types.BackoffConfig{
+					MaxRetries:     10,
+					InitialDelayMs: 60_000,
+					MaxDelayMs:     60_000,
+				},
+			},
+		)
+		provider := NewAlchemyProvider(config).(*AlchemyProvider)
+		httpmock.Activate(t)
+		defer httpmock.DeactivateAndReset()
+		httpmock.RegisterResponder(
+			"POST",
+			provider.config.GetUrl(),
+			httpmock.NewStringResponder(200, "not json"),
+		)
+		ctx, cancel := context.WithCancel(context.Background())
+		time.AfterFunc(10*time.Millisecond, cancel)
+
+		// Act
+		_, err := provider.SendContext(ctx, "hoge", types.RequestArgs{})
+
+		// Assert
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.ErrorIs(t, err, constant.ErrFailedToUnmarshalResponse)
+		assert.Equal(t, 1, httpmock.GetTotalCallCount())
+	})
+
+	t.Run("batched request: ctx bounds the wait for its answer", func(t *testing.T) {
+		// Arrange
+		config, _ := NewAlchemyConfig(
+			AlchemySetting{
+				ApiKey:             "hoge",
+				Network:            "fuga",
+				IsRequestBatch:     true,
+				RequestBatchSize:   10,
+				RequestBatchWindow: time.Minute,
+				BackoffConfig:      &types.BackoffConfig{MaxRetries: 0},
+			},
+		)
+		provider := NewAlchemyProvider(config)
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		// Act
+		_, err := provider.SendContext(ctx, "hoge", types.RequestArgs{})
+
+		// Assert
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
+
+func TestAlchemyProvider_Middlewares(t *testing.T) {
+	t.Run("middleware sees method, params, result, error and latency", func(t *testing.T) {
+		// Arrange
+		provider := newProviderForTest()
+		provider.config.backoffConfig.MaxRetries = 0
+		httpmock.Activate(t)
+		defer httpmock.DeactivateAndReset()
+		httpmock.RegisterResponder(
+			"POST",
+			provider.config.GetUrl(),
+			func(req *http.Request) (*http.Response, error) {
+				time.Sleep(5 * time.Millisecond)
+				return httpmock.NewStringResponse(200, `{"jsonrpc":"2.0","id":1,"result":"0x1234"}`), nil
+			},
+		)
+		var (
+			gotReq     types.RpcRequest
+			gotRes     any
+			gotErr     error
+			gotLatency time.Duration
+		)
+		provider.config.middlewares = []types.Middleware{
+			func(next types.RpcHandler) types.RpcHandler {
+				return func(ctx context.Context, req types.RpcRequest) (any, error) {
+					start := time.Now()
+					res, err := next(ctx, req)
+					gotReq, gotRes, gotErr, gotLatency = req, res, err, time.Since(start)
+					return res, err
+				}
+			},
+		}
+
+		// Act
+		result, err := provider.Send("eth_getBalance", types.RequestArgs{"0xabc", "latest"})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "0x1234", result)
+		assert.Equal(t, types.RpcRequest{Method: "eth_getBalance", Params: types.RequestArgs{"0xabc", "latest"}}, gotReq)
+		assert.Equal(t, "0x1234", gotRes)
+		assert.NoError(t, gotErr)
+		assert.GreaterOrEqual(t, gotLatency, 5*time.Millisecond)
+	})
+
+	t.Run("middleware can rewrite the request", func(t *testing.T) {
+		// Arrange
+		provider := newProviderForTest()
+		provider.config.backoffConfig.MaxRetries = 0
+		httpmock.Activate(t)
+		defer httpmock.DeactivateAndReset()
+		var body types.AlchemyRequestBody
+		httpmock.RegisterResponder(
+			"POST",
+			provider.config.GetUrl(),
+			func(req *http.Request) (*http.Response, error) {
+				if err := json.NewDecoder(req.Body).Decode(&body); err != nil {
+					return nil, err
+				}
+				return httpmock.NewStringResponse(200, `{"jsonrpc":"2.0","id":1,"result":"0x1"}`), nil
+			},
+		)
+		provider.config.middlewares = []types.Middleware{
+			func(next types.RpcHandler) types.RpcHandler {
+				return func(ctx context.Context, req types.RpcRequest) (any, error) {
+					req.Params = types.RequestArgs{"finalized"}
+					return next(ctx, req)
+				}
+			},
+		}
+
+		// Act
+		_, err := provider.Send("eth_getBlockByNumber", types.RequestArgs{"latest"})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "eth_getBlockByNumber", body.Method)
+		assert.Equal(t, types.RequestArgs{"finalized"}, body.Params)
+	})
+
+	t.Run("middleware can short-circuit the call", func(t *testing.T) {
+		// Arrange
+		provider := newProviderForTest()
+		httpmock.Activate(t)
+		defer httpmock.DeactivateAndReset()
+		denied := errors.New("denied")
+		provider.config.middlewares = []types.Middleware{
+			func(next types.RpcHandler) types.RpcHandler {
+				return func(ctx context.Context, req types.RpcRequest) (any, error) {
+					return nil, denied
+				}
+			},
+		}
+
+		// Act
+		_, err := provider.Send("eth_sendRawTransaction", types.RequestArgs{"0x00"})
+
+		// Assert
+		assert.ErrorIs(t, err, denied)
+		assert.Equal(t, 0, httpmock.GetTotalCallCount())
+	})
+}
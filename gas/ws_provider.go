@@ -9,6 +9,7 @@ import (
 
 	"github.com/poteto-go/go-alchemy-sdk/constant"
 	"github.com/poteto-go/go-alchemy-sdk/types"
+	"github.com/poteto-go/go-alchemy-sdk/utils"
 )
 
 // WsAlchemyProvider routes JSON-RPC requests and eth_subscribe streams over the
@@ -41,20 +42,30 @@ func (provider *WsAlchemyProvider) Network() types.Network {
 }
 
 // Send dispatches a single JSON-RPC call over the ws socket, mirroring the
-// HTTP provider's contract: it returns the raw result (map/slice/string) or
-// ErrResultIsNil when the node answers with a null result.
+// HTTP provider's contract: it returns the raw result (map/slice/string),
+// a *types.RpcError when the node answers with an error, or ErrResultIsNil
+// when it answers with a null result.
 func (provider *WsAlchemyProvider) Send(method string, params types.RequestArgs) (any, error) {
+	return provider.SendContext(context.Background(), method, params)
+}
+
+func (provider *WsAlchemyProvider) SendContext(ctx context.Context, method string, params types.RequestArgs) (any, error) {
+	handler := types.ChainMiddlewares(provider.sendRequest, provider.config.middlewares...)
+	return handler(ctx, types.RpcRequest{Method: method, Params: params})
+}
+
+func (provider *WsAlchemyProvider) sendRequest(ctx context.Context, req types.RpcRequest) (any, error) {
 	client, err := provider.rpcClient()
 	if err != nil {
 		return nil, err
 	}
 
-	ctx, cancel := provider.requestContext()
+	ctx, cancel := provider.requestContext(ctx)
 	defer cancel()
 
 	var result any
-	if err := client.CallContext(ctx, &result, method, params...); err != nil {
-		return nil, err
+	if err := client.CallContext(ctx, &result, req.Method, req.Params...); err != nil {
+		return nil, utils.RpcErrorOf(req.Method, err)
 	}
 	if result == nil {
 		return nil, constant.ErrResultIsNil
@@ -78,9 +89,9 @@ func (provider *WsAlchemyProvider) rpcClient() (*rpc.Client, error) {
 	return client.Client(), nil
 }
 
-func (provider *WsAlchemyProvider) requestContext() (context.Context, context.CancelFunc) {
+func (provider *WsAlchemyProvider) requestContext(ctx context.Context) (context.Context, context.CancelFunc) {
 	if provider.config.requestTimeout > 0 {
-		return context.WithTimeout(context.Background(), provider.config.requestTimeout)
+		return context.WithTimeout(ctx, provider.config.requestTimeout)
 	}
-	return context.WithCancel(context.Background())
+	return context.WithCancel(ctx)
 }
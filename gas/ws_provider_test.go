@@ -2,6 +2,7 @@ package gas
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"net/http/httptest"
 	"strings"
@@ -29,6 +30,16 @@ func (wsTestAPI) BlockNumber() hexutil.Uint64 { return hexutil.Uint64(0x42) }
 // Null answers eth_null with a JSON null result, to exercise the ErrResultIsNil path.
 func (wsTestAPI) Null() *hexutil.Uint64 { return nil }
 
+// Revert answers eth_revert with a JSON-RPC error carrying data, like a
+// reverted eth_call.
+func (wsTestAPI) Revert() (hexutil.Uint64, error) { return 0, wsTestRevertError{} }
+
+type wsTestRevertError struct{}
+
+func (wsTestRevertError) Error() string  { return "execution reverted" }
+func (wsTestRevertError) ErrorCode() int { return 3 }
+func (wsTestRevertError) ErrorData() any { return "0x08c379a0" }
+
 func (wsTestAPI) Ticks(ctx context.Context) (*rpc.Subscription, error) {
 	notifier, supported := rpc.NotifierFromContext(ctx)
 	if !supported {
@@ -65,7 +76,7 @@ func newWsProviderForTest(t *testing.T) *WsAlchemyProvider {
 	eth := ether.NewWsEtherApi(
 		provider,
 		// override the derived alchemy endpoint with the in-process ws url.
-		ether.NewEtherApiConfig(wsUrl, 0, 2*time.Second, &types.DefaultBackoffConfig, []http.Header{}, nil, 5<<20, nil),
+		ether.NewEtherApiConfig(wsUrl, 0, 2*time.Second, &types.DefaultBackoffConfig, []http.Header{}, nil, 5<<20, nil, nil, nil),
 	)
 	provider.SetEth(eth)
 
@@ -118,6 +129,26 @@ func TestWsAlchemyProvider_Send(t *testing.T) {
 		assert.Equal(t, "0x42", result)
 	})
 
+	t.Run("runs the middlewares around the ws call", func(t *testing.T) {
+		provider := newWsProviderForTest(t)
+		var seen []string
+		provider.config.middlewares = []types.Middleware{
+			func(next types.RpcHandler) types.RpcHandler {
+				return func(ctx context.Context, req types.RpcRequest) (any, error) {
+					seen = append(seen, req.Method)
+					req.Method = "eth_blockNumber"
+					return next(ctx, req)
+				}
+			},
+		}
+
+		result, err := provider.Send("eth_null", types.RequestArgs{})
+
+		require.NoError(t, err)
+		assert.Equal(t, "0x42", result)
+		assert.Equal(t, []string{"eth_null"}, seen)
+	})
+
 	t.Run("returns ErrResultIsNil when the node answers null", func(t *testing.T) {
 		provider := newWsProviderForTest(t)
 
@@ -133,6 +164,20 @@ func TestWsAlchemyProvider_Send(t *testing.T) {
 		assert.Error(t, err)
 	})
 
+	t.Run("returns a *types.RpcError when the node answers an error", func(t *testing.T) {
+		provider := newWsProviderForTest(t)
+
+		_, err := provider.Send("eth_revert", types.RequestArgs{})
+
+		rpcErr, ok := errors.AsType[*types.RpcError](err)
+		require.True(t, ok)
+		assert.Equal(t, "eth_revert", rpcErr.Method)
+		assert.Equal(t, 3, rpcErr.Code)
+		assert.Equal(t, "execution reverted", rpcErr.Message)
+		assert.JSONEq(t, `"0x08c379a0"`, string(rpcErr.Data))
+		assert.True(t, rpcErr.IsExecutionReverted())
+	})
+
 	t.Run("returns error if eth client is not set", func(t *testing.T) {
 		config, _ := NewAlchemyConfig(AlchemySetting{ApiKey: "k", Network: "n"})
 		provider := NewWsAlchemyProvider(config).(*WsAlchemyProvider)
@@ -158,7 +203,8 @@ func TestWsAlchemyProvider_Send(t *testing.T) {
 		provider := NewWsAlchemyProvider(config).(*WsAlchemyProvider)
 		// nothing listens on port 1 -> the ws dial inside SetEthClient fails.
 		provider.SetEth(ether.NewWsEtherApi(provider, ether.NewEtherApiConfig(
-			"ws://127.0.0.1:1", 0, 500*time.Millisecond, &types.DefaultBackoffConfig, []http.Header{}, nil, 5<<20, nil,
+			"ws://127.0.0.1:1", 0, 500*time.Millisecond, &types.DefaultBackoffConfig, []http.Header{}, nil, 5<<20, nil, nil,
+			nil,
 		)))
 
 		_, err := provider.Send("eth_blockNumber", types.RequestArgs{})
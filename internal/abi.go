@@ -0,0 +1,429 @@
+package internal
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/poteto-go/go-alchemy-sdk/constant"
+)
+
+var (
+	bigIntType  = reflect.TypeFor[big.Int]()
+	addressType = reflect.TypeFor[common.Address]()
+)
+
+/*
+ParseABIArguments parses a comma separated list of Solidity types, as found
+between the parentheses of a function signature, e.g.
+
+	"address,(uint160,int24)[],bytes32 salt"
+
+A type may be followed by a parameter name, and "indexed" for an event
+parameter. Tuple components left unnamed are
+named field0, field1, ... as go-ethereum requires names; values are matched
+by position anyway.
+*/
+func ParseABIArguments(types string) (abi.Arguments, error) {
+	items, err := splitABITypes(types)
+	if err != nil {
+		return nil, errors.Join(constant.ErrInvalidABIType, err)
+	}
+
+	args := make(abi.Arguments, 0, len(items))
+	for _, item := range items {
+		marshaling, err := parseABIArgument(item)
+		if err != nil {
+			return nil, errors.Join(constant.ErrInvalidABIType, err)
+		}
+		typ, err := abi.NewType(marshaling.Type, "", marshaling.Components)
+		if err != nil {
+			return nil, errors.Join(constant.ErrInvalidABIType, err)
+		}
+		args = append(args, abi.Argument{Name: marshaling.Name, Type: typ, Indexed: marshaling.Indexed})
+	}
+	return args, nil
+}
+
+// splitABITypes splits types on its top-level commas.
+func splitABITypes(types string) ([]string, error) {
+	types = strings.TrimSpace(types)
+	if types == "" {
+		return nil, nil
+	}
+
+	var items []string
+	depth, start := 0, 0
+	for i, c := range types {
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth < 0 {
+				return nil, fmt.Errorf("unbalanced parentheses in %q", types)
+			}
+		case ',':
+			if depth == 0 {
+				items = append(items, strings.TrimSpace(types[start:i]))
+				start = i + 1
+			}
+		}
+	}
+	if depth != 0 {
+		return nil, fmt.Errorf("unbalanced parentheses in %q", types)
+	}
+	items = append(items, strings.TrimSpace(types[start:]))
+
+	for _, item := range items {
+		if item == "" {
+			return nil, fmt.Errorf("empty type in %q", types)
+		}
+	}
+	return items, nil
+}
+
+// parseABIArgument parses one "type [name]" item into the form abi.NewType
+// takes: a tuple becomes "tuple" plus its array suffix, with components.
+func parseABIArgument(item string) (abi.ArgumentMarshaling, error) {
+	if strings.HasPrefix(item, "tuple(") {
+		item = strings.TrimPrefix(item, "tuple")
+	}
+	if !strings.HasPrefix(item, "(") {
+		fields := strings.Fields(item)
+		marshaling := abi.ArgumentMarshaling{Type: fields[0]}
+		marshaling.Name, marshaling.Indexed = parseABIModifiers(fields[1:])
+		return marshaling, nil
+	}
+
+	end := closingParen(item)
+	inner, rest := item[1:end], item[end+1:]
+	suffix := rest[:len(rest)-len(strings.TrimLeft(rest, "[]0123456789"))]
+	marshaling := abi.ArgumentMarshaling{Type: "tuple" + suffix}
+	marshaling.Name, marshaling.Indexed = parseABIModifiers(strings.Fields(rest[len(suffix):]))
+
+	items, err := splitABITypes(inner)
+	if err != nil {
+		return abi.ArgumentMarshaling{}, err
+	}
+	if len(items) == 0 {
+		return abi.ArgumentMarshaling{}, errors.New("empty tuple")
+	}
+	for i, component := range items {
+		componentMarshaling, err := parseABIArgument(component)
+		if err != nil {
+			return abi.ArgumentMarshaling{}, err
+		}
+		if componentMarshaling.Name == "" {
+			componentMarshaling.Name = "field" + strconv.Itoa(i)
+		}
+		marshaling.Components = append(marshaling.Components, componentMarshaling)
+	}
+	return marshaling, nil
+}
+
+// parseABIModifiers reads the words following a type: the "indexed" of an
+// event parameter, a data location such as "memory", and the name last.
+func parseABIModifiers(words []string) (name string, indexed bool) {
+	for _, word := range words {
+		switch word {
+		case "indexed":
+			indexed = true
+		case "memory", "calldata", "storage":
+		default:
+			name = word
+		}
+	}
+	return name, indexed
+}
+
+// closingParen returns the index of the parenthesis closing s[0]; s is
+// already known to be balanced.
+func closingParen(s string) int {
+	depth := 0
+	for i, c := range s {
+		switch c {
+		case '(':
+			depth++
+		case ')':
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return len(s) - 1
+}
+
+/*
+ABITypeOf returns the Solidity type of a Go type:
+
+  - *big.Int / big.Int is uint256, intN / uintN keep their size, int / uint
+    are int256 / uint256
+  - common.Address is address, [N]byte is bytesN, []byte is bytes
+  - slices and arrays are T[] and T[N]
+  - a struct is the tuple of its exported fields, see ABIFieldTypes
+*/
+func ABITypeOf(t reflect.Type) (string, error) {
+	for t.Kind() == reflect.Pointer && t.Elem() != bigIntType {
+		t = t.Elem()
+	}
+
+	switch {
+	case t == bigIntType || t == reflect.PointerTo(bigIntType):
+		return "uint256", nil
+	case t == addressType:
+		return "address", nil
+	}
+
+	switch t.Kind() {
+	case reflect.Bool:
+		return "bool", nil
+	case reflect.String:
+		return "string", nil
+	case reflect.Int:
+		return "int256", nil
+	case reflect.Uint:
+		return "uint256", nil
+	case reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return "int" + strconv.Itoa(t.Bits()), nil
+	case reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return "uint" + strconv.Itoa(t.Bits()), nil
+	case reflect.Slice:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return "bytes", nil
+		}
+		elem, err := ABITypeOf(t.Elem())
+		if err != nil {
+			return "", err
+		}
+		return elem + "[]", nil
+	case reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 && t.Len() >= 1 && t.Len() <= 32 {
+			return "bytes" + strconv.Itoa(t.Len()), nil
+		}
+		elem, err := ABITypeOf(t.Elem())
+		if err != nil {
+			return "", err
+		}
+		return elem + "[" + strconv.Itoa(t.Len()) + "]", nil
+	case reflect.Struct:
+		fields, err := ABIFieldTypes(t)
+		if err != nil {
+			return "", err
+		}
+		return "(" + strings.Join(fields, ",") + ")", nil
+	}
+	return "", errors.Join(constant.ErrInvalidABIType, fmt.Errorf("no ABI type for %s", t))
+}
+
+/*
+ABIFieldTypes returns the Solidity types of the exported fields of struct t,
+in order. A field tagged `abi:"<type>"` has that type, e.g. `abi:"uint160"`
+on a *big.Int; `abi:"-"` skips the field.
+*/
+func ABIFieldTypes(t reflect.Type) ([]string, error) {
+	fields := make([]string, 0, t.NumField())
+	for _, i := range abiFields(t) {
+		field := t.Field(i)
+		if tag := field.Tag.Get("abi"); tag != "" {
+			fields = append(fields, tag)
+			continue
+		}
+		typ, err := ABITypeOf(field.Type)
+		if err != nil {
+			return nil, err
+		}
+		fields = append(fields, typ)
+	}
+	return fields, nil
+}
+
+// ABIFieldValues returns the values of the fields of struct v typed by
+// ABIFieldTypes, in order.
+func ABIFieldValues(v reflect.Value) []any {
+	indexes := abiFields(v.Type())
+	values := make([]any, len(indexes))
+	for i, index := range indexes {
+		values[i] = v.Field(index).Interface()
+	}
+	return values
+}
+
+// abiFields returns the indexes of the fields of struct t taking part in
+// its ABI tuple.
+func abiFields(t reflect.Type) []int {
+	var indexes []int
+	for i := range t.NumField() {
+		field := t.Field(i)
+		if !field.IsExported() || field.Tag.Get("abi") == "-" {
+			continue
+		}
+		indexes = append(indexes, i)
+	}
+	return indexes
+}
+
+/*
+ConvertABIValue sets dst from src, converting between the Go types of the
+same ABI value: integers of any width and *big.Int, arrays and slices
+element-wise, and structs field by field in order. A hex string may stand for
+a common.Address, and a []any for a struct.
+
+It is how values cross between user types and those go-ethereum packs and
+unpacks (see abi.Type.GetType).
+*/
+func ConvertABIValue(dst, src reflect.Value) error {
+	for src.Kind() == reflect.Interface && !src.IsNil() {
+		src = src.Elem()
+	}
+	if !src.IsValid() || (src.Kind() == reflect.Pointer && src.IsNil()) {
+		return abiValueMismatch(dst.Type(), "nil")
+	}
+
+	if src.Type().AssignableTo(dst.Type()) {
+		dst.Set(src)
+		return nil
+	}
+	if dst.Kind() == reflect.Interface && dst.NumMethod() == 0 {
+		dst.Set(src)
+		return nil
+	}
+
+	switch {
+	case isBigIntPointer(dst.Type()) || dst.Type() == bigIntType || isInteger(dst.Kind()):
+		return setInteger(dst, src)
+	case dst.Kind() == reflect.Pointer:
+		if src.Kind() == reflect.Pointer {
+			src = src.Elem()
+		}
+		elem := reflect.New(dst.Type().Elem())
+		if err := ConvertABIValue(elem.Elem(), src); err != nil {
+			return err
+		}
+		dst.Set(elem)
+		return nil
+	case src.Kind() == reflect.Pointer:
+		return ConvertABIValue(dst, src.Elem())
+	case dst.Type() == addressType && src.Kind() == reflect.String:
+		if !common.IsHexAddress(src.String()) {
+			return errors.Join(constant.ErrABIValueMismatch, constant.ErrInvalidAddress)
+		}
+		dst.Set(reflect.ValueOf(common.HexToAddress(src.String())))
+		return nil
+	case dst.Kind() == reflect.Struct:
+		return setStruct(dst, src)
+	case dst.Kind() == reflect.Slice || dst.Kind() == reflect.Array:
+		return setSequence(dst, src)
+	case dst.Kind() == src.Kind() && src.Type().ConvertibleTo(dst.Type()):
+		dst.Set(src.Convert(dst.Type()))
+		return nil
+	}
+	return abiValueMismatch(dst.Type(), src.Type().String())
+}
+
+func setInteger(dst, src reflect.Value) error {
+	var n *big.Int
+	switch {
+	case isBigIntPointer(src.Type()):
+		n = new(big.Int).Set(src.Interface().(*big.Int))
+	case src.Type() == bigIntType:
+		value := src.Interface().(big.Int)
+		n = new(big.Int).Set(&value)
+	case src.CanInt():
+		n = big.NewInt(src.Int())
+	case src.CanUint():
+		n = new(big.Int).SetUint64(src.Uint())
+	default:
+		return abiValueMismatch(dst.Type(), src.Type().String())
+	}
+
+	switch {
+	case isBigIntPointer(dst.Type()):
+		dst.Set(reflect.ValueOf(n))
+	case dst.Type() == bigIntType:
+		dst.Set(reflect.ValueOf(*n))
+	case dst.CanInt():
+		if !n.IsInt64() || dst.OverflowInt(n.Int64()) {
+			return errors.Join(constant.ErrABIValueMismatch, constant.ErrOverFlow)
+		}
+		dst.SetInt(n.Int64())
+	default:
+		if !n.IsUint64() || dst.OverflowUint(n.Uint64()) {
+			return errors.Join(constant.ErrABIValueMismatch, constant.ErrOverFlow)
+		}
+		dst.SetUint(n.Uint64())
+	}
+	return nil
+}
+
+func setStruct(dst, src reflect.Value) error {
+	var elems []reflect.Value
+	switch src.Kind() {
+	case reflect.Struct:
+		for _, i := range abiFields(src.Type()) {
+			elems = append(elems, src.Field(i))
+		}
+	case reflect.Slice, reflect.Array:
+		for i := range src.Len() {
+			elems = append(elems, src.Index(i))
+		}
+	default:
+		return abiValueMismatch(dst.Type(), src.Type().String())
+	}
+
+	fields := abiFields(dst.Type())
+	if len(fields) != len(elems) {
+		return abiValueMismatch(
+			dst.Type(),
+			fmt.Sprintf("%d values for %d fields", len(elems), len(fields)),
+		)
+	}
+	for i, field := range fields {
+		if err := ConvertABIValue(dst.Field(field), elems[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func setSequence(dst, src reflect.Value) error {
+	if src.Kind() != reflect.Slice && src.Kind() != reflect.Array {
+		return abiValueMismatch(dst.Type(), src.Type().String())
+	}
+
+	n := src.Len()
+	if dst.Kind() == reflect.Array {
+		if dst.Len() != n {
+			return abiValueMismatch(dst.Type(), fmt.Sprintf("%d elements", n))
+		}
+	} else {
+		dst.Set(reflect.MakeSlice(dst.Type(), n, n))
+	}
+	for i := range n {
+		if err := ConvertABIValue(dst.Index(i), src.Index(i)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func isBigIntPointer(t reflect.Type) bool {
+	return t.Kind() == reflect.Pointer && t.Elem() == bigIntType
+}
+
+func isInteger(kind reflect.Kind) bool {
+	return reflect.Int <= kind && kind <= reflect.Uint64
+}
+
+func abiValueMismatch(t reflect.Type, got string) error {
+	return errors.Join(
+		constant.ErrABIValueMismatch,
+		fmt.Errorf("cannot use %s as %s", got, t),
+	)
+}
@@ -0,0 +1,182 @@
+package internal_test
+
+import (
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/poteto-go/go-alchemy-sdk/constant"
+	"github.com/poteto-go/go-alchemy-sdk/internal"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseABIArguments(t *testing.T) {
+	t.Run("elementary, tuple and array types with names", func(t *testing.T) {
+		// Act
+		args, err := internal.ParseABIArguments(
+			"address owner, (uint160,int24 tick)[] memory ticks, tuple(bytes32,string)[2], uint8",
+		)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Len(t, args, 4)
+		assert.Equal(t, "owner", args[0].Name)
+		assert.Equal(t, "address", args[0].Type.String())
+		assert.Equal(t, "ticks", args[1].Name)
+		assert.Equal(t, "(uint160,int24)[]", args[1].Type.String())
+		assert.Equal(t, []string{"field0", "tick"}, args[1].Type.Elem.TupleRawNames)
+		assert.Equal(t, "(bytes32,string)[2]", args[2].Type.String())
+		assert.Equal(t, "", args[3].Name)
+	})
+
+	t.Run("indexed event parameters", func(t *testing.T) {
+		// Act
+		args, err := internal.ParseABIArguments("address indexed from, uint256 value")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.True(t, args[0].Indexed)
+		assert.Equal(t, "from", args[0].Name)
+		assert.False(t, args[1].Indexed)
+	})
+
+	t.Run("empty list", func(t *testing.T) {
+		// Act
+		args, err := internal.ParseABIArguments(" ")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Empty(t, args)
+	})
+
+	t.Run("invalid types", func(t *testing.T) {
+		for _, types := range []string{"uint256,", "(uint256", "uint256)", "()", "foo", "uint256[x"} {
+			// Act
+			_, err := internal.ParseABIArguments(types)
+
+			// Assert
+			assert.ErrorIs(t, err, constant.ErrInvalidABIType, types)
+		}
+	})
+}
+
+func TestABITypeOf(t *testing.T) {
+	type inner struct {
+		Amount *big.Int `abi:"uint128"`
+		Memo   string
+	}
+	type outer struct {
+		Owner   common.Address
+		Inners  []inner
+		Skipped bool `abi:"-"`
+		private int
+	}
+
+	tests := []struct {
+		name     string
+		typ      reflect.Type
+		expected string
+	}{
+		{"*big.Int", reflect.TypeFor[*big.Int](), "uint256"},
+		{"big.Int", reflect.TypeFor[big.Int](), "uint256"},
+		{"int", reflect.TypeFor[int](), "int256"},
+		{"int32", reflect.TypeFor[int32](), "int32"},
+		{"uint16", reflect.TypeFor[uint16](), "uint16"},
+		{"bool", reflect.TypeFor[bool](), "bool"},
+		{"string", reflect.TypeFor[string](), "string"},
+		{"address", reflect.TypeFor[common.Address](), "address"},
+		{"hash", reflect.TypeFor[common.Hash](), "bytes32"},
+		{"[4]byte", reflect.TypeFor[[4]byte](), "bytes4"},
+		{"[]byte", reflect.TypeFor[[]byte](), "bytes"},
+		{"nested arrays", reflect.TypeFor[[][2]*big.Int](), "uint256[2][]"},
+		{"struct", reflect.TypeFor[*outer](), "(address,(uint128,string)[])"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			typ, err := internal.ABITypeOf(tt.typ)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, typ)
+		})
+	}
+
+	t.Run("no ABI type", func(t *testing.T) {
+		// Act
+		_, err := internal.ABITypeOf(reflect.TypeFor[map[string]int]())
+
+		// Assert
+		assert.ErrorIs(t, err, constant.ErrInvalidABIType)
+	})
+}
+
+func TestConvertABIValue(t *testing.T) {
+	t.Run("integers of any width and *big.Int", func(t *testing.T) {
+		// Arrange
+		var small int32
+		var n *big.Int
+
+		// Act
+		err1 := internal.ConvertABIValue(reflect.ValueOf(&small).Elem(), reflect.ValueOf(big.NewInt(-100)))
+		err2 := internal.ConvertABIValue(reflect.ValueOf(&n).Elem(), reflect.ValueOf(uint8(7)))
+
+		// Assert
+		assert.NoError(t, err1)
+		assert.Equal(t, int32(-100), small)
+		assert.NoError(t, err2)
+		assert.Equal(t, big.NewInt(7), n)
+	})
+
+	t.Run("overflow", func(t *testing.T) {
+		// Arrange
+		var u uint8
+
+		// Act
+		err1 := internal.ConvertABIValue(reflect.ValueOf(&u).Elem(), reflect.ValueOf(256))
+		err2 := internal.ConvertABIValue(reflect.ValueOf(&u).Elem(), reflect.ValueOf(big.NewInt(-1)))
+
+		// Assert
+		assert.ErrorIs(t, err1, constant.ErrOverFlow)
+		assert.ErrorIs(t, err2, constant.ErrABIValueMismatch)
+	})
+
+	t.Run("[]any into a struct, hex string into an address", func(t *testing.T) {
+		// Arrange
+		type pair struct {
+			Token  common.Address
+			Amount *big.Int
+			Ids    []uint64
+		}
+		var p pair
+
+		// Act
+		err := internal.ConvertABIValue(
+			reflect.ValueOf(&p).Elem(),
+			reflect.ValueOf([]any{"0x00000000000000000000000000000000000000aa", 5, []int{1, 2}}),
+		)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, common.HexToAddress("0xaa"), p.Token)
+		assert.Equal(t, big.NewInt(5), p.Amount)
+		assert.Equal(t, []uint64{1, 2}, p.Ids)
+	})
+
+	t.Run("mismatches", func(t *testing.T) {
+		// Arrange
+		var address common.Address
+		var fixed [2]int
+		var p struct{ A, B int }
+		var n *big.Int
+
+		// Act & Assert
+		assert.ErrorIs(t, internal.ConvertABIValue(reflect.ValueOf(&address).Elem(), reflect.ValueOf("0xzz")), constant.ErrInvalidAddress)
+		assert.ErrorIs(t, internal.ConvertABIValue(reflect.ValueOf(&fixed).Elem(), reflect.ValueOf([]int{1})), constant.ErrABIValueMismatch)
+		assert.ErrorIs(t, internal.ConvertABIValue(reflect.ValueOf(&p).Elem(), reflect.ValueOf([]any{1})), constant.ErrABIValueMismatch)
+		assert.ErrorIs(t, internal.ConvertABIValue(reflect.ValueOf(&n).Elem(), reflect.ValueOf((*big.Int)(nil))), constant.ErrABIValueMismatch)
+		assert.ErrorIs(t, internal.ConvertABIValue(reflect.ValueOf(&n).Elem(), reflect.ValueOf("1")), constant.ErrABIValueMismatch)
+	})
+}
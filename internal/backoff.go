@@ -1,6 +1,7 @@
 package internal
 
 import (
+	"context"
 	"math"
 	"sync"
 	"time"
@@ -26,6 +27,21 @@ func NewBackoffManager(config types.BackoffConfig) *BackoffManager {
 }
 
 func (b *BackoffManager) Backoff() error {
+	return b.BackoffContext(context.Background())
+}
+
+// BackoffContext is Backoff whose sleep stops early, returning ctx.Err(),
+// once ctx is done.
+func (b *BackoffManager) BackoffContext(ctx context.Context) error {
+	return b.BackoffAfter(ctx, 0)
+}
+
+// BackoffAfter is BackoffContext that waits retryAfter instead of the
+// scheduled delay when the server asked for one (retryAfter > 0), even past
+// MaxDelayMs: retrying earlier would only be throttled again. The schedule
+// then continues from it. A retryAfter past MaxRetryAfterMs (MaxDelayMs if 0)
+// is not waited for: it returns constant.ErrRetryAfterTooLong at once.
+func (b *BackoffManager) BackoffAfter(ctx context.Context, retryAfter time.Duration) error {
 	b.lock.Lock()
 	defer b.lock.Unlock()
 
@@ -33,17 +49,34 @@ func (b *BackoffManager) Backoff() error {
 		return constant.ErrOverMaxRetries
 	}
 
+	maxRetryAfterMs := b.config.MaxRetryAfterMs
+	if maxRetryAfterMs <= 0 {
+		maxRetryAfterMs = b.config.MaxDelayMs
+	}
+	if float64(retryAfter)/float64(time.Millisecond) > maxRetryAfterMs {
+		return constant.ErrRetryAfterTooLong
+	}
+
 	currentDelay := b.calculateBackOffDelay()
 
 	currentDelay = math.Max(currentDelay, b.config.InitialDelayMs)
 	currentDelay = math.Min(currentDelay, b.config.MaxDelayMs)
+	if retryAfter > 0 {
+		currentDelay = float64(retryAfter) / float64(time.Millisecond)
+	}
 
 	b.retries++
 	b.lastDelay = currentDelay
 
-	time.Sleep(time.Duration(currentDelay) * time.Millisecond)
+	timer := time.NewTimer(time.Duration(currentDelay * float64(time.Millisecond)))
+	defer timer.Stop()
 
-	return nil
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
 }
 
 func (b *BackoffManager) calculateBackOffDelay() float64 {
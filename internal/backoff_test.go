@@ -1,7 +1,9 @@
 package internal
 
 import (
+	"context"
 	"testing"
+	"time"
 
 	"github.com/agiledragon/gomonkey"
 	"github.com/poteto-go/go-alchemy-sdk/constant"
@@ -78,6 +80,42 @@ func TestBackoffManager_Backoff(t *testing.T) {
 	})
 }
 
+func TestBackoffManager_BackoffContext(t *testing.T) {
+	config := types.BackoffConfig{
+		MaxRetries:     1,
+		InitialDelayMs: 60_000,
+		MaxDelayMs:     60_000,
+	}
+
+	t.Run("cancel stops the sleep -> ctx.Err()", func(t *testing.T) {
+		// Arrange
+		manager := NewBackoffManager(config)
+		ctx, cancel := context.WithCancel(context.Background())
+		time.AfterFunc(10*time.Millisecond, cancel)
+
+		// Act
+		start := time.Now()
+		err := manager.BackoffContext(ctx)
+
+		// Assert
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Less(t, time.Since(start), time.Second)
+	})
+
+	t.Run("deadline stops the sleep -> ctx.Err()", func(t *testing.T) {
+		// Arrange
+		manager := NewBackoffManager(config)
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		// Act
+		err := manager.BackoffContext(ctx)
+
+		// Assert
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}
+
 func TestBackoffManager_calculateExponentialBackOffDelay(t *testing.T) {
 	t.Run("first retry returns 0", func(t *testing.T) {
 		// Arrange
@@ -171,3 +209,76 @@ func TestBackoffManager_calculateExponentialBackOffDelay(t *testing.T) {
 		assert.Equal(t, float64(30), delay)
 	})
 }
+
+func TestBackoffManager_BackoffAfter(t *testing.T) {
+	t.Run("waits retryAfter up to MaxRetryAfterMs, even past MaxDelayMs", func(t *testing.T) {
+		// Arrange
+		manager := NewBackoffManager(types.BackoffConfig{
+			Mode:            "exponential",
+			MaxRetries:      2,
+			InitialDelayMs:  1,
+			MaxDelayMs:      1,
+			MaxRetryAfterMs: 50,
+		})
+
+		// Act
+		start := time.Now()
+		err := manager.BackoffAfter(context.Background(), 50*time.Millisecond)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.GreaterOrEqual(t, time.Since(start), 50*time.Millisecond)
+		assert.Equal(t, float64(50), manager.lastDelay)
+		assert.Equal(t, 1, manager.retries)
+	})
+
+	t.Run("zero retryAfter keeps the schedule", func(t *testing.T) {
+		// Arrange
+		manager := NewBackoffManager(types.BackoffConfig{
+			MaxRetries:     1,
+			InitialDelayMs: 1,
+			MaxDelayMs:     1,
+		})
+
+		// Act
+		err := manager.BackoffAfter(context.Background(), 0)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, float64(1), manager.lastDelay)
+	})
+
+	t.Run("retryAfter past the cap -> ErrRetryAfterTooLong at once", func(t *testing.T) {
+		for _, tt := range []struct {
+			name   string
+			config types.BackoffConfig
+		}{
+			{"MaxRetryAfterMs", types.BackoffConfig{MaxRetries: 1, MaxDelayMs: 1000, MaxRetryAfterMs: 10}},
+			{"MaxDelayMs if MaxRetryAfterMs is 0", types.BackoffConfig{MaxRetries: 1, MaxDelayMs: 10}},
+		} {
+			t.Run(tt.name, func(t *testing.T) {
+				// Arrange
+				manager := NewBackoffManager(tt.config)
+
+				// Act
+				start := time.Now()
+				err := manager.BackoffAfter(context.Background(), time.Hour)
+
+				// Assert
+				assert.ErrorIs(t, err, constant.ErrRetryAfterTooLong)
+				assert.Less(t, time.Since(start), time.Second)
+			})
+		}
+	})
+
+	t.Run("over max retries -> ErrOverMaxRetries", func(t *testing.T) {
+		// Arrange
+		manager := NewBackoffManager(types.BackoffConfig{})
+
+		// Act
+		err := manager.BackoffAfter(context.Background(), time.Hour)
+
+		// Assert
+		assert.ErrorIs(t, err, constant.ErrOverMaxRetries)
+	})
+}
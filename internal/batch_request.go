@@ -3,10 +3,11 @@ package internal
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"net/http"
-	"sync"
 	"time"
 
+	"github.com/poteto-go/go-alchemy-sdk/constant"
 	"github.com/poteto-go/go-alchemy-sdk/types"
 )
 
@@ -19,23 +20,31 @@ type QueuedRequest struct {
 type RequestBatcher struct {
 	config       BatcherConfig
 	requestQueue chan QueuedRequest
-	mutex        sync.Mutex
+	// flushes bounds the batches in flight; queueing goes on meanwhile
+	flushes chan struct{}
 }
 
 type BatcherConfig struct {
 	MaxBatchSize int
 	MaxBatchTime time.Duration
-	Client       *http.Client
-	Fetch        types.BatchAlchemyFetchHandler
+	// MaxConcurrentFlushes is how many batches are sent at once
+	// (constant.DefaultBatchFlushConcurrency if 0).
+	MaxConcurrentFlushes int
+	Client               *http.Client
+	Fetch                types.BatchAlchemyFetchHandler
 }
 
 func NewRequestBatcher(
 	ctx context.Context,
 	config BatcherConfig,
 ) *RequestBatcher {
+	if config.MaxConcurrentFlushes <= 0 {
+		config.MaxConcurrentFlushes = constant.DefaultBatchFlushConcurrency
+	}
 	batcher := &RequestBatcher{
 		config:       config,
 		requestQueue: make(chan QueuedRequest, config.MaxBatchSize),
+		flushes:      make(chan struct{}, config.MaxConcurrentFlushes),
 	}
 	go batcher.processQueue(ctx)
 	return batcher
@@ -76,13 +85,13 @@ func (b *RequestBatcher) processQueue(ctx context.Context) {
 		case req := <-b.requestQueue:
 			batch = append(batch, req)
 			if len(batch) >= b.config.MaxBatchSize {
-				b.flush(batch)
+				b.flushAsync(batch)
 				batch = make([]QueuedRequest, 0, b.config.MaxBatchSize)
 				timer.Reset(b.config.MaxBatchTime)
 			}
 		case <-timer.C:
 			if len(batch) > 0 {
-				b.flush(batch)
+				b.flushAsync(batch)
 				batch = make([]QueuedRequest, 0, b.config.MaxBatchSize)
 			}
 			timer.Reset(b.config.MaxBatchTime)
@@ -90,10 +99,18 @@ func (b *RequestBatcher) processQueue(ctx context.Context) {
 	}
 }
 
-func (b *RequestBatcher) flush(batch []QueuedRequest) {
-	b.mutex.Lock()
-	defer b.mutex.Unlock()
+// flushAsync sends batch in its own goroutine, once fewer than
+// MaxConcurrentFlushes batches are in flight, so a slow batch does not hold
+// the next ones back.
+func (b *RequestBatcher) flushAsync(batch []QueuedRequest) {
+	b.flushes <- struct{}{}
+	go func() {
+		defer func() { <-b.flushes }()
+		b.flush(batch)
+	}()
+}
 
+func (b *RequestBatcher) flush(batch []QueuedRequest) {
 	requests := make([]types.AlchemyRequest, len(batch))
 	bodies := make([][]byte, len(batch))
 	for i, req := range batch {
@@ -123,6 +140,9 @@ func (b *RequestBatcher) flush(batch []QueuedRequest) {
 		}
 
 		if res, ok := responseMap[requestBody.Id]; ok {
+			if rpcErr, ok := errors.AsType[*types.RpcError](res.Error); ok {
+				rpcErr.Method = requestBody.Method
+			}
 			req.Response <- res
 		} else {
 			req.Response <- types.AlchemyResponse{Error: types.ErrNoResultFound}
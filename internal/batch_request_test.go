@@ -2,6 +2,7 @@ package internal
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"net/http"
 	"net/http/httptest"
@@ -175,3 +176,71 @@ func TestRequestBatcher_ProcessQueue_Timeout(t *testing.T) {
 
 	time.Sleep(time.Millisecond * 20)
 }
+
+func TestRequestBatcher_Flush_RpcError_HasMethod(t *testing.T) {
+	config := BatcherConfig{
+		MaxBatchSize: 1,
+		MaxBatchTime: time.Millisecond * 100,
+		Fetch: func(_ *http.Client, reqs []types.AlchemyRequest, bodies [][]byte) ([]types.AlchemyResponse, error) {
+			return []types.AlchemyResponse{
+				{Id: 1, Error: &types.RpcError{Code: -32000, Message: "nonce too low"}},
+			}, nil
+		},
+	}
+
+	batcher := NewRequestBatcher(context.Background(), config)
+
+	req, _ := http.NewRequest("POST", "", nil)
+	request := types.AlchemyRequest{
+		Request: req,
+	}
+	body, _ := utils.CreateRequestBodyToBytes(1, "eth_sendRawTransaction", types.RequestArgs{})
+
+	_, err := batcher.QueueRequest(context.Background(), request, body)
+
+	rpcErr, ok := errors.AsType[*types.RpcError](err)
+	assert.True(t, ok)
+	assert.Equal(t, "eth_sendRawTransaction", rpcErr.Method)
+	assert.Equal(t, -32000, rpcErr.Code)
+}
+
+func TestRequestBatcher_Flush_Concurrent(t *testing.T) {
+	// Arrange
+	started := make(chan struct{}, 2)
+	release := make(chan struct{})
+	config := BatcherConfig{
+		MaxBatchSize: 1,
+		MaxBatchTime: time.Millisecond * 100,
+		Fetch: func(_ *http.Client, reqs []types.AlchemyRequest, bodies [][]byte) ([]types.AlchemyResponse, error) {
+			started <- struct{}{}
+			<-release
+			var body types.AlchemyRequestBody
+			_ = json.Unmarshal(bodies[0], &body)
+			return []types.AlchemyResponse{{Id: body.Id, Result: "0x1"}}, nil
+		},
+	}
+	batcher := NewRequestBatcher(context.Background(), config)
+
+	// Act
+	var wg sync.WaitGroup
+	for id := 1; id <= 2; id++ {
+		wg.Go(func() {
+			req, _ := http.NewRequest("POST", "", nil)
+			body, _ := utils.CreateRequestBodyToBytes(id, "method", types.RequestArgs{})
+			res, err := batcher.QueueRequest(context.Background(), types.AlchemyRequest{Request: req}, body)
+			assert.NoError(t, err)
+			assert.Equal(t, "0x1", res.Result)
+		})
+	}
+
+	// Assert
+	for range 2 {
+		select {
+		case <-started:
+		case <-time.After(time.Second):
+			t.Fatal("the second batch waited for the first one")
+		}
+	}
+	close(release)
+	wg.Wait()
+}
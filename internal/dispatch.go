@@ -2,13 +2,34 @@ package internal
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"time"
 
 	"github.com/poteto-go/go-alchemy-sdk/types"
+	"github.com/poteto-go/go-alchemy-sdk/utils"
 )
 
+// backoffOrStop waits before the next attempt, as long as a throttled
+// lastErr asks for. It returns the error to give up with: lastErr once
+// retries are exhausted, or ctx.Err() joined with lastErr once ctx is done.
+func backoffOrStop(ctx context.Context, backoffManager *BackoffManager, lastErr error) error {
+	var retryAfter time.Duration
+	if rateLimitErr, ok := errors.AsType[*types.RateLimitError](lastErr); ok {
+		retryAfter = rateLimitErr.RetryAfter
+	}
+	if err := backoffManager.BackoffAfter(ctx, retryAfter); err != nil {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return errors.Join(ctxErr, lastErr)
+		}
+		return lastErr
+	}
+	observeRetry(ctx, lastErr)
+	return nil
+}
+
 func requestWithBackoffError(
+	ctx context.Context,
 	backoffConfig types.BackoffConfig,
 	operation func() error,
 ) error {
@@ -20,18 +41,20 @@ func requestWithBackoffError(
 			return nil
 		}
 
+		err = rateLimited(ctx, err)
 		if isAlwaysReProduceError(err) {
 			return err
 		}
 
 		lastHttpError = err
-		if err := backoffManager.Backoff(); err != nil {
-			return lastHttpError
+		if err := backoffOrStop(ctx, backoffManager, lastHttpError); err != nil {
+			return err
 		}
 	}
 }
 
 func requestWithBackoff[T any](
+	ctx context.Context,
 	backoffConfig types.BackoffConfig,
 	operation func() (T, error),
 ) (T, error) {
@@ -43,19 +66,21 @@ func requestWithBackoff[T any](
 			return result, nil
 		}
 
+		err = rateLimited(ctx, err)
 		if isAlwaysReProduceError(err) {
 			return result, err
 		}
 
 		lastHttpError = err
-		if err := backoffManager.Backoff(); err != nil {
+		if err := backoffOrStop(ctx, backoffManager, lastHttpError); err != nil {
 			var zero T
-			return zero, lastHttpError
+			return zero, err
 		}
 	}
 }
 
 func requestWithBackoffTuple[T any, O any](
+	ctx context.Context,
 	backoffConfig types.BackoffConfig,
 	operation func() (T, O, error),
 ) (T, O, error) {
@@ -67,20 +92,22 @@ func requestWithBackoffTuple[T any, O any](
 			return result, other, nil
 		}
 
+		err = rateLimited(ctx, err)
 		if isAlwaysReProduceError(err) {
 			return result, other, err
 		}
 
 		lastHttpError = err
-		if err := backoffManager.Backoff(); err != nil {
+		if err := backoffOrStop(ctx, backoffManager, lastHttpError); err != nil {
 			var zeroT T
 			var zeroO O
-			return zeroT, zeroO, lastHttpError
+			return zeroT, zeroO, err
 		}
 	}
 }
 
 func RequestHttpWithBackoff(
+	ctx context.Context,
 	backoffConfig types.BackoffConfig,
 	client *http.Client,
 	handler types.AlchemyFetchHandler,
@@ -90,10 +117,26 @@ func RequestHttpWithBackoff(
 	operation := func() (types.AlchemyResponse, error) {
 		return handler(client, request, body)
 	}
-	return requestWithBackoff(backoffConfig, operation)
+	return requestWithBackoff(ctx, backoffConfig, operation)
+}
+
+// RequestBatchWithBackoff queues the request on the auto-batcher, retrying the
+// single request (not the whole batch) on retryable errors.
+func RequestBatchWithBackoff(
+	ctx context.Context,
+	backoffConfig types.BackoffConfig,
+	batcher *RequestBatcher,
+	request types.AlchemyRequest,
+	body []byte,
+) (types.AlchemyResponse, error) {
+	operation := func() (types.AlchemyResponse, error) {
+		return batcher.QueueRequest(ctx, request, body)
+	}
+	return requestWithBackoff(ctx, backoffConfig, operation)
 }
 
 func GethRequestArgWithBackOff[T any, A any](
+	ctx context.Context,
 	backoffConfig *types.BackoffConfig,
 	timeout time.Duration,
 	handler func(
@@ -101,19 +144,21 @@ func GethRequestArgWithBackOff[T any, A any](
 	) (T, error),
 	arg A,
 ) (T, error) {
+	ctx = utils.WithRateLimitSink(ctx)
 	if backoffConfig == nil {
 		backoffConfig = &types.DefaultBackoffConfig
 	}
 
 	operation := func() (T, error) {
-		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		ctx, cancel := context.WithTimeout(ctx, timeout)
 		defer cancel()
 		return handler(ctx, arg)
 	}
-	return requestWithBackoff(*backoffConfig, operation)
+	return requestWithBackoff(ctx, *backoffConfig, operation)
 }
 
 func GethRequestTwoArgWithBackOff[T any, A any, B any](
+	ctx context.Context,
 	backoffConfig *types.BackoffConfig,
 	timeout time.Duration,
 	handler func(
@@ -122,18 +167,20 @@ func GethRequestTwoArgWithBackOff[T any, A any, B any](
 	arg1 A,
 	arg2 B,
 ) (T, error) {
+	ctx = utils.WithRateLimitSink(ctx)
 	if backoffConfig == nil {
 		backoffConfig = &types.DefaultBackoffConfig
 	}
 	operation := func() (T, error) {
-		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		ctx, cancel := context.WithTimeout(ctx, timeout)
 		defer cancel()
 		return handler(ctx, arg1, arg2)
 	}
-	return requestWithBackoff(*backoffConfig, operation)
+	return requestWithBackoff(ctx, *backoffConfig, operation)
 }
 
 func GethRequestThreeArgWithBackOff[T any, A any, B any, C any](
+	ctx context.Context,
 	backoffConfig *types.BackoffConfig,
 	timeout time.Duration,
 	handler func(
@@ -143,18 +190,20 @@ func GethRequestThreeArgWithBackOff[T any, A any, B any, C any](
 	arg2 B,
 	arg3 C,
 ) (T, error) {
+	ctx = utils.WithRateLimitSink(ctx)
 	if backoffConfig == nil {
 		backoffConfig = &types.DefaultBackoffConfig
 	}
 	operation := func() (T, error) {
-		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		ctx, cancel := context.WithTimeout(ctx, timeout)
 		defer cancel()
 		return handler(ctx, arg1, arg2, arg3)
 	}
-	return requestWithBackoff(*backoffConfig, operation)
+	return requestWithBackoff(ctx, *backoffConfig, operation)
 }
 
 func GethRequestArgWithBackOffTuple[T any, A any, O any](
+	ctx context.Context,
 	backoffConfig *types.BackoffConfig,
 	timeout time.Duration,
 	handler func(
@@ -162,36 +211,40 @@ func GethRequestArgWithBackOffTuple[T any, A any, O any](
 	) (T, O, error),
 	arg A,
 ) (T, O, error) {
+	ctx = utils.WithRateLimitSink(ctx)
 	if backoffConfig == nil {
 		backoffConfig = &types.DefaultBackoffConfig
 	}
 	operation := func() (T, O, error) {
-		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		ctx, cancel := context.WithTimeout(ctx, timeout)
 		defer cancel()
 		return handler(ctx, arg)
 	}
-	return requestWithBackoffTuple(*backoffConfig, operation)
+	return requestWithBackoffTuple(ctx, *backoffConfig, operation)
 }
 
 func GethRequestWithBackOff[T any](
+	ctx context.Context,
 	backoffConfig *types.BackoffConfig,
 	timeout time.Duration,
 	handler func(
 		context.Context,
 	) (T, error),
 ) (T, error) {
+	ctx = utils.WithRateLimitSink(ctx)
 	if backoffConfig == nil {
 		backoffConfig = &types.DefaultBackoffConfig
 	}
 	operation := func() (T, error) {
-		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		ctx, cancel := context.WithTimeout(ctx, timeout)
 		defer cancel()
 		return handler(ctx)
 	}
-	return requestWithBackoff(*backoffConfig, operation)
+	return requestWithBackoff(ctx, *backoffConfig, operation)
 }
 
 func GethRequestSingleErrorWithBackOff[A any](
+	ctx context.Context,
 	backoffConfig *types.BackoffConfig,
 	timeout time.Duration,
 	handler func(
@@ -199,13 +252,14 @@ func GethRequestSingleErrorWithBackOff[A any](
 	) error,
 	arg A,
 ) error {
+	ctx = utils.WithRateLimitSink(ctx)
 	if backoffConfig == nil {
 		backoffConfig = &types.DefaultBackoffConfig
 	}
 	operation := func() error {
-		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		ctx, cancel := context.WithTimeout(ctx, timeout)
 		defer cancel()
 		return handler(ctx, arg)
 	}
-	return requestWithBackoffError(*backoffConfig, operation)
+	return requestWithBackoffError(ctx, *backoffConfig, operation)
 }
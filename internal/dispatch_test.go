@@ -6,6 +6,7 @@ import (
 	"net/http"
 	"net/url"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -20,7 +21,7 @@ func TestRequestWithBackoff(t *testing.T) {
 		}
 
 		// Act
-		result, err := requestWithBackoff(types.DefaultBackoffConfig, operation)
+		result, err := requestWithBackoff(context.Background(), types.DefaultBackoffConfig, operation)
 
 		// Assert
 		assert.NoError(t, err)
@@ -42,7 +43,7 @@ func TestRequestWithBackoff(t *testing.T) {
 		}
 
 		// Act
-		result, err := requestWithBackoff(config, operation)
+		result, err := requestWithBackoff(context.Background(), config, operation)
 
 		// Assert
 		assert.NoError(t, err)
@@ -65,7 +66,7 @@ func TestRequestWithBackoff(t *testing.T) {
 		}
 
 		// Act
-		_, err := requestWithBackoff(config, operation)
+		_, err := requestWithBackoff(context.Background(), config, operation)
 
 		// Assert
 		assert.Error(t, err)
@@ -81,7 +82,7 @@ func TestRequestWithBackoff(t *testing.T) {
 		}
 
 		// Act
-		_, err := requestWithBackoff(config, operation)
+		_, err := requestWithBackoff(context.Background(), config, operation)
 
 		// Assert
 		assert.Error(t, err)
@@ -96,7 +97,7 @@ func TestRequestWithBackoffTuple(t *testing.T) {
 		}
 
 		// Act
-		result1, result2, err := requestWithBackoffTuple(types.DefaultBackoffConfig, operation)
+		result1, result2, err := requestWithBackoffTuple(context.Background(), types.DefaultBackoffConfig, operation)
 
 		// Assert
 		assert.NoError(t, err)
@@ -119,7 +120,7 @@ func TestRequestWithBackoffTuple(t *testing.T) {
 		}
 
 		// Act
-		result1, result2, err := requestWithBackoffTuple(config, operation)
+		result1, result2, err := requestWithBackoffTuple(context.Background(), config, operation)
 
 		// Assert
 		assert.NoError(t, err)
@@ -143,7 +144,7 @@ func TestRequestWithBackoffTuple(t *testing.T) {
 		}
 
 		// Act
-		_, _, err := requestWithBackoffTuple(config, operation)
+		_, _, err := requestWithBackoffTuple(context.Background(), config, operation)
 
 		// Assert
 		assert.Error(t, err)
@@ -159,13 +160,82 @@ func TestRequestWithBackoffTuple(t *testing.T) {
 		}
 
 		// Act
-		_, _, err := requestWithBackoffTuple(config, operation)
+		_, _, err := requestWithBackoffTuple(context.Background(), config, operation)
 
 		// Assert
 		assert.Error(t, err)
 	})
 }
 
+func TestRequestWithBackoff_Context(t *testing.T) {
+	config := types.BackoffConfig{
+		MaxRetries:     10,
+		InitialDelayMs: 60_000,
+		MaxDelayMs:     60_000,
+	}
+
+	t.Run("cancel during backoff -> ctx.Err() joined with last error", func(t *testing.T) {
+		// Arrange
+		ctx, cancel := context.WithCancel(context.Background())
+		lastErr := errors.New("test error")
+		callCount := 0
+		operation := func() (int, error) {
+			callCount++
+			return 0, lastErr
+		}
+		time.AfterFunc(10*time.Millisecond, cancel)
+
+		// Act
+		_, err := requestWithBackoff(ctx, config, operation)
+
+		// Assert
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.ErrorIs(t, err, lastErr)
+		assert.Equal(t, 1, callCount)
+	})
+
+	t.Run("already done ctx -> no retry", func(t *testing.T) {
+		// Arrange
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		callCount := 0
+		operation := func() error {
+			callCount++
+			return errors.New("test error")
+		}
+
+		// Act
+		err := requestWithBackoffError(ctx, config, operation)
+
+		// Assert
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Equal(t, 1, callCount)
+	})
+}
+
+func TestGethRequestWithBackOff_Context(t *testing.T) {
+	t.Run("handler ctx is derived from the caller ctx", func(t *testing.T) {
+		// Arrange
+		type ctxKey struct{}
+		ctx := context.WithValue(context.Background(), ctxKey{}, "caller")
+		var got any
+		var hasDeadline bool
+		mockHandler := func(ctx context.Context) (int, error) {
+			got = ctx.Value(ctxKey{})
+			_, hasDeadline = ctx.Deadline()
+			return 1, nil
+		}
+
+		// Act
+		_, err := GethRequestWithBackOff(ctx, &types.BackoffConfig{}, time.Second, mockHandler)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "caller", got)
+		assert.True(t, hasDeadline)
+	})
+}
+
 func TestRequestHttpWithBackoff(t *testing.T) {
 	t.Run("success", func(t *testing.T) {
 		// Arrange
@@ -179,7 +249,7 @@ func TestRequestHttpWithBackoff(t *testing.T) {
 		body := []byte{}
 
 		// Act
-		response, err := RequestHttpWithBackoff(backoffConfig, &http.Client{}, mockHandler, request, body)
+		response, err := RequestHttpWithBackoff(context.Background(), backoffConfig, &http.Client{}, mockHandler, request, body)
 
 		// Assert
 		assert.NoError(t, err)
@@ -198,7 +268,7 @@ func TestGethRequestArgWithBackOff(t *testing.T) {
 		}
 
 		// Act
-		result, err := GethRequestArgWithBackOff(backoffConfig, 0, mockHandler, 1)
+		result, err := GethRequestArgWithBackOff(context.Background(), backoffConfig, 0, mockHandler, 1)
 
 		// Assert
 		assert.NoError(t, err)
@@ -212,7 +282,7 @@ func TestGethRequestArgWithBackOff(t *testing.T) {
 		}
 
 		// Act
-		result, err := GethRequestArgWithBackOff(nil, 0, mockHandler, 1)
+		result, err := GethRequestArgWithBackOff(context.Background(), nil, 0, mockHandler, 1)
 
 		// Assert
 		assert.NoError(t, err)
@@ -231,7 +301,7 @@ func TestGethRequestTwoArgWithBackOff(t *testing.T) {
 		}
 
 		// Act
-		result, err := GethRequestTwoArgWithBackOff(backoffConfig, 0, mockHandler, 1, 2)
+		result, err := GethRequestTwoArgWithBackOff(context.Background(), backoffConfig, 0, mockHandler, 1, 2)
 
 		// Assert
 		assert.NoError(t, err)
@@ -245,7 +315,7 @@ func TestGethRequestTwoArgWithBackOff(t *testing.T) {
 		}
 
 		// Act
-		result, err := GethRequestTwoArgWithBackOff(nil, 0, mockHandler, 1, 2)
+		result, err := GethRequestTwoArgWithBackOff(context.Background(), nil, 0, mockHandler, 1, 2)
 
 		// Assert
 		assert.NoError(t, err)
@@ -264,7 +334,7 @@ func TestGethRequestThreeArgWithBackOff(t *testing.T) {
 		}
 
 		// Act
-		result, err := GethRequestThreeArgWithBackOff(backoffConfig, 0, mockHandler, 1, 2, 3)
+		result, err := GethRequestThreeArgWithBackOff(context.Background(), backoffConfig, 0, mockHandler, 1, 2, 3)
 
 		// Assert
 		assert.NoError(t, err)
@@ -278,7 +348,7 @@ func TestGethRequestThreeArgWithBackOff(t *testing.T) {
 		}
 
 		// Act
-		result, err := GethRequestThreeArgWithBackOff(nil, 0, mockHandler, 1, 2, 3)
+		result, err := GethRequestThreeArgWithBackOff(context.Background(), nil, 0, mockHandler, 1, 2, 3)
 
 		// Assert
 		assert.NoError(t, err)
@@ -297,7 +367,7 @@ func TestGethRequestArgWithBackOffTuple(t *testing.T) {
 		}
 
 		// Act
-		result1, result2, err := GethRequestArgWithBackOffTuple(backoffConfig, 0, mockHandler, 1)
+		result1, result2, err := GethRequestArgWithBackOffTuple(context.Background(), backoffConfig, 0, mockHandler, 1)
 
 		// Assert
 		assert.NoError(t, err)
@@ -312,7 +382,7 @@ func TestGethRequestArgWithBackOffTuple(t *testing.T) {
 		}
 
 		// Act
-		result1, result2, err := GethRequestArgWithBackOffTuple(nil, 0, mockHandler, 1)
+		result1, result2, err := GethRequestArgWithBackOffTuple(context.Background(), nil, 0, mockHandler, 1)
 
 		// Assert
 		assert.NoError(t, err)
@@ -332,7 +402,7 @@ func TestGethRequestWithBackOff(t *testing.T) {
 		}
 
 		// Act
-		result, err := GethRequestWithBackOff(backoffConfig, 0, mockHandler)
+		result, err := GethRequestWithBackOff(context.Background(), backoffConfig, 0, mockHandler)
 
 		// Assert
 		assert.NoError(t, err)
@@ -346,7 +416,7 @@ func TestGethRequestWithBackOff(t *testing.T) {
 		}
 
 		// Act
-		result, err := GethRequestWithBackOff(nil, 0, mockHandler)
+		result, err := GethRequestWithBackOff(context.Background(), nil, 0, mockHandler)
 
 		// Assert
 		assert.NoError(t, err)
@@ -365,7 +435,7 @@ func TestGethRequestSingleErrorWithBackOff(t *testing.T) {
 		}
 
 		// Act
-		err := GethRequestSingleErrorWithBackOff(backoffConfig, 0, mockHandler, 1)
+		err := GethRequestSingleErrorWithBackOff(context.Background(), backoffConfig, 0, mockHandler, 1)
 
 		// Assert
 		assert.NoError(t, err)
@@ -386,7 +456,7 @@ func TestGethRequestSingleErrorWithBackOff(t *testing.T) {
 		}
 
 		// Act
-		err := GethRequestSingleErrorWithBackOff(backoffConfig, 0, mockHandler, 1)
+		err := GethRequestSingleErrorWithBackOff(context.Background(), backoffConfig, 0, mockHandler, 1)
 
 		// Assert
 		assert.NoError(t, err)
@@ -408,7 +478,7 @@ func TestGethRequestSingleErrorWithBackOff(t *testing.T) {
 		}
 
 		// Act
-		err := GethRequestSingleErrorWithBackOff(backoffConfig, 0, mockHandler, 1)
+		err := GethRequestSingleErrorWithBackOff(context.Background(), backoffConfig, 0, mockHandler, 1)
 
 		// Assert
 		assert.Error(t, err)
@@ -424,7 +494,7 @@ func TestGethRequestSingleErrorWithBackOff(t *testing.T) {
 		}
 
 		// Act
-		err := GethRequestSingleErrorWithBackOff(backoffConfig, 0, mockHandler, 1)
+		err := GethRequestSingleErrorWithBackOff(context.Background(), backoffConfig, 0, mockHandler, 1)
 
 		// Assert
 		assert.Error(t, err)
@@ -437,7 +507,7 @@ func TestGethRequestSingleErrorWithBackOff(t *testing.T) {
 		}
 
 		// Act
-		err := GethRequestSingleErrorWithBackOff(nil, 0, mockHandler, 1)
+		err := GethRequestSingleErrorWithBackOff(context.Background(), nil, 0, mockHandler, 1)
 
 		// Assert
 		assert.NoError(t, err)
@@ -452,7 +522,7 @@ func TestRequestWithBackoffError(t *testing.T) {
 		}
 
 		// Act
-		err := requestWithBackoffError(types.DefaultBackoffConfig, operation)
+		err := requestWithBackoffError(context.Background(), types.DefaultBackoffConfig, operation)
 
 		// Assert
 		assert.NoError(t, err)
@@ -473,7 +543,7 @@ func TestRequestWithBackoffError(t *testing.T) {
 		}
 
 		// Act
-		err := requestWithBackoffError(config, operation)
+		err := requestWithBackoffError(context.Background(), config, operation)
 
 		// Assert
 		assert.NoError(t, err)
@@ -490,7 +560,7 @@ func TestRequestWithBackoffError(t *testing.T) {
 		}
 
 		// Act
-		err := requestWithBackoffError(config, operation)
+		err := requestWithBackoffError(context.Background(), config, operation)
 
 		// Assert
 		assert.Error(t, err)
@@ -9,8 +9,23 @@ import (
 
 	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/poteto-go/go-alchemy-sdk/constant"
+	"github.com/poteto-go/go-alchemy-sdk/types"
 )
 
+// IsRetryableError reports whether err is a transport or JSON-RPC error
+// that can work on a next try: a throttle, a timeout, or a code that is not
+// always reproduced. Any other error (e.g. an unexpected response) is not.
+func IsRetryableError(err error) bool {
+	_, isRateLimit := errors.AsType[*types.RateLimitError](err)
+	_, isUrl := errors.AsType[*url.Error](err)
+	_, isRpc := errors.AsType[rpc.Error](err)
+	_, isHttp := errors.AsType[rpc.HTTPError](err)
+	if !isRateLimit && !isUrl && !isRpc && !isHttp {
+		return false
+	}
+	return !isAlwaysReProduceError(err)
+}
+
 // Determine whether provided error always reproduce or not.
 // For cases where repeated transmission yields no change in result, do not back off.
 //
@@ -24,6 +39,11 @@ func isAlwaysReProduceError(err error) bool {
 		return false
 	}
 
+	// throttled: it can work once the server's wait is over
+	if _, ok := errors.AsType[*types.RateLimitError](err); ok {
+		return false
+	}
+
 	if urlErr, ok := errors.AsType[*url.Error](err); ok {
 		if urlErr.Timeout() || errors.Is(urlErr.Err, context.DeadlineExceeded) {
 			return false
@@ -46,6 +66,12 @@ func isAlwaysReProduceRpcError(err rpc.Error) bool {
 	errorCode := err.ErrorCode()
 	errorMsg := err.Error()
 
+	// eth_getLogs limit exceeded: the same range is refused again, it has to
+	// be split instead
+	if (&types.RpcError{Code: errorCode, Message: errorMsg}).IsLogsLimitExceeded() {
+		return true
+	}
+
 	// internal error
 	// not found
 	// stack limit reached
@@ -77,6 +103,12 @@ func isAlwaysReProduceRpcError(err rpc.Error) bool {
 		return true
 	}
 
+	// alchemy compute units exceeded
+	// if you wait backoff, it can work on next-try
+	if errorCode == http.StatusTooManyRequests {
+		return false
+	}
+
 	// limit exceeded
 	if errorCode == -32005 {
 		// tx pool limit exceeded
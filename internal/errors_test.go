@@ -9,6 +9,7 @@ import (
 	"testing"
 
 	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/poteto-go/go-alchemy-sdk/types"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -150,6 +151,26 @@ func Test_isAlwaysReproduceError(t *testing.T) {
 			err:      testRPCError{code: -32650, message: "custom error"},
 			expected: true,
 		},
+		{
+			name:     "rpc.Error -32005 (logs limit exceeded)",
+			err:      testRPCError{code: -32005, message: "query returned more than 10000 results"},
+			expected: true,
+		},
+		{
+			name:     "types.RpcError logs limit exceeded",
+			err:      &types.RpcError{Method: "eth_getLogs", Code: -32602, Message: "Log response size exceeded."},
+			expected: true,
+		},
+		{
+			name:     "rpc.Error 429 (compute units exceeded)",
+			err:      testRPCError{code: 429, message: "compute units exceeded"},
+			expected: false,
+		},
+		{
+			name:     "rate limit error",
+			err:      &types.RateLimitError{Err: rpc.HTTPError{StatusCode: http.StatusTooManyRequests}},
+			expected: false,
+		},
 		{
 			name:     "rpc.Error -32700",
 			err:      testRPCError{code: -32700, message: "parse error"},
@@ -178,3 +199,58 @@ func Test_isAlwaysReproduceError(t *testing.T) {
 		})
 	}
 }
+
+func TestIsRetryableError(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{
+			name:     "nil error",
+			err:      nil,
+			expected: false,
+		},
+		{
+			name:     "url error - timeout",
+			err:      &url.Error{Op: "Get", URL: "https://example.com", Err: context.DeadlineExceeded},
+			expected: true,
+		},
+		{
+			name:     "url error - permanent (no timeout)",
+			err:      &url.Error{},
+			expected: false,
+		},
+		{
+			name:     "rate limit error",
+			err:      &types.RateLimitError{Err: rpc.HTTPError{StatusCode: http.StatusTooManyRequests}},
+			expected: true,
+		},
+		{
+			name:     "rpc.Error -32000 execution timeout",
+			err:      testRPCError{code: -32000, message: "execution timeout"},
+			expected: true,
+		},
+		{
+			name:     "rpc.Error -32602",
+			err:      testRPCError{code: -32602, message: "invalid params"},
+			expected: false,
+		},
+		{
+			name:     "http 503",
+			err:      rpc.HTTPError{StatusCode: http.StatusServiceUnavailable},
+			expected: true,
+		},
+		{
+			name:     "unclassified error",
+			err:      errors.New("unexpected response type"),
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, IsRetryableError(tt.err))
+		})
+	}
+}
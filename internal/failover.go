@@ -0,0 +1,191 @@
+package internal
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/poteto-go/go-alchemy-sdk/constant"
+)
+
+// EndpointPool keeps an ordered list of RPC endpoints and their health.
+// The first healthy endpoint is preferred; an endpoint marked down is skipped
+// until its cooldown elapses, after which it is tried again (and preferred
+// again as soon as it answers).
+type EndpointPool struct {
+	endpoints []*url.URL
+	downUntil []time.Time
+	cooldown  time.Duration
+	now       func() time.Time
+	mu        sync.Mutex
+}
+
+func NewEndpointPool(urls []string, cooldown time.Duration) (*EndpointPool, error) {
+	endpoints := make([]*url.URL, len(urls))
+	for i, rawUrl := range urls {
+		parsed, err := url.Parse(rawUrl)
+		if err != nil {
+			return nil, err
+		}
+		endpoints[i] = parsed
+	}
+
+	return &EndpointPool{
+		endpoints: endpoints,
+		downUntil: make([]time.Time, len(urls)),
+		cooldown:  cooldown,
+		now:       time.Now,
+	}, nil
+}
+
+// Len returns the number of endpoints in the pool.
+func (p *EndpointPool) Len() int {
+	return len(p.endpoints)
+}
+
+// Endpoint returns the url of the i-th endpoint.
+func (p *EndpointPool) Endpoint(i int) *url.URL {
+	return p.endpoints[i]
+}
+
+// Order returns endpoint indexes in the order they should be tried:
+// healthy endpoints by priority first, then the ones still cooling down.
+func (p *EndpointPool) Order() []int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	now := p.now()
+	healthy := make([]int, 0, len(p.endpoints))
+	down := make([]int, 0, len(p.endpoints))
+	for i := range p.endpoints {
+		if now.Before(p.downUntil[i]) {
+			down = append(down, i)
+			continue
+		}
+		healthy = append(healthy, i)
+	}
+	return append(healthy, down...)
+}
+
+// Active returns the index of the endpoint the next request goes to first.
+func (p *EndpointPool) Active() int {
+	return p.Order()[0]
+}
+
+// MarkDown skips endpoint i until the cooldown elapses.
+func (p *EndpointPool) MarkDown(i int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.downUntil[i] = p.now().Add(p.cooldown)
+}
+
+// MarkUp marks endpoint i healthy again.
+func (p *EndpointPool) MarkUp(i int) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.downUntil[i] = time.Time{}
+}
+
+// IsFailoverStatus reports whether an http status means the endpoint itself is
+// unavailable (5xx or rate limited), so the request should move to the next one.
+func IsFailoverStatus(statusCode int) bool {
+	return statusCode == http.StatusTooManyRequests || statusCode >= http.StatusInternalServerError
+}
+
+// FailoverTransport is an http.RoundTripper that sends every request to the
+// active endpoint of its pool, whatever url the request was built for, and
+// moves on to the next endpoint on transport errors, 5xx or 429.
+//
+// It sits below both the Alchemy Send path and geth's rpc.Client, so the two
+// share the same view of which endpoint is healthy.
+//
+// The credentials of a request are for the host it was built for: a request
+// sent to another host goes without its Authorization and Cookie headers, nor
+// the authHeaders of NewFailoverTransport.
+type FailoverTransport struct {
+	pool        *EndpointPool
+	underlying  http.RoundTripper
+	authHeaders []string
+}
+
+// NewFailoverTransport wraps underlying. If underlying is nil, requests
+// delegate to http.DefaultTransport at call time. authHeaders are the
+// headers, on top of Authorization and Cookie, not sent to another host.
+func NewFailoverTransport(pool *EndpointPool, underlying http.RoundTripper, authHeaders ...string) *FailoverTransport {
+	return &FailoverTransport{
+		pool:        pool,
+		underlying:  underlying,
+		authHeaders: append([]string{"Authorization", "Cookie"}, authHeaders...),
+	}
+}
+
+func (t *FailoverTransport) Pool() *EndpointPool {
+	return t.pool
+}
+
+func (t *FailoverTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	// the body is replayed on every endpoint
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	order := t.pool.Order()
+	for n, i := range order {
+		if err := req.Context().Err(); err != nil {
+			return nil, err
+		}
+
+		res, err := t.roundTrip(req, t.pool.Endpoint(i), body)
+		if err == nil && !IsFailoverStatus(res.StatusCode) {
+			t.pool.MarkUp(i)
+			return res, nil
+		}
+		// the caller gave up (cancel or deadline): not the endpoint's fault
+		if err != nil && req.Context().Err() != nil {
+			return nil, err
+		}
+
+		t.pool.MarkDown(i)
+		// the last endpoint answers as is, so the caller sees the real status
+		if n == len(order)-1 {
+			return res, err
+		}
+		if res != nil {
+			res.Body.Close()
+		}
+	}
+	return nil, constant.ErrFailedToConnect
+}
+
+func (t *FailoverTransport) roundTrip(req *http.Request, endpoint *url.URL, body []byte) (*http.Response, error) {
+	target := *endpoint
+	attempt := req.Clone(req.Context())
+	attempt.URL = &target
+	attempt.Host = endpoint.Host
+	if endpoint.Host != req.URL.Host {
+		for _, header := range t.authHeaders {
+			attempt.Header.Del(header)
+		}
+	}
+	if req.Body != nil {
+		attempt.Body = io.NopCloser(bytes.NewReader(body))
+		attempt.ContentLength = int64(len(body))
+		attempt.GetBody = func() (io.ReadCloser, error) {
+			return io.NopCloser(bytes.NewReader(body)), nil
+		}
+	}
+
+	if t.underlying == nil {
+		return http.DefaultTransport.RoundTrip(attempt)
+	}
+	return t.underlying.RoundTrip(attempt)
+}
@@ -0,0 +1,292 @@
+package internal
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/jarcoal/httpmock"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	primaryUrl   = "https://primary.example.com/v2/key"
+	secondaryUrl = "http://127.0.0.1:8545"
+)
+
+func newFailoverForTest(t *testing.T) *FailoverTransport {
+	t.Helper()
+	pool, err := NewEndpointPool([]string{primaryUrl, secondaryUrl}, time.Minute)
+	assert.NoError(t, err)
+	return NewFailoverTransport(pool, nil)
+}
+
+func postTo(transport http.RoundTripper, body string) (*http.Response, error) {
+	req, _ := http.NewRequest("POST", primaryUrl, bytes.NewBufferString(body))
+	return transport.RoundTrip(req)
+}
+
+func TestEndpointPool_Order(t *testing.T) {
+	t.Run("healthy endpoints keep priority order", func(t *testing.T) {
+		// Arrange
+		pool, _ := NewEndpointPool([]string{primaryUrl, secondaryUrl}, time.Minute)
+
+		// Act & Assert
+		assert.Equal(t, []int{0, 1}, pool.Order())
+		assert.Equal(t, 0, pool.Active())
+	})
+
+	t.Run("down endpoint moves behind healthy ones", func(t *testing.T) {
+		// Arrange
+		pool, _ := NewEndpointPool([]string{primaryUrl, secondaryUrl}, time.Minute)
+
+		// Act
+		pool.MarkDown(0)
+
+		// Assert
+		assert.Equal(t, []int{1, 0}, pool.Order())
+		assert.Equal(t, 1, pool.Active())
+	})
+
+	t.Run("down endpoint is preferred again after cooldown", func(t *testing.T) {
+		// Arrange
+		pool, _ := NewEndpointPool([]string{primaryUrl, secondaryUrl}, time.Minute)
+		now := time.Now()
+		pool.now = func() time.Time { return now }
+		pool.MarkDown(0)
+
+		// Act
+		pool.now = func() time.Time { return now.Add(time.Minute) }
+
+		// Assert
+		assert.Equal(t, 0, pool.Active())
+	})
+
+	t.Run("MarkUp clears the cooldown", func(t *testing.T) {
+		// Arrange
+		pool, _ := NewEndpointPool([]string{primaryUrl, secondaryUrl}, time.Minute)
+		pool.MarkDown(0)
+
+		// Act
+		pool.MarkUp(0)
+
+		// Assert
+		assert.Equal(t, 0, pool.Active())
+	})
+
+	t.Run("invalid url -> error", func(t *testing.T) {
+		// Act
+		_, err := NewEndpointPool([]string{"http://[::1"}, time.Minute)
+
+		// Assert
+		assert.Error(t, err)
+	})
+}
+
+func TestIsFailoverStatus(t *testing.T) {
+	tests := []struct {
+		statusCode int
+		expected   bool
+	}{
+		{http.StatusOK, false},
+		{http.StatusBadRequest, false},
+		{http.StatusUnauthorized, false},
+		{http.StatusTooManyRequests, true},
+		{http.StatusInternalServerError, true},
+		{http.StatusBadGateway, true},
+		{http.StatusServiceUnavailable, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(http.StatusText(tt.statusCode), func(t *testing.T) {
+			assert.Equal(t, tt.expected, IsFailoverStatus(tt.statusCode))
+		})
+	}
+}
+
+func TestFailoverTransport_RoundTrip(t *testing.T) {
+	t.Run("healthy primary answers", func(t *testing.T) {
+		// Arrange
+		httpmock.Activate(t)
+		defer httpmock.DeactivateAndReset()
+		transport := newFailoverForTest(t)
+		httpmock.RegisterResponder("POST", primaryUrl, httpmock.NewStringResponder(200, "primary"))
+		httpmock.RegisterResponder("POST", secondaryUrl, httpmock.NewStringResponder(200, "secondary"))
+
+		// Act
+		res, err := postTo(transport, "{}")
+
+		// Assert
+		assert.NoError(t, err)
+		body, _ := io.ReadAll(res.Body)
+		assert.Equal(t, "primary", string(body))
+		assert.Equal(t, 0, httpmock.GetCallCountInfo()["POST "+secondaryUrl])
+	})
+
+	for _, tt := range []struct {
+		name      string
+		responder httpmock.Responder
+	}{
+		{"transport error", httpmock.NewErrorResponder(errors.New("connection refused"))},
+		{"5xx", httpmock.NewStringResponder(http.StatusServiceUnavailable, "unavailable")},
+		{"429", httpmock.NewStringResponder(http.StatusTooManyRequests, "rate limited")},
+	} {
+		t.Run(tt.name+" -> next endpoint with the same body", func(t *testing.T) {
+			// Arrange
+			httpmock.Activate(t)
+			defer httpmock.DeactivateAndReset()
+			transport := newFailoverForTest(t)
+			httpmock.RegisterResponder("POST", primaryUrl, tt.responder)
+			var receivedBody string
+			httpmock.RegisterResponder("POST", secondaryUrl, func(req *http.Request) (*http.Response, error) {
+				b, _ := io.ReadAll(req.Body)
+				receivedBody = string(b)
+				return httpmock.NewStringResponse(200, "secondary"), nil
+			})
+
+			// Act
+			res, err := postTo(transport, `{"method":"eth_blockNumber"}`)
+
+			// Assert
+			assert.NoError(t, err)
+			body, _ := io.ReadAll(res.Body)
+			assert.Equal(t, "secondary", string(body))
+			assert.Equal(t, `{"method":"eth_blockNumber"}`, receivedBody)
+			assert.Equal(t, 1, transport.Pool().Active())
+		})
+	}
+
+	t.Run("failed endpoint is skipped while cooling down", func(t *testing.T) {
+		// Arrange
+		httpmock.Activate(t)
+		defer httpmock.DeactivateAndReset()
+		transport := newFailoverForTest(t)
+		httpmock.RegisterResponder("POST", primaryUrl, httpmock.NewStringResponder(http.StatusBadGateway, ""))
+		httpmock.RegisterResponder("POST", secondaryUrl, httpmock.NewStringResponder(200, "secondary"))
+
+		// Act
+		_, _ = postTo(transport, "{}")
+		_, err := postTo(transport, "{}")
+
+		// Assert
+		assert.NoError(t, err)
+		info := httpmock.GetCallCountInfo()
+		assert.Equal(t, 1, info["POST "+primaryUrl])
+		assert.Equal(t, 2, info["POST "+secondaryUrl])
+	})
+
+	t.Run("moves back to the primary once it is healthy again", func(t *testing.T) {
+		// Arrange
+		httpmock.Activate(t)
+		defer httpmock.DeactivateAndReset()
+		transport := newFailoverForTest(t)
+		now := time.Now()
+		transport.pool.now = func() time.Time { return now }
+		httpmock.RegisterResponder("POST", primaryUrl, httpmock.NewStringResponder(http.StatusBadGateway, ""))
+		httpmock.RegisterResponder("POST", secondaryUrl, httpmock.NewStringResponder(200, "secondary"))
+		_, _ = postTo(transport, "{}")
+
+		// Act
+		httpmock.RegisterResponder("POST", primaryUrl, httpmock.NewStringResponder(200, "primary"))
+		transport.pool.now = func() time.Time { return now.Add(time.Minute) }
+		res, err := postTo(transport, "{}")
+
+		// Assert
+		assert.NoError(t, err)
+		body, _ := io.ReadAll(res.Body)
+		assert.Equal(t, "primary", string(body))
+		assert.Equal(t, 0, transport.Pool().Active())
+	})
+
+	t.Run("every endpoint fails -> last response is returned as is", func(t *testing.T) {
+		// Arrange
+		httpmock.Activate(t)
+		defer httpmock.DeactivateAndReset()
+		transport := newFailoverForTest(t)
+		httpmock.RegisterResponder("POST", primaryUrl, httpmock.NewStringResponder(http.StatusBadGateway, ""))
+		httpmock.RegisterResponder("POST", secondaryUrl, httpmock.NewStringResponder(http.StatusServiceUnavailable, ""))
+
+		// Act
+		res, err := postTo(transport, "{}")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusServiceUnavailable, res.StatusCode)
+	})
+
+	t.Run("a request the caller cancels does not mark the endpoint down", func(t *testing.T) {
+		// Arrange
+		httpmock.Activate(t)
+		defer httpmock.DeactivateAndReset()
+		transport := newFailoverForTest(t)
+		ctx, cancel := context.WithCancel(context.Background())
+		httpmock.RegisterResponder("POST", primaryUrl, func(req *http.Request) (*http.Response, error) {
+			cancel()
+			return nil, req.Context().Err()
+		})
+		httpmock.RegisterResponder("POST", secondaryUrl, httpmock.NewStringResponder(200, "secondary"))
+		req, _ := http.NewRequestWithContext(ctx, "POST", primaryUrl, bytes.NewBufferString("{}"))
+
+		// Act
+		_, err := transport.RoundTrip(req)
+
+		// Assert
+		assert.ErrorIs(t, err, context.Canceled)
+		assert.Equal(t, 0, transport.Pool().Active())
+		assert.Equal(t, 0, httpmock.GetCallCountInfo()["POST "+secondaryUrl])
+	})
+
+	t.Run("client error does not fail over", func(t *testing.T) {
+		// Arrange
+		httpmock.Activate(t)
+		defer httpmock.DeactivateAndReset()
+		transport := newFailoverForTest(t)
+		httpmock.RegisterResponder("POST", primaryUrl, httpmock.NewStringResponder(http.StatusBadRequest, ""))
+		httpmock.RegisterResponder("POST", secondaryUrl, httpmock.NewStringResponder(200, "secondary"))
+
+		// Act
+		res, err := postTo(transport, "{}")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+		assert.Equal(t, 0, transport.Pool().Active())
+	})
+
+	t.Run("auth headers are not sent to another host", func(t *testing.T) {
+		// Arrange
+		httpmock.Activate(t)
+		defer httpmock.DeactivateAndReset()
+		pool, _ := NewEndpointPool([]string{primaryUrl, secondaryUrl}, time.Minute)
+		transport := NewFailoverTransport(pool, nil, "X-Api-Key")
+		var primaryHeader, secondaryHeader http.Header
+		httpmock.RegisterResponder("POST", primaryUrl, func(req *http.Request) (*http.Response, error) {
+			primaryHeader = req.Header
+			return httpmock.NewStringResponse(http.StatusServiceUnavailable, ""), nil
+		})
+		httpmock.RegisterResponder("POST", secondaryUrl, func(req *http.Request) (*http.Response, error) {
+			secondaryHeader = req.Header
+			return httpmock.NewStringResponse(200, "secondary"), nil
+		})
+		req, _ := http.NewRequest("POST", primaryUrl, bytes.NewBufferString("{}"))
+		req.Header.Set("Authorization", "Bearer jws")
+		req.Header.Set("X-Api-Key", "key")
+		req.Header.Set("Content-Type", "application/json")
+
+		// Act
+		_, err := transport.RoundTrip(req)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "Bearer jws", primaryHeader.Get("Authorization"))
+		assert.Equal(t, "key", primaryHeader.Get("X-Api-Key"))
+		assert.Empty(t, secondaryHeader.Get("Authorization"))
+		assert.Empty(t, secondaryHeader.Get("X-Api-Key"))
+		assert.Equal(t, "application/json", secondaryHeader.Get("Content-Type"))
+		assert.Equal(t, "Bearer jws", req.Header.Get("Authorization"))
+	})
+}
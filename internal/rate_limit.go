@@ -0,0 +1,39 @@
+package internal
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/poteto-go/go-alchemy-sdk/types"
+	"github.com/poteto-go/go-alchemy-sdk/utils"
+)
+
+// rateLimited turns the error of a throttled geth call, HTTP 429 or
+// JSON-RPC error 429, into a *types.RateLimitError, with the headers the
+// SDK's http.Client recorded under ctx. Other errors are returned as is.
+func rateLimited(ctx context.Context, err error) error {
+	if _, ok := errors.AsType[*types.RateLimitError](err); ok {
+		return err
+	}
+
+	if httpErr, ok := errors.AsType[rpc.HTTPError](err); ok && httpErr.StatusCode == http.StatusTooManyRequests {
+		return utils.ParseRateLimit(utils.RateLimitHeader(ctx), httpErr.Body, err)
+	}
+
+	if rpcErr, ok := errors.AsType[rpc.Error](err); ok && rpcErr.ErrorCode() == http.StatusTooManyRequests {
+		rateLimitErr := utils.ParseRateLimit(utils.RateLimitHeader(ctx), nil, err)
+		rateLimitErr.Code = rpcErr.ErrorCode()
+		rateLimitErr.Message = rpcErr.Error()
+		if dataErr, ok := errors.AsType[rpc.DataError](err); ok {
+			if data, err := json.Marshal(dataErr.ErrorData()); err == nil {
+				rateLimitErr.Data = data
+			}
+		}
+		return rateLimitErr
+	}
+
+	return err
+}
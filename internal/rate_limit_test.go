@@ -0,0 +1,147 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/poteto-go/go-alchemy-sdk/types"
+	"github.com/poteto-go/go-alchemy-sdk/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+type testRPCDataError struct {
+	testRPCError
+	data any
+}
+
+func (e testRPCDataError) ErrorData() any {
+	return e.data
+}
+
+func Test_rateLimited(t *testing.T) {
+	t.Run("http 429 -> RateLimitError with the recorded headers", func(t *testing.T) {
+		// Arrange
+		ctx := utils.WithRateLimitSink(context.Background())
+		header := http.Header{}
+		header.Set("Retry-After", "2")
+		header.Set("X-RateLimit-Remaining", "0")
+		recordHeader(t, ctx, header)
+		httpErr := rpc.HTTPError{
+			StatusCode: http.StatusTooManyRequests,
+			Status:     "429 Too Many Requests",
+			Body:       []byte(`{"jsonrpc":"2.0","id":1,"error":{"code":429,"message":"slow down"}}`),
+		}
+
+		// Act
+		err := rateLimited(ctx, httpErr)
+
+		// Assert
+		rateLimitErr, ok := errors.AsType[*types.RateLimitError](err)
+		assert.True(t, ok)
+		assert.Equal(t, 2*time.Second, rateLimitErr.RetryAfter)
+		assert.Equal(t, 0, rateLimitErr.Remaining)
+		assert.Equal(t, 429, rateLimitErr.Code)
+		assert.Equal(t, "slow down", rateLimitErr.Message)
+		_, ok = errors.AsType[rpc.HTTPError](err)
+		assert.True(t, ok)
+	})
+
+	t.Run("json-rpc error 429 -> RateLimitError with its data", func(t *testing.T) {
+		// Arrange
+		rpcErr := testRPCDataError{
+			testRPCError: testRPCError{code: 429, message: "compute units exceeded"},
+			data:         map[string]int{"cups": 330},
+		}
+
+		// Act
+		err := rateLimited(context.Background(), rpcErr)
+
+		// Assert
+		rateLimitErr, ok := errors.AsType[*types.RateLimitError](err)
+		assert.True(t, ok)
+		assert.Equal(t, 429, rateLimitErr.Code)
+		assert.Equal(t, "compute units exceeded", rateLimitErr.Message)
+		assert.JSONEq(t, `{"cups":330}`, string(rateLimitErr.Data))
+		assert.Equal(t, -1, rateLimitErr.Limit)
+	})
+
+	t.Run("other errors are returned as is", func(t *testing.T) {
+		// Arrange
+		cause := errors.New("test error")
+		httpErr := rpc.HTTPError{StatusCode: http.StatusServiceUnavailable}
+
+		// Act & Assert
+		assert.Equal(t, cause, rateLimited(context.Background(), cause))
+		assert.Equal(t, httpErr, rateLimited(context.Background(), httpErr))
+		assert.Nil(t, rateLimited(context.Background(), nil))
+	})
+}
+
+func TestGethRequestWithBackOff_RetryAfter(t *testing.T) {
+	t.Run("waits Retry-After before the next try", func(t *testing.T) {
+		// Arrange
+		callCount := 0
+		mockHandler := func(context.Context) (int, error) {
+			callCount++
+			if callCount == 1 {
+				return 0, &types.RateLimitError{RetryAfter: 100 * time.Millisecond}
+			}
+			return 1, nil
+		}
+		backoffConfig := &types.BackoffConfig{Mode: "exponential", MaxRetries: 1, InitialDelayMs: 1, MaxDelayMs: 1, MaxRetryAfterMs: 1000}
+
+		// Act
+		start := time.Now()
+		result, err := GethRequestWithBackOff(context.Background(), backoffConfig, time.Second, mockHandler)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, 1, result)
+		assert.GreaterOrEqual(t, time.Since(start), 100*time.Millisecond)
+	})
+
+	t.Run("Retry-After past the cap -> the RateLimitError at once", func(t *testing.T) {
+		// Arrange
+		callCount := 0
+		mockHandler := func(context.Context) (int, error) {
+			callCount++
+			return 0, &types.RateLimitError{RetryAfter: time.Hour}
+		}
+		backoffConfig := &types.BackoffConfig{Mode: "exponential", MaxRetries: 3, InitialDelayMs: 1, MaxDelayMs: 1000}
+
+		// Act
+		start := time.Now()
+		_, err := GethRequestWithBackOff(context.Background(), backoffConfig, time.Second, mockHandler)
+
+		// Assert
+		rateLimitErr, ok := errors.AsType[*types.RateLimitError](err)
+		assert.True(t, ok)
+		assert.Equal(t, time.Hour, rateLimitErr.RetryAfter)
+		assert.Equal(t, 1, callCount)
+		assert.Less(t, time.Since(start), time.Second)
+	})
+}
+
+// recordHeader records header under ctx the way the SDK's http.Client does
+// for a 429 response.
+func recordHeader(t *testing.T, ctx context.Context, header http.Header) {
+	t.Helper()
+	server := func(req *http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusTooManyRequests, Header: header, Body: http.NoBody, Request: req}, nil
+	}
+	client := utils.NewSharedHTTPClient(0, time.Second, roundTripperFunc(server))
+	req, _ := http.NewRequestWithContext(ctx, "POST", "http://example.com", nil)
+	res, err := client.Do(req)
+	assert.NoError(t, err)
+	res.Body.Close()
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
@@ -0,0 +1,25 @@
+package internal
+
+import "context"
+
+type retryObserverKey struct{}
+
+// WithRetryObserver returns a ctx under which every backoff retry of this
+// package calls observe with the error that caused it, e.g. to count retries
+// per call. Observers already set on ctx are still called.
+func WithRetryObserver(ctx context.Context, observe func(err error)) context.Context {
+	if parent, ok := ctx.Value(retryObserverKey{}).(func(error)); ok {
+		child := observe
+		observe = func(err error) {
+			parent(err)
+			child(err)
+		}
+	}
+	return context.WithValue(ctx, retryObserverKey{}, observe)
+}
+
+func observeRetry(ctx context.Context, err error) {
+	if observe, ok := ctx.Value(retryObserverKey{}).(func(error)); ok {
+		observe(err)
+	}
+}
@@ -0,0 +1,79 @@
+package internal
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/poteto-go/go-alchemy-sdk/types"
+)
+
+func TestWithRetryObserver(t *testing.T) {
+	t.Run("observes every retry with its cause", func(t *testing.T) {
+		// Arrange
+		testErr := errors.New("test error")
+		var observed []error
+		ctx := WithRetryObserver(context.Background(), func(err error) {
+			observed = append(observed, err)
+		})
+		callCount := 0
+		operation := func() (int, error) {
+			callCount++
+			if callCount < 3 {
+				return 0, testErr
+			}
+			return 1, nil
+		}
+
+		// Act
+		_, err := requestWithBackoff(ctx, types.BackoffConfig{MaxRetries: 3}, operation)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, []error{testErr, testErr}, observed)
+	})
+
+	t.Run("giving up is not a retry", func(t *testing.T) {
+		// Arrange
+		observed := 0
+		ctx := WithRetryObserver(context.Background(), func(error) {
+			observed++
+		})
+		operation := func() (int, error) {
+			return 0, errors.New("test error")
+		}
+
+		// Act
+		_, err := requestWithBackoff(ctx, types.BackoffConfig{MaxRetries: 1}, operation)
+
+		// Assert
+		assert.Error(t, err)
+		assert.Equal(t, 1, observed)
+	})
+
+	t.Run("nested observers all observe", func(t *testing.T) {
+		// Arrange
+		outer, inner := 0, 0
+		ctx := WithRetryObserver(context.Background(), func(error) {
+			outer++
+		})
+		ctx = WithRetryObserver(ctx, func(error) {
+			inner++
+		})
+
+		// Act
+		observeRetry(ctx, errors.New("test error"))
+
+		// Assert
+		assert.Equal(t, 1, outer)
+		assert.Equal(t, 1, inner)
+	})
+
+	t.Run("no observer is a no-op", func(t *testing.T) {
+		assert.NotPanics(t, func() {
+			observeRetry(context.Background(), errors.New("test error"))
+		})
+	})
+}
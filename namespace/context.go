@@ -0,0 +1,371 @@
+package namespace
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/v2"
+	"github.com/ethereum/go-ethereum/common"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/poteto-go/go-alchemy-sdk/types"
+)
+
+var _ types.EtherApi = (*boundEther)(nil)
+
+/*
+boundEther routes the ctx-less EtherApi calls a namespace makes to their
+...Context variants with ctx, so the ctx-first namespaces (CoreCtx, ERC20Ctx,
+...) reuse the ctx-less namespace bodies instead of duplicating them.
+
+It implements EtherApi method by method instead of embedding it, so a method
+added to EtherApi does not compile until it is bound here too.
+
+It only lives for a single namespace call.
+*/
+type boundEther struct {
+	ether types.EtherApi
+	ctx   context.Context
+}
+
+func withContext(ctx context.Context, ether types.EtherApi) types.EtherApi {
+	return &boundEther{ether: ether, ctx: ctx}
+}
+
+func (b *boundEther) BatchCall(elems []rpc.BatchElem) error {
+	return b.ether.BatchCallContext(b.ctx, elems)
+}
+
+func (b *boundEther) BlockNumber() (uint64, error) {
+	return b.ether.BlockNumberContext(b.ctx)
+}
+
+func (b *boundEther) GetBalance(address string, blockTag string) (*big.Int, error) {
+	return b.ether.GetBalanceContext(b.ctx, address, blockTag)
+}
+
+func (b *boundEther) CodeAt(address string, blockTag string) (string, error) {
+	return b.ether.CodeAtContext(b.ctx, address, blockTag)
+}
+
+func (b *boundEther) CodeAtHash(address string, blockHash string) (string, error) {
+	return b.ether.CodeAtHashContext(b.ctx, address, blockHash)
+}
+
+func (b *boundEther) GetTransaction(hash string) (*gethTypes.Transaction, bool, error) {
+	return b.ether.GetTransactionContext(b.ctx, hash)
+}
+
+func (b *boundEther) StorageAt(address, position, blockTag string) (string, error) {
+	return b.ether.StorageAtContext(b.ctx, address, position, blockTag)
+}
+
+func (b *boundEther) GetLogs(filter types.Filter) ([]types.LogResponse, error) {
+	return b.ether.GetLogsContext(b.ctx, filter)
+}
+
+func (b *boundEther) GetTransactionReceipt(hash string) (*gethTypes.Receipt, error) {
+	return b.ether.GetTransactionReceiptContext(b.ctx, hash)
+}
+
+func (b *boundEther) GetTransactionReceipts(arg types.BlockNumberOrHash) ([]*gethTypes.Receipt, error) {
+	return b.ether.GetTransactionReceiptsContext(b.ctx, arg)
+}
+
+func (b *boundEther) GetBlockByNumber(blockNumber string) (*gethTypes.Block, error) {
+	return b.ether.GetBlockByNumberContext(b.ctx, blockNumber)
+}
+
+func (b *boundEther) GetBlockByHash(blockHash string) (*gethTypes.Block, error) {
+	return b.ether.GetBlockByHashContext(b.ctx, blockHash)
+}
+
+func (b *boundEther) ChainID() (*big.Int, error) {
+	return b.ether.ChainIDContext(b.ctx)
+}
+
+func (b *boundEther) PeerCount() (uint64, error) {
+	return b.ether.PeerCountContext(b.ctx)
+}
+
+func (b *boundEther) GasPrice() (*big.Int, error) {
+	return b.ether.GasPriceContext(b.ctx)
+}
+
+func (b *boundEther) EstimateGas(tx types.TransactionRequest) (*big.Int, error) {
+	return b.ether.EstimateGasContext(b.ctx, tx)
+}
+
+func (b *boundEther) SuggestGasPrice() (*big.Int, error) {
+	return b.ether.SuggestGasPriceContext(b.ctx)
+}
+
+func (b *boundEther) SuggestGasTipCap() (*big.Int, error) {
+	return b.ether.SuggestGasTipCapContext(b.ctx)
+}
+
+func (b *boundEther) SuggestEIP1559Fees() (*big.Int, *big.Int, error) {
+	return b.ether.SuggestEIP1559FeesContext(b.ctx)
+}
+
+func (b *boundEther) GetTokenBalances(address string, params ...string) (types.TokenBalanceResponse, error) {
+	return b.ether.GetTokenBalancesContext(b.ctx, address, params...)
+}
+
+func (b *boundEther) GetTokenBalancesPage(address string, pageKey string, maxCount int) (types.TokenBalanceResponse, error) {
+	return b.ether.GetTokenBalancesPageContext(b.ctx, address, pageKey, maxCount)
+}
+
+func (b *boundEther) GetTokenMetadata(address string) (types.TokenMetadataResponse, error) {
+	return b.ether.GetTokenMetadataContext(b.ctx, address)
+}
+
+func (b *boundEther) GetAssetTransfers(params types.AssetTransfersParams) (types.AssetTransfersResponse, error) {
+	return b.ether.GetAssetTransfersContext(b.ctx, params)
+}
+
+func (b *boundEther) CallReadMethod(method []byte, contractAddress string, args ...[]byte) ([]byte, error) {
+	return b.ether.CallReadMethodContext(b.ctx, method, contractAddress, args...)
+}
+
+func (b *boundEther) Call(tx types.TransactionRequest, blockTag string) (string, error) {
+	return b.ether.CallContext(b.ctx, tx, blockTag)
+}
+
+func (b *boundEther) CallContract(msg ethereum.CallMsg, blockTag string) ([]byte, error) {
+	return b.ether.CallContractContext(b.ctx, msg, blockTag)
+}
+
+func (b *boundEther) PendingNonceAt(address string) (uint64, error) {
+	return b.ether.PendingNonceAtContext(b.ctx, address)
+}
+
+func (b *boundEther) NonceAt(address string, blockTag string) (uint64, error) {
+	return b.ether.NonceAtContext(b.ctx, address, blockTag)
+}
+
+func (b *boundEther) SendRawTransaction(signedTx *gethTypes.Transaction) error {
+	return b.ether.SendRawTransactionContext(b.ctx, signedTx)
+}
+
+func (b *boundEther) Snapshot() (*big.Int, error) {
+	return b.ether.SnapshotContext(b.ctx)
+}
+
+func (b *boundEther) RevertTo(snapshotId *big.Int) (bool, error) {
+	return b.ether.RevertToContext(b.ctx, snapshotId)
+}
+
+func (b *boundEther) ResolveNameBy(registryAddress string, name string) (string, error) {
+	return b.ether.ResolveNameByContext(b.ctx, registryAddress, name)
+}
+
+func (b *boundEther) LookupAddressBy(registryAddress string, address string) (string, error) {
+	return b.ether.LookupAddressByContext(b.ctx, registryAddress, address)
+}
+
+// ContractCall runs with ctx unless ops carries its own.
+func (b *boundEther) ContractCall(contractAddress common.Address, ops *bind.CallOpts, callData []byte, unpack func([]byte) (any, error)) (any, error) {
+	opsWithCtx := bind.CallOpts{}
+	if ops != nil {
+		opsWithCtx = *ops
+	}
+	if opsWithCtx.Context == nil {
+		opsWithCtx.Context = b.ctx
+	}
+	return b.ether.ContractCall(contractAddress, &opsWithCtx, callData, unpack)
+}
+
+// ContractTransact runs with ctx unless auth carries its own.
+func (b *boundEther) ContractTransact(auth *bind.TransactOpts, contractAddress string, data []byte) (*gethTypes.Transaction, error) {
+	return b.ether.ContractTransact(b.transactOpts(auth), contractAddress, data)
+}
+
+// DeployContract runs with ctx unless auth carries its own.
+func (b *boundEther) DeployContract(auth *bind.TransactOpts, metaData *bind.MetaData) (*bind.DeploymentResult, error) {
+	return b.ether.DeployContract(b.transactOpts(auth), metaData)
+}
+
+func (b *boundEther) transactOpts(auth *bind.TransactOpts) *bind.TransactOpts {
+	if auth == nil || auth.Context != nil {
+		return auth
+	}
+	withCtx := *auth
+	withCtx.Context = b.ctx
+	return &withCtx
+}
+
+// The calls below take no ctx to bind: a ctx of their own, or none at all.
+
+func (b *boundEther) SetEthClient() error {
+	return b.ether.SetEthClient()
+}
+
+func (b *boundEther) Close() {
+	b.ether.Close()
+}
+
+func (b *boundEther) Shutdown() {
+	b.ether.Shutdown()
+}
+
+func (b *boundEther) Client() types.EthClient {
+	return b.ether.Client()
+}
+
+func (b *boundEther) Commit() (common.Hash, error) {
+	return b.ether.Commit()
+}
+
+func (b *boundEther) Fork(snapShotHash common.Hash) error {
+	return b.ether.Fork(snapShotHash)
+}
+
+func (b *boundEther) Network() types.Network {
+	return b.ether.Network()
+}
+
+func (b *boundEther) WaitMined(ctx context.Context, hash common.Hash) (*gethTypes.Receipt, error) {
+	return b.ether.WaitMined(ctx, hash)
+}
+
+func (b *boundEther) RevertReason(ctx context.Context, hash common.Hash) (*types.RevertError, error) {
+	return b.ether.RevertReason(ctx, hash)
+}
+
+func (b *boundEther) WaitDeployed(ctx context.Context, hash common.Hash) (common.Address, error) {
+	return b.ether.WaitDeployed(ctx, hash)
+}
+
+func (b *boundEther) BatchCallContext(ctx context.Context, elems []rpc.BatchElem) error {
+	return b.ether.BatchCallContext(ctx, elems)
+}
+
+func (b *boundEther) BlockNumberContext(ctx context.Context) (uint64, error) {
+	return b.ether.BlockNumberContext(ctx)
+}
+
+func (b *boundEther) GetBalanceContext(ctx context.Context, address string, blockTag string) (*big.Int, error) {
+	return b.ether.GetBalanceContext(ctx, address, blockTag)
+}
+
+func (b *boundEther) CodeAtContext(ctx context.Context, address string, blockTag string) (string, error) {
+	return b.ether.CodeAtContext(ctx, address, blockTag)
+}
+
+func (b *boundEther) CodeAtHashContext(ctx context.Context, address string, blockHash string) (string, error) {
+	return b.ether.CodeAtHashContext(ctx, address, blockHash)
+}
+
+func (b *boundEther) GetTransactionContext(ctx context.Context, hash string) (*gethTypes.Transaction, bool, error) {
+	return b.ether.GetTransactionContext(ctx, hash)
+}
+
+func (b *boundEther) StorageAtContext(ctx context.Context, address string, position string, blockTag string) (string, error) {
+	return b.ether.StorageAtContext(ctx, address, position, blockTag)
+}
+
+func (b *boundEther) GetLogsContext(ctx context.Context, filter types.Filter) ([]types.LogResponse, error) {
+	return b.ether.GetLogsContext(ctx, filter)
+}
+
+func (b *boundEther) GetTransactionReceiptContext(ctx context.Context, hash string) (*gethTypes.Receipt, error) {
+	return b.ether.GetTransactionReceiptContext(ctx, hash)
+}
+
+func (b *boundEther) GetTransactionReceiptsContext(ctx context.Context, arg types.BlockNumberOrHash) ([]*gethTypes.Receipt, error) {
+	return b.ether.GetTransactionReceiptsContext(ctx, arg)
+}
+
+func (b *boundEther) GetBlockByNumberContext(ctx context.Context, blockNumber string) (*gethTypes.Block, error) {
+	return b.ether.GetBlockByNumberContext(ctx, blockNumber)
+}
+
+func (b *boundEther) GetBlockByHashContext(ctx context.Context, blockHash string) (*gethTypes.Block, error) {
+	return b.ether.GetBlockByHashContext(ctx, blockHash)
+}
+
+func (b *boundEther) ChainIDContext(ctx context.Context) (*big.Int, error) {
+	return b.ether.ChainIDContext(ctx)
+}
+
+func (b *boundEther) PeerCountContext(ctx context.Context) (uint64, error) {
+	return b.ether.PeerCountContext(ctx)
+}
+
+func (b *boundEther) GasPriceContext(ctx context.Context) (*big.Int, error) {
+	return b.ether.GasPriceContext(ctx)
+}
+
+func (b *boundEther) EstimateGasContext(ctx context.Context, tx types.TransactionRequest) (*big.Int, error) {
+	return b.ether.EstimateGasContext(ctx, tx)
+}
+
+func (b *boundEther) SuggestGasPriceContext(ctx context.Context) (*big.Int, error) {
+	return b.ether.SuggestGasPriceContext(ctx)
+}
+
+func (b *boundEther) SuggestGasTipCapContext(ctx context.Context) (*big.Int, error) {
+	return b.ether.SuggestGasTipCapContext(ctx)
+}
+
+func (b *boundEther) SuggestEIP1559FeesContext(ctx context.Context) (*big.Int, *big.Int, error) {
+	return b.ether.SuggestEIP1559FeesContext(ctx)
+}
+
+func (b *boundEther) GetTokenBalancesContext(ctx context.Context, address string, params ...string) (types.TokenBalanceResponse, error) {
+	return b.ether.GetTokenBalancesContext(ctx, address, params...)
+}
+
+func (b *boundEther) GetTokenBalancesPageContext(ctx context.Context, address string, pageKey string, maxCount int) (types.TokenBalanceResponse, error) {
+	return b.ether.GetTokenBalancesPageContext(ctx, address, pageKey, maxCount)
+}
+
+func (b *boundEther) GetTokenMetadataContext(ctx context.Context, address string) (types.TokenMetadataResponse, error) {
+	return b.ether.GetTokenMetadataContext(ctx, address)
+}
+
+func (b *boundEther) GetAssetTransfersContext(ctx context.Context, params types.AssetTransfersParams) (types.AssetTransfersResponse, error) {
+	return b.ether.GetAssetTransfersContext(ctx, params)
+}
+
+func (b *boundEther) CallReadMethodContext(ctx context.Context, method []byte, contractAddress string, args ...[]byte) ([]byte, error) {
+	return b.ether.CallReadMethodContext(ctx, method, contractAddress, args...)
+}
+
+func (b *boundEther) CallContext(ctx context.Context, tx types.TransactionRequest, blockTag string) (string, error) {
+	return b.ether.CallContext(ctx, tx, blockTag)
+}
+
+func (b *boundEther) CallContractContext(ctx context.Context, msg ethereum.CallMsg, blockTag string) ([]byte, error) {
+	return b.ether.CallContractContext(ctx, msg, blockTag)
+}
+
+func (b *boundEther) PendingNonceAtContext(ctx context.Context, address string) (uint64, error) {
+	return b.ether.PendingNonceAtContext(ctx, address)
+}
+
+func (b *boundEther) NonceAtContext(ctx context.Context, address string, blockTag string) (uint64, error) {
+	return b.ether.NonceAtContext(ctx, address, blockTag)
+}
+
+func (b *boundEther) SendRawTransactionContext(ctx context.Context, signedTx *gethTypes.Transaction) error {
+	return b.ether.SendRawTransactionContext(ctx, signedTx)
+}
+
+func (b *boundEther) SnapshotContext(ctx context.Context) (*big.Int, error) {
+	return b.ether.SnapshotContext(ctx)
+}
+
+func (b *boundEther) RevertToContext(ctx context.Context, snapshotId *big.Int) (bool, error) {
+	return b.ether.RevertToContext(ctx, snapshotId)
+}
+
+func (b *boundEther) ResolveNameByContext(ctx context.Context, registryAddress string, name string) (string, error) {
+	return b.ether.ResolveNameByContext(ctx, registryAddress, name)
+}
+
+func (b *boundEther) LookupAddressByContext(ctx context.Context, registryAddress string, address string) (string, error) {
+	return b.ether.LookupAddressByContext(ctx, registryAddress, address)
+}
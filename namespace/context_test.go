@@ -0,0 +1,105 @@
+package namespace
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/v2"
+	"github.com/ethereum/go-ethereum/common"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/poteto-go/go-alchemy-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+type ctxKey struct{}
+
+// ctxRecorder records the ctx each ...Context call gets.
+type ctxRecorder struct {
+	types.EtherApi
+	got map[string]any
+}
+
+func (r *ctxRecorder) PendingNonceAtContext(ctx context.Context, _ string) (uint64, error) {
+	r.got["PendingNonceAt"] = ctx.Value(ctxKey{})
+	return 0, nil
+}
+
+func (r *ctxRecorder) NonceAtContext(ctx context.Context, _ string, _ string) (uint64, error) {
+	r.got["NonceAt"] = ctx.Value(ctxKey{})
+	return 0, nil
+}
+
+func (r *ctxRecorder) SendRawTransactionContext(ctx context.Context, _ *gethTypes.Transaction) error {
+	r.got["SendRawTransaction"] = ctx.Value(ctxKey{})
+	return nil
+}
+
+func (r *ctxRecorder) ContractCall(_ common.Address, ops *bind.CallOpts, _ []byte, _ func([]byte) (any, error)) (any, error) {
+	r.got["ContractCall"] = ops.Context.Value(ctxKey{})
+	return nil, nil
+}
+
+func (r *ctxRecorder) ContractTransact(auth *bind.TransactOpts, _ string, _ []byte) (*gethTypes.Transaction, error) {
+	r.got["ContractTransact"] = auth.Context.Value(ctxKey{})
+	return nil, nil
+}
+
+func TestBoundEther_TransactionSender(t *testing.T) {
+	// Arrange
+	recorder := &ctxRecorder{got: map[string]any{}}
+	ether := withContext(context.WithValue(context.Background(), ctxKey{}, "caller"), recorder)
+
+	// Act
+	_, pendingErr := ether.PendingNonceAt("0x1234567890abcdef1234567890abcdef12345678")
+	_, nonceErr := ether.NonceAt("0x1234567890abcdef1234567890abcdef12345678", "latest")
+	sendErr := ether.SendRawTransaction(gethTypes.NewTx(&gethTypes.LegacyTx{}))
+
+	// Assert
+	assert.NoError(t, pendingErr)
+	assert.NoError(t, nonceErr)
+	assert.NoError(t, sendErr)
+	assert.Equal(t, map[string]any{
+		"PendingNonceAt":     "caller",
+		"NonceAt":            "caller",
+		"SendRawTransaction": "caller",
+	}, recorder.got)
+}
+
+func TestBoundEther_Bind(t *testing.T) {
+	t.Run("bind calls run with ctx", func(t *testing.T) {
+		// Arrange
+		recorder := &ctxRecorder{got: map[string]any{}}
+		ether := withContext(context.WithValue(context.Background(), ctxKey{}, "caller"), recorder)
+
+		// Act
+		_, callErr := ether.ContractCall(common.Address{}, nil, nil, nil)
+		_, transactErr := ether.ContractTransact(&bind.TransactOpts{}, "0x1234567890abcdef1234567890abcdef12345678", nil)
+
+		// Assert
+		assert.NoError(t, callErr)
+		assert.NoError(t, transactErr)
+		assert.Equal(t, map[string]any{
+			"ContractCall":     "caller",
+			"ContractTransact": "caller",
+		}, recorder.got)
+	})
+
+	t.Run("the ctx of the opts wins", func(t *testing.T) {
+		// Arrange
+		recorder := &ctxRecorder{got: map[string]any{}}
+		ether := withContext(context.WithValue(context.Background(), ctxKey{}, "caller"), recorder)
+		own := context.WithValue(context.Background(), ctxKey{}, "own")
+
+		// Act
+		_, callErr := ether.ContractCall(common.Address{}, &bind.CallOpts{Context: own}, nil, nil)
+		_, transactErr := ether.ContractTransact(&bind.TransactOpts{Context: own}, "0x1234567890abcdef1234567890abcdef12345678", nil)
+
+		// Assert
+		assert.NoError(t, callErr)
+		assert.NoError(t, transactErr)
+		assert.Equal(t, map[string]any{
+			"ContractCall":     "own",
+			"ContractTransact": "own",
+		}, recorder.got)
+	})
+}
@@ -0,0 +1,179 @@
+package namespace
+
+import (
+	"context"
+	"iter"
+	"math/big"
+
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/poteto-go/go-alchemy-sdk/event"
+	"github.com/poteto-go/go-alchemy-sdk/types"
+)
+
+/*
+ICoreCtx is ICore with a caller supplied ctx as the first argument.
+
+ctx bounds the whole call, backoff retries and the sleeps between them
+included; see ICore for what each method returns.
+*/
+type ICoreCtx interface {
+	GetBlockNumber(ctx context.Context) (uint64, error)
+	GetGasPrice(ctx context.Context) (*big.Int, error)
+	SuggestGasTipCap(ctx context.Context) (*big.Int, error)
+	SuggestEIP1559Fees(ctx context.Context) (maxPriorityFeePerGas *big.Int, maxFeePerGas *big.Int, err error)
+	PeerCount(ctx context.Context) (uint64, error)
+	GetBalance(ctx context.Context, address string, blockTag string) (*big.Int, error)
+	GetCode(ctx context.Context, address string, arg types.BlockTagOrHash) (string, error)
+	IsContractAddress(ctx context.Context, address string) bool
+	GetTransaction(ctx context.Context, hash string) (tx *gethTypes.Transaction, isPending bool, err error)
+	GetStorageAt(ctx context.Context, address, position, blockTag string) (string, error)
+	GetTokenBalances(ctx context.Context, address string, option *types.TokenBalanceOption) (types.TokenBalanceResponse, error)
+	GetTokenMetadata(ctx context.Context, address string) (types.TokenMetadataResponse, error)
+	GetLogs(ctx context.Context, filter types.Filter) ([]types.LogResponse, error)
+	GetLogsRange(ctx context.Context, filter types.Filter, option *types.LogsRangeOption) ([]types.LogResponse, error)
+	GetEvents(ctx context.Context, filter types.Filter, decoder *event.Decoder) ([]any, error)
+	EstimateGas(ctx context.Context, tx types.TransactionRequest) (*big.Int, error)
+	Call(ctx context.Context, tx types.TransactionRequest, blockTag string) (string, error)
+	GetTransactionReceipt(ctx context.Context, hash string) (*gethTypes.Receipt, error)
+	GetTransactionReceipts(ctx context.Context, arg types.BlockNumberOrHash) ([]*gethTypes.Receipt, error)
+	GetBlock(ctx context.Context, blockHashOrBlockTag types.BlockTagOrHash) (*gethTypes.Block, error)
+	ResolveName(ctx context.Context, name string) (string, error)
+	ResolveNameBy(ctx context.Context, registryAddress string, name string) (string, error)
+	LookupAddress(ctx context.Context, address string) (string, error)
+	LookupAddressBy(ctx context.Context, registryAddress string, address string) (string, error)
+	GetAssetTransfers(ctx context.Context, params types.AssetTransfersParams) (types.AssetTransfersResponse, error)
+	IterAssetTransfers(ctx context.Context, params types.AssetTransfersParams, option *types.PageOption) iter.Seq2[types.AssetTransfer, error]
+	CollectAssetTransfers(ctx context.Context, params types.AssetTransfersParams, option *types.PageOption) ([]types.AssetTransfer, error)
+	IterTokenBalances(ctx context.Context, address string, option *types.TokenBalanceOption, pageOption *types.PageOption) iter.Seq2[types.TokenBalance, error]
+	CollectTokenBalances(ctx context.Context, address string, option *types.TokenBalanceOption, pageOption *types.PageOption) ([]types.TokenBalance, error)
+}
+
+type CoreCtx struct {
+	ether types.EtherApi
+}
+
+func NewCoreCtx(ether types.EtherApi) ICoreCtx {
+	return &CoreCtx{
+		ether: ether,
+	}
+}
+
+func (c *CoreCtx) core(ctx context.Context) *Core {
+	return &Core{ether: withContext(ctx, c.ether)}
+}
+
+func (c *CoreCtx) GetBlockNumber(ctx context.Context) (uint64, error) {
+	return c.core(ctx).GetBlockNumber()
+}
+
+func (c *CoreCtx) GetGasPrice(ctx context.Context) (*big.Int, error) {
+	return c.core(ctx).GetGasPrice()
+}
+
+func (c *CoreCtx) SuggestGasTipCap(ctx context.Context) (*big.Int, error) {
+	return c.core(ctx).SuggestGasTipCap()
+}
+
+func (c *CoreCtx) SuggestEIP1559Fees(ctx context.Context) (*big.Int, *big.Int, error) {
+	return c.core(ctx).SuggestEIP1559Fees()
+}
+
+func (c *CoreCtx) PeerCount(ctx context.Context) (uint64, error) {
+	return c.core(ctx).PeerCount()
+}
+
+func (c *CoreCtx) GetBalance(ctx context.Context, address string, blockTag string) (*big.Int, error) {
+	return c.core(ctx).GetBalance(address, blockTag)
+}
+
+func (c *CoreCtx) GetCode(ctx context.Context, address string, arg types.BlockTagOrHash) (string, error) {
+	return c.core(ctx).GetCode(address, arg)
+}
+
+func (c *CoreCtx) IsContractAddress(ctx context.Context, address string) bool {
+	return c.core(ctx).IsContractAddress(address)
+}
+
+func (c *CoreCtx) GetTransaction(ctx context.Context, hash string) (*gethTypes.Transaction, bool, error) {
+	return c.core(ctx).GetTransaction(hash)
+}
+
+func (c *CoreCtx) GetStorageAt(ctx context.Context, address, position, blockTag string) (string, error) {
+	return c.core(ctx).GetStorageAt(address, position, blockTag)
+}
+
+func (c *CoreCtx) GetTokenBalances(ctx context.Context, address string, option *types.TokenBalanceOption) (types.TokenBalanceResponse, error) {
+	return c.core(ctx).GetTokenBalances(address, option)
+}
+
+func (c *CoreCtx) GetTokenMetadata(ctx context.Context, address string) (types.TokenMetadataResponse, error) {
+	return c.core(ctx).GetTokenMetadata(address)
+}
+
+func (c *CoreCtx) GetLogs(ctx context.Context, filter types.Filter) ([]types.LogResponse, error) {
+	return c.core(ctx).GetLogs(filter)
+}
+
+func (c *CoreCtx) GetLogsRange(ctx context.Context, filter types.Filter, option *types.LogsRangeOption) ([]types.LogResponse, error) {
+	return c.core(ctx).GetLogsRange(filter, option)
+}
+
+func (c *CoreCtx) GetEvents(ctx context.Context, filter types.Filter, decoder *event.Decoder) ([]any, error) {
+	return c.core(ctx).GetEvents(filter, decoder)
+}
+
+func (c *CoreCtx) EstimateGas(ctx context.Context, tx types.TransactionRequest) (*big.Int, error) {
+	return c.core(ctx).EstimateGas(tx)
+}
+
+func (c *CoreCtx) Call(ctx context.Context, tx types.TransactionRequest, blockTag string) (string, error) {
+	return c.core(ctx).Call(tx, blockTag)
+}
+
+func (c *CoreCtx) GetTransactionReceipt(ctx context.Context, hash string) (*gethTypes.Receipt, error) {
+	return c.core(ctx).GetTransactionReceipt(hash)
+}
+
+func (c *CoreCtx) GetTransactionReceipts(ctx context.Context, arg types.BlockNumberOrHash) ([]*gethTypes.Receipt, error) {
+	return c.core(ctx).GetTransactionReceipts(arg)
+}
+
+func (c *CoreCtx) GetBlock(ctx context.Context, blockHashOrBlockTag types.BlockTagOrHash) (*gethTypes.Block, error) {
+	return c.core(ctx).GetBlock(blockHashOrBlockTag)
+}
+
+func (c *CoreCtx) ResolveName(ctx context.Context, name string) (string, error) {
+	return c.core(ctx).ResolveName(name)
+}
+
+func (c *CoreCtx) ResolveNameBy(ctx context.Context, registryAddress string, name string) (string, error) {
+	return c.core(ctx).ResolveNameBy(registryAddress, name)
+}
+
+func (c *CoreCtx) LookupAddress(ctx context.Context, address string) (string, error) {
+	return c.core(ctx).LookupAddress(address)
+}
+
+func (c *CoreCtx) LookupAddressBy(ctx context.Context, registryAddress string, address string) (string, error) {
+	return c.core(ctx).LookupAddressBy(registryAddress, address)
+}
+
+func (c *CoreCtx) GetAssetTransfers(ctx context.Context, params types.AssetTransfersParams) (types.AssetTransfersResponse, error) {
+	return c.core(ctx).GetAssetTransfers(params)
+}
+
+func (c *CoreCtx) IterAssetTransfers(ctx context.Context, params types.AssetTransfersParams, option *types.PageOption) iter.Seq2[types.AssetTransfer, error] {
+	return c.core(ctx).iterAssetTransfers(ctx, params, option)
+}
+
+func (c *CoreCtx) CollectAssetTransfers(ctx context.Context, params types.AssetTransfersParams, option *types.PageOption) ([]types.AssetTransfer, error) {
+	return collect(c.IterAssetTransfers(ctx, params, option))
+}
+
+func (c *CoreCtx) IterTokenBalances(ctx context.Context, address string, option *types.TokenBalanceOption, pageOption *types.PageOption) iter.Seq2[types.TokenBalance, error] {
+	return c.core(ctx).iterTokenBalances(ctx, address, option, pageOption)
+}
+
+func (c *CoreCtx) CollectTokenBalances(ctx context.Context, address string, option *types.TokenBalanceOption, pageOption *types.PageOption) ([]types.TokenBalance, error) {
+	return collect(c.IterTokenBalances(ctx, address, option, pageOption))
+}
@@ -0,0 +1,155 @@
+package namespace
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/poteto-go/go-alchemy-sdk/constant"
+	"github.com/poteto-go/go-alchemy-sdk/types"
+)
+
+type logsRange struct {
+	from, to uint64
+}
+
+/*
+GetLogsRange is GetLogs over a block range of any size.
+
+The range of filter is split into option.ChunkSize-block requests, run
+option.Concurrency at a time. A request the node refuses for matching too
+many logs or blocks (see types.RpcError.IsLogsLimitExceeded) is bisected
+until it fits. Logs are returned in block order; the first error fails the
+whole call. A filter with BlockHash is one block, so it is one GetLogs.
+*/
+func (c *Core) GetLogsRange(filter types.Filter, option *types.LogsRangeOption) ([]types.LogResponse, error) {
+	if filter.BlockHash != "" {
+		return c.ether.GetLogs(filter)
+	}
+
+	chunkSize, concurrency := uint64(constant.DefaultLogsRangeChunkSize), constant.DefaultLogsRangeConcurrency
+	if option != nil && option.ChunkSize > 0 {
+		chunkSize = option.ChunkSize
+	}
+	if option != nil && option.Concurrency > 0 {
+		concurrency = option.Concurrency
+	}
+
+	from, err := c.resolveBlockNumber(filter.FromBlock)
+	if err != nil {
+		return []types.LogResponse{}, err
+	}
+	to, err := c.resolveBlockNumber(filter.ToBlock)
+	if err != nil {
+		return []types.LogResponse{}, err
+	}
+	if from > to {
+		return []types.LogResponse{}, constant.ErrInvalidArgs
+	}
+
+	var chunks []logsRange
+	for lo := from; ; lo += chunkSize {
+		hi := to
+		if to-lo >= chunkSize {
+			hi = lo + chunkSize - 1
+		}
+		chunks = append(chunks, logsRange{from: lo, to: hi})
+		if hi == to {
+			break
+		}
+	}
+
+	results := make([][]types.LogResponse, len(chunks))
+	var (
+		wg       sync.WaitGroup
+		mu       sync.Mutex
+		firstErr error
+	)
+	sem := make(chan struct{}, concurrency)
+	for i, chunk := range chunks {
+		sem <- struct{}{}
+		mu.Lock()
+		failed := firstErr != nil
+		mu.Unlock()
+		if failed {
+			<-sem
+			break
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			logs, err := c.getLogsBisect(filter, chunk)
+			if err != nil {
+				mu.Lock()
+				if firstErr == nil {
+					firstErr = err
+				}
+				mu.Unlock()
+				return
+			}
+			results[i] = logs
+		}()
+	}
+	wg.Wait()
+	if firstErr != nil {
+		return []types.LogResponse{}, firstErr
+	}
+
+	logs := []types.LogResponse{}
+	for _, result := range results {
+		logs = append(logs, result...)
+	}
+	return logs, nil
+}
+
+// getLogsBisect fetches the logs of r, halving r while the node refuses it.
+func (c *Core) getLogsBisect(filter types.Filter, r logsRange) ([]types.LogResponse, error) {
+	filter.FromBlock = hexutil.EncodeUint64(r.from)
+	filter.ToBlock = hexutil.EncodeUint64(r.to)
+	logs, err := c.ether.GetLogs(filter)
+	if err == nil {
+		return logs, nil
+	}
+
+	rpcErr, ok := errors.AsType[*types.RpcError](err)
+	if !ok || !rpcErr.IsLogsLimitExceeded() || r.from == r.to {
+		return nil, err
+	}
+
+	mid := r.from + (r.to-r.from)/2
+	left, err := c.getLogsBisect(filter, logsRange{from: r.from, to: mid})
+	if err != nil {
+		return nil, err
+	}
+	right, err := c.getLogsBisect(filter, logsRange{from: mid + 1, to: r.to})
+	if err != nil {
+		return nil, err
+	}
+	return append(left, right...), nil
+}
+
+// resolveBlockNumber returns the number of a hex block number or block tag;
+// "" is latest.
+func (c *Core) resolveBlockNumber(blockTag string) (uint64, error) {
+	switch blockTag {
+	case "", "latest":
+		return c.ether.BlockNumber()
+	case "earliest":
+		return 0, nil
+	case "pending", "safe", "finalized":
+		block, err := c.ether.GetBlockByNumber(blockTag)
+		if err != nil {
+			return 0, err
+		}
+		return block.NumberU64(), nil
+	}
+
+	number, err := hexutil.DecodeUint64(blockTag)
+	if err != nil {
+		return 0, constant.ErrInvalidBlockTag
+	}
+	return number, nil
+}
@@ -1,10 +1,12 @@
 package namespace
 
 import (
+	"iter"
 	"math/big"
 
 	gethTypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/poteto-go/go-alchemy-sdk/constant"
+	"github.com/poteto-go/go-alchemy-sdk/event"
 	"github.com/poteto-go/go-alchemy-sdk/types"
 )
 
@@ -84,6 +86,20 @@ type ICore interface {
 	*/
 	GetLogs(filter types.Filter) ([]types.LogResponse, error)
 
+	/*
+		Returns the logs that match the provided filter over a block range of any
+		size, split into chunks fetched in parallel and bisected when the node
+		refuses a chunk for its size. Logs are in block order.
+	*/
+	GetLogsRange(filter types.Filter, option *types.LogsRangeOption) ([]types.LogResponse, error)
+
+	/*
+		Returns the logs that match the provided filter, decoded by decoder
+		(event.Standard() if nil). Logs of events the decoder does not know
+		are skipped.
+	*/
+	GetEvents(filter types.Filter, decoder *event.Decoder) ([]any, error)
+
 	/*
 		Returns an estimate of the amount of gas that would be required to submit transaction to the network.
 
@@ -163,6 +179,33 @@ type ICore interface {
 		endpoints such as simulated backends.
 	*/
 	GetAssetTransfers(params types.AssetTransfersParams) (types.AssetTransfersResponse, error)
+
+	/*
+		IterAssetTransfers yields the transfers matching params page by page,
+		following the page keys from params.PageKey on. A failed page is
+		retried with option.Backoff before its error is yielded, which ends
+		the iteration.
+	*/
+	IterAssetTransfers(params types.AssetTransfersParams, option *types.PageOption) iter.Seq2[types.AssetTransfer, error]
+
+	/*
+		CollectAssetTransfers returns the transfers of IterAssetTransfers, up
+		to option.MaxItems. On error, it returns the transfers collected so
+		far with it.
+	*/
+	CollectAssetTransfers(params types.AssetTransfersParams, option *types.PageOption) ([]types.AssetTransfer, error)
+
+	/*
+		IterTokenBalances is IterAssetTransfers for the ERC-20 token balances
+		of address. With option.ContractAddresses, it yields their balances,
+		which come in a single page.
+	*/
+	IterTokenBalances(address string, option *types.TokenBalanceOption, pageOption *types.PageOption) iter.Seq2[types.TokenBalance, error]
+
+	/*
+		CollectTokenBalances is CollectAssetTransfers for IterTokenBalances.
+	*/
+	CollectTokenBalances(address string, option *types.TokenBalanceOption, pageOption *types.PageOption) ([]types.TokenBalance, error)
 }
 
 type Core struct {
@@ -264,6 +307,10 @@ func (c *Core) GetTokenBalances(address string, option *types.TokenBalanceOption
 		params = option.ContractAddresses
 	}
 
+	if option != nil && len(params) == 0 && (option.PageKey != "" || option.MaxCount > 0) {
+		return c.ether.GetTokenBalancesPage(address, option.PageKey, option.MaxCount)
+	}
+
 	result, err := c.ether.GetTokenBalances(address, params...)
 	if err != nil {
 		return types.TokenBalanceResponse{}, err
@@ -290,6 +337,18 @@ func (c *Core) GetLogs(filter types.Filter) ([]types.LogResponse, error) {
 	return logs, nil
 }
 
+func (c *Core) GetEvents(filter types.Filter, decoder *event.Decoder) ([]any, error) {
+	logs, err := c.ether.GetLogs(filter)
+	if err != nil {
+		return []any{}, err
+	}
+
+	if decoder == nil {
+		decoder = event.Standard()
+	}
+	return decoder.DecodeLogResponses(logs)
+}
+
 func (c *Core) EstimateGas(tx types.TransactionRequest) (*big.Int, error) {
 	estimatedGas, err := c.ether.EstimateGas(tx)
 	if err != nil {
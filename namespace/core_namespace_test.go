@@ -10,9 +10,11 @@ import (
 
 	"github.com/agiledragon/gomonkey"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	gethTypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/poteto-go/go-alchemy-sdk/constant"
 	"github.com/poteto-go/go-alchemy-sdk/ether"
+	"github.com/poteto-go/go-alchemy-sdk/event"
 	"github.com/poteto-go/go-alchemy-sdk/gas"
 	"github.com/poteto-go/go-alchemy-sdk/namespace"
 	"github.com/poteto-go/go-alchemy-sdk/types"
@@ -42,6 +44,8 @@ func newEtherApi() *ether.Ether {
 		nil,
 		0,
 		nil,
+		nil,
+		nil,
 	)).(*ether.Ether)
 }
 
@@ -960,6 +964,220 @@ func TestCore_GetLogs(t *testing.T) {
 	})
 }
 
+func TestCore_GetEvents(t *testing.T) {
+	// Arrange
+	api := newEtherApi()
+	coreNamespace := namespace.NewCore(api).(*namespace.Core)
+	filter := types.Filter{Address: "0x3"}
+
+	t.Run("decodes the logs of ether.GetLogs", func(t *testing.T) {
+		patches := gomonkey.NewPatches()
+		defer patches.Reset()
+
+		// Arrange
+		logs := []types.LogResponse{
+			{
+				LogIndex:         "0x0",
+				BlockNumber:      "0x233",
+				TransactionIndex: "0x0",
+				Address:          "0x42699a7612a82f1d9c36148af9c77354759b210b",
+				Data:             "0x0000000000000000000000000000000000000000000000000000000000000004",
+				Topics: []string{
+					// Transfer(address,address,uint256)
+					"0xddf252ad1be2c89b69c2b068fc378daa952ba7f163c4a11628f55a4df523b3ef",
+					"0x000000000000000000000000000000000000000000000000000000000000000a",
+					"0x000000000000000000000000000000000000000000000000000000000000000b",
+				},
+			},
+			{
+				LogIndex:         "0x1",
+				BlockNumber:      "0x233",
+				TransactionIndex: "0x0",
+				Address:          "0x42699a7612a82f1d9c36148af9c77354759b210b",
+				Data:             "0x0000000000000000000000000000000000000000000000000000000000000004",
+				Topics: []string{
+					"0x04474795f5b996ff80cb47c148d4c5ccdbe09ef27551820caa9c2f8ed149cce3",
+				},
+			},
+		}
+
+		// Mock & Assert
+		patches.ApplyMethod(
+			reflect.TypeOf(api),
+			"GetLogs",
+			func(_ *ether.Ether, fil types.Filter) ([]types.LogResponse, error) {
+				assert.Equal(t, filter, fil)
+				return logs, nil
+			},
+		)
+
+		// Act
+		actual, err := coreNamespace.GetEvents(filter, nil)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Len(t, actual, 1)
+		transfer := actual[0].(*event.Transfer)
+		assert.Equal(t, common.HexToAddress("0xa"), transfer.From)
+		assert.Equal(t, common.HexToAddress("0xb"), transfer.To)
+		assert.Equal(t, big.NewInt(4), transfer.Value)
+	})
+
+	t.Run("call ether.GetLogs & return internal error", func(t *testing.T) {
+		patches := gomonkey.NewPatches()
+		defer patches.Reset()
+
+		// Arrange
+		expectedErr := errors.New("error")
+
+		// Mock
+		patches.ApplyMethod(
+			reflect.TypeOf(api),
+			"GetLogs",
+			func(_ *ether.Ether, _ types.Filter) ([]types.LogResponse, error) {
+				return []types.LogResponse{}, expectedErr
+			},
+		)
+
+		// Act
+		_, err := coreNamespace.GetEvents(filter, event.Standard())
+
+		// Assert
+		assert.ErrorIs(t, err, expectedErr)
+	})
+}
+
+func TestCore_GetLogsRange(t *testing.T) {
+	// Arrange
+	api := newEtherApi()
+	coreNamespace := namespace.NewCore(api).(*namespace.Core)
+
+	// a node with one log per block that refuses spans of more than 4 blocks
+	limitedGetLogs := func(_ *ether.Ether, fil types.Filter) ([]types.LogResponse, error) {
+		from, _ := hexutil.DecodeUint64(fil.FromBlock)
+		to, _ := hexutil.DecodeUint64(fil.ToBlock)
+		if to-from >= 4 {
+			return []types.LogResponse{}, &types.RpcError{Code: -32005, Message: "query returned more than 10000 results"}
+		}
+		logs := []types.LogResponse{}
+		for n := from; n <= to; n++ {
+			logs = append(logs, types.LogResponse{BlockNumber: hexutil.EncodeUint64(n), Address: fil.Address})
+		}
+		return logs, nil
+	}
+
+	t.Run("chunks & bisects the range, keeping block order", func(t *testing.T) {
+		patches := gomonkey.NewPatches()
+		defer patches.Reset()
+
+		// Mock
+		patches.ApplyMethod(reflect.TypeOf(api), "GetLogs", limitedGetLogs)
+
+		// Act
+		actual, err := coreNamespace.GetLogsRange(
+			types.Filter{FromBlock: "0x0", ToBlock: "0x19", Address: "0x3"},
+			&types.LogsRangeOption{ChunkSize: 10, Concurrency: 2},
+		)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Len(t, actual, 26)
+		for i, log := range actual {
+			assert.Equal(t, hexutil.EncodeUint64(uint64(i)), log.BlockNumber)
+			assert.Equal(t, "0x3", log.Address)
+		}
+	})
+
+	t.Run("latest is resolved by ether.BlockNumber", func(t *testing.T) {
+		patches := gomonkey.NewPatches()
+		defer patches.Reset()
+
+		// Mock
+		patches.ApplyMethod(reflect.TypeOf(api), "GetLogs", limitedGetLogs)
+		patches.ApplyMethod(
+			reflect.TypeOf(api),
+			"BlockNumber",
+			func(_ *ether.Ether) (uint64, error) {
+				return 7, nil
+			},
+		)
+
+		// Act
+		actual, err := coreNamespace.GetLogsRange(types.Filter{FromBlock: "0x5"}, nil)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Len(t, actual, 3)
+		assert.Equal(t, "0x7", actual[2].BlockNumber)
+	})
+
+	t.Run("a filter with BlockHash is sent as is", func(t *testing.T) {
+		patches := gomonkey.NewPatches()
+		defer patches.Reset()
+
+		// Arrange
+		var sent []types.Filter
+
+		// Mock
+		patches.ApplyMethod(
+			reflect.TypeOf(api),
+			"GetLogs",
+			func(_ *ether.Ether, fil types.Filter) ([]types.LogResponse, error) {
+				sent = append(sent, fil)
+				return []types.LogResponse{{BlockHash: fil.BlockHash}}, nil
+			},
+		)
+
+		// Act
+		actual, err := coreNamespace.GetLogsRange(types.Filter{BlockHash: "0xh", Address: "0x3"}, nil)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, []types.Filter{{BlockHash: "0xh", Address: "0x3"}}, sent)
+		assert.Equal(t, []types.LogResponse{{BlockHash: "0xh"}}, actual)
+	})
+
+	t.Run("if from is after to, return ErrInvalidArgs", func(t *testing.T) {
+		// Act
+		_, err := coreNamespace.GetLogsRange(types.Filter{FromBlock: "0x2", ToBlock: "0x1"}, nil)
+
+		// Assert
+		assert.ErrorIs(t, err, constant.ErrInvalidArgs)
+	})
+
+	t.Run("if invalid block tag, return ErrInvalidBlockTag", func(t *testing.T) {
+		// Act
+		_, err := coreNamespace.GetLogsRange(types.Filter{FromBlock: "hoge", ToBlock: "0x1"}, nil)
+
+		// Assert
+		assert.ErrorIs(t, err, constant.ErrInvalidBlockTag)
+	})
+
+	t.Run("if other error occurred, return it", func(t *testing.T) {
+		patches := gomonkey.NewPatches()
+		defer patches.Reset()
+
+		// Arrange
+		expectedErr := errors.New("error")
+
+		// Mock
+		patches.ApplyMethod(
+			reflect.TypeOf(api),
+			"GetLogs",
+			func(_ *ether.Ether, _ types.Filter) ([]types.LogResponse, error) {
+				return []types.LogResponse{}, expectedErr
+			},
+		)
+
+		// Act
+		actual, err := coreNamespace.GetLogsRange(types.Filter{FromBlock: "0x0", ToBlock: "0x9"}, &types.LogsRangeOption{ChunkSize: 2})
+
+		// Assert
+		assert.ErrorIs(t, err, expectedErr)
+		assert.Equal(t, []types.LogResponse{}, actual)
+	})
+}
+
 func TestCore_EstimateGas(t *testing.T) {
 	// Arrange
 	api := newEtherApi()
@@ -1458,7 +1676,7 @@ func TestCore_GetAssetTransfers(t *testing.T) {
 			)
 
 			actual, err := core.GetAssetTransfers(types.AssetTransfersParams{
-				Category: []string{"external"},
+				Category: []types.AssetTransferCategory{types.CategoryExternal},
 			})
 
 			assert.NoError(t, err)
@@ -1481,7 +1699,7 @@ func TestCore_GetAssetTransfers(t *testing.T) {
 			)
 
 			_, err := core.GetAssetTransfers(types.AssetTransfersParams{
-				Category: []string{"external"},
+				Category: []types.AssetTransferCategory{types.CategoryExternal},
 			})
 
 			assert.ErrorIs(t, err, expectedErr)
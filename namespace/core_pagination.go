@@ -0,0 +1,156 @@
+package namespace
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"iter"
+
+	"github.com/poteto-go/go-alchemy-sdk/constant"
+	"github.com/poteto-go/go-alchemy-sdk/internal"
+	"github.com/poteto-go/go-alchemy-sdk/types"
+)
+
+func (c *Core) IterAssetTransfers(params types.AssetTransfersParams, option *types.PageOption) iter.Seq2[types.AssetTransfer, error] {
+	return c.iterAssetTransfers(context.Background(), params, option)
+}
+
+func (c *Core) iterAssetTransfers(ctx context.Context, params types.AssetTransfersParams, option *types.PageOption) iter.Seq2[types.AssetTransfer, error] {
+	return paginate(ctx, params.PageKey, option, func(pageKey string) ([]types.AssetTransfer, string, error) {
+		page := params
+		page.PageKey = pageKey
+		response, err := c.ether.GetAssetTransfers(page)
+		return response.Transfers, response.PageKey, err
+	})
+}
+
+func (c *Core) CollectAssetTransfers(params types.AssetTransfersParams, option *types.PageOption) ([]types.AssetTransfer, error) {
+	return collect(c.IterAssetTransfers(params, option))
+}
+
+func (c *Core) IterTokenBalances(address string, option *types.TokenBalanceOption, pageOption *types.PageOption) iter.Seq2[types.TokenBalance, error] {
+	return c.iterTokenBalances(context.Background(), address, option, pageOption)
+}
+
+func (c *Core) iterTokenBalances(ctx context.Context, address string, option *types.TokenBalanceOption, pageOption *types.PageOption) iter.Seq2[types.TokenBalance, error] {
+	var pageKey string
+	maxCount := 0
+	if option != nil {
+		pageKey, maxCount = option.PageKey, option.MaxCount
+	}
+	return paginate(ctx, pageKey, pageOption, func(pageKey string) ([]types.TokenBalance, string, error) {
+		var (
+			response types.TokenBalanceResponse
+			err      error
+		)
+		if option != nil && len(option.ContractAddresses) > 0 {
+			response, err = c.ether.GetTokenBalances(address, option.ContractAddresses...)
+		} else {
+			response, err = c.ether.GetTokenBalancesPage(address, pageKey, maxCount)
+		}
+		return response.TokenBalances, response.PageKey, err
+	})
+}
+
+func (c *Core) CollectTokenBalances(address string, option *types.TokenBalanceOption, pageOption *types.PageOption) ([]types.TokenBalance, error) {
+	return collect(c.IterTokenBalances(address, option, pageOption))
+}
+
+/*
+paginate yields the items of the pages fetch returns, from pageKey on,
+until a page has no next key or option.MaxItems items are yielded.
+
+A page that fails with a retryable error (see internal.IsRetryableError) is
+fetched again with option.Backoff; its last error is yielded and ends the
+iteration, as do ctx being done and a page key the node already returned.
+*/
+func paginate[T any](
+	ctx context.Context,
+	pageKey string,
+	option *types.PageOption,
+	fetch func(pageKey string) (items []T, nextPageKey string, err error),
+) iter.Seq2[T, error] {
+	maxItems, backoff := 0, types.DefaultBackoffConfig
+	if option != nil {
+		maxItems = option.MaxItems
+		if option.Backoff != nil {
+			backoff = *option.Backoff
+		}
+	}
+
+	return func(yield func(T, error) bool) {
+		// each range starts over from the first page key
+		pageKey := pageKey
+		var zero T
+		yielded := 0
+		seen := map[string]bool{pageKey: true}
+		for {
+			if err := ctx.Err(); err != nil {
+				yield(zero, err)
+				return
+			}
+
+			items, next, err := fetchPage(ctx, backoff, pageKey, fetch)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+			for _, item := range items {
+				if maxItems > 0 && yielded == maxItems {
+					return
+				}
+				if !yield(item, nil) {
+					return
+				}
+				yielded++
+			}
+
+			if next == "" || (maxItems > 0 && yielded == maxItems) {
+				return
+			}
+			if seen[next] {
+				yield(zero, errors.Join(constant.ErrRepeatedPageKey, fmt.Errorf("page key %q", next)))
+				return
+			}
+			seen[next] = true
+			pageKey = next
+		}
+	}
+}
+
+func fetchPage[T any](
+	ctx context.Context,
+	config types.BackoffConfig,
+	pageKey string,
+	fetch func(pageKey string) ([]T, string, error),
+) ([]T, string, error) {
+	backoff := internal.NewBackoffManager(config)
+	for {
+		items, next, err := fetch(pageKey)
+		if err == nil {
+			return items, next, nil
+		}
+		if ctx.Err() != nil || !internal.IsRetryableError(err) {
+			return nil, "", err
+		}
+
+		if backoffErr := backoff.BackoffContext(ctx); backoffErr != nil {
+			if errors.Is(backoffErr, constant.ErrOverMaxRetries) {
+				return nil, "", err
+			}
+			return nil, "", backoffErr
+		}
+	}
+}
+
+// collect returns the items of seq, and the ones before its error with it.
+func collect[T any](seq iter.Seq2[T, error]) ([]T, error) {
+	items := []T{}
+	for item, err := range seq {
+		if err != nil {
+			return items, err
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
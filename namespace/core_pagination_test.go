@@ -0,0 +1,295 @@
+package namespace_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/poteto-go/go-alchemy-sdk/alchemymock"
+	"github.com/poteto-go/go-alchemy-sdk/constant"
+	"github.com/poteto-go/go-alchemy-sdk/gas"
+	"github.com/poteto-go/go-alchemy-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+var paginationSetting = gas.AlchemySetting{
+	ApiKey:  "hoge",
+	Network: "fuga",
+	BackoffConfig: &types.BackoffConfig{
+		MaxRetries: 0,
+	},
+}
+
+var pageRetry = &types.PageOption{
+	Backoff: &types.BackoffConfig{MaxRetries: 1, InitialDelayMs: 1, MaxDelayMs: 1},
+}
+
+func transfersPage(pageKey string, hashes ...string) string {
+	transfers := ""
+	for i, hash := range hashes {
+		if i > 0 {
+			transfers += ","
+		}
+		transfers += fmt.Sprintf(`{"hash":%q,"category":"external"}`, hash)
+	}
+	return fmt.Sprintf(`{"jsonrpc":"2.0","id":1,"result":{"transfers":[%s],"pageKey":%q}}`, transfers, pageKey)
+}
+
+func balancesPage(pageKey string, contracts ...string) string {
+	balances := ""
+	for i, contract := range contracts {
+		if i > 0 {
+			balances += ","
+		}
+		balances += fmt.Sprintf(`{"contractAddress":%q,"tokenBalance":"0x1"}`, contract)
+	}
+	return fmt.Sprintf(`{"jsonrpc":"2.0","id":1,"result":{"address":"0xabc","tokenBalances":[%s],"pageKey":%q}}`, balances, pageKey)
+}
+
+const pageFailure = `{"jsonrpc":"2.0","id":1,"error":{"code":-32005,"message":"limit exceeded"}}`
+
+func hashesOf(transfers []types.AssetTransfer) []string {
+	hashes := make([]string, len(transfers))
+	for i, transfer := range transfers {
+		hashes[i] = transfer.Hash
+	}
+	return hashes
+}
+
+func TestCore_IterAssetTransfers(t *testing.T) {
+	params := types.AssetTransfersParams{Category: []types.AssetTransferCategory{types.CategoryExternal}}
+
+	t.Run("follows the page keys to the last page", func(t *testing.T) {
+		// Arrange
+		mock := alchemymock.NewAlchemyHttpMock(paginationSetting, t)
+		defer mock.DeactivateAndReset()
+		mock.RegisterResponderOnce("alchemy_getAssetTransfers", transfersPage("p2", "0x1", "0x2"))
+		mock.RegisterResponderOnce("alchemy_getAssetTransfers", transfersPage("p3", "0x3"))
+		mock.RegisterResponderOnce("alchemy_getAssetTransfers", transfersPage("", "0x4"))
+		alchemy, _ := gas.NewAlchemy(paginationSetting)
+
+		// Act
+		hashes := []string{}
+		for transfer, err := range alchemy.Core.IterAssetTransfers(params, nil) {
+			assert.NoError(t, err)
+			hashes = append(hashes, transfer.Hash)
+		}
+
+		// Assert
+		assert.Equal(t, []string{"0x1", "0x2", "0x3", "0x4"}, hashes)
+	})
+
+	t.Run("ranging again starts over from the first page", func(t *testing.T) {
+		// Arrange
+		mock := alchemymock.NewAlchemyHttpMock(paginationSetting, t)
+		defer mock.DeactivateAndReset()
+		for range 2 {
+			mock.RegisterResponderOnce("alchemy_getAssetTransfers", transfersPage("p2", "0x1"))
+			mock.RegisterResponderOnce("alchemy_getAssetTransfers", transfersPage("", "0x2"))
+		}
+		alchemy, _ := gas.NewAlchemy(paginationSetting)
+		transfers := alchemy.Core.IterAssetTransfers(params, nil)
+
+		// Act
+		hashes := []string{}
+		for range 2 {
+			for transfer, err := range transfers {
+				assert.NoError(t, err)
+				hashes = append(hashes, transfer.Hash)
+			}
+		}
+
+		// Assert
+		assert.Equal(t, []string{"0x1", "0x2", "0x1", "0x2"}, hashes)
+	})
+
+	t.Run("stops when the caller breaks, without fetching the next page", func(t *testing.T) {
+		// Arrange
+		mock := alchemymock.NewAlchemyHttpMock(paginationSetting, t)
+		defer mock.DeactivateAndReset()
+		mock.RegisterResponderOnce("alchemy_getAssetTransfers", transfersPage("p2", "0x1", "0x2"))
+		alchemy, _ := gas.NewAlchemy(paginationSetting)
+
+		// Act
+		hashes := []string{}
+		for transfer := range alchemy.Core.IterAssetTransfers(params, nil) {
+			hashes = append(hashes, transfer.Hash)
+			break
+		}
+
+		// Assert
+		assert.Equal(t, []string{"0x1"}, hashes)
+	})
+
+	t.Run("retries a failed page", func(t *testing.T) {
+		// Arrange
+		mock := alchemymock.NewAlchemyHttpMock(paginationSetting, t)
+		defer mock.DeactivateAndReset()
+		mock.RegisterResponderOnce("alchemy_getAssetTransfers", transfersPage("p2", "0x1"))
+		mock.RegisterResponderOnce("alchemy_getAssetTransfers", pageFailure)
+		mock.RegisterResponderOnce("alchemy_getAssetTransfers", transfersPage("", "0x2"))
+		alchemy, _ := gas.NewAlchemy(paginationSetting)
+
+		// Act
+		transfers, err := alchemy.Core.CollectAssetTransfers(params, pageRetry)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"0x1", "0x2"}, hashesOf(transfers))
+	})
+
+	t.Run("if a page still fails, return the transfers so far & the error", func(t *testing.T) {
+		// Arrange
+		mock := alchemymock.NewAlchemyHttpMock(paginationSetting, t)
+		defer mock.DeactivateAndReset()
+		mock.RegisterResponderOnce("alchemy_getAssetTransfers", transfersPage("p2", "0x1"))
+		mock.RegisterResponderOnce("alchemy_getAssetTransfers", pageFailure)
+		mock.RegisterResponderOnce("alchemy_getAssetTransfers", pageFailure)
+		alchemy, _ := gas.NewAlchemy(paginationSetting)
+
+		// Act
+		transfers, err := alchemy.Core.CollectAssetTransfers(params, pageRetry)
+
+		// Assert
+		rpcErr, ok := errors.AsType[*types.RpcError](err)
+		assert.True(t, ok)
+		assert.Equal(t, "limit exceeded", rpcErr.Message)
+		assert.Equal(t, []string{"0x1"}, hashesOf(transfers))
+	})
+
+	t.Run("does not retry a page the node always rejects", func(t *testing.T) {
+		// Arrange
+		mock := alchemymock.NewAlchemyHttpMock(paginationSetting, t)
+		defer mock.DeactivateAndReset()
+		mock.RegisterResponderOnce("alchemy_getAssetTransfers", `{"jsonrpc":"2.0","id":1,"error":{"code":-32602,"message":"invalid params"}}`)
+		mock.RegisterResponderOnce("alchemy_getAssetTransfers", transfersPage("", "0x1"))
+		alchemy, _ := gas.NewAlchemy(paginationSetting)
+
+		// Act
+		transfers, err := alchemy.Core.CollectAssetTransfers(params, pageRetry)
+
+		// Assert
+		rpcErr, ok := errors.AsType[*types.RpcError](err)
+		assert.True(t, ok)
+		assert.Equal(t, -32602, rpcErr.Code)
+		assert.Empty(t, transfers)
+	})
+
+	t.Run("does not retry an unexpected response", func(t *testing.T) {
+		// Arrange
+		mock := alchemymock.NewAlchemyHttpMock(paginationSetting, t)
+		defer mock.DeactivateAndReset()
+		mock.RegisterResponderOnce("alchemy_getAssetTransfers", `{"jsonrpc":"2.0","id":1,"result":"0x1"}`)
+		mock.RegisterResponderOnce("alchemy_getAssetTransfers", transfersPage("", "0x1"))
+		alchemy, _ := gas.NewAlchemy(paginationSetting)
+
+		// Act
+		transfers, err := alchemy.Core.CollectAssetTransfers(params, pageRetry)
+
+		// Assert
+		assert.ErrorIs(t, err, constant.ErrUnexpectedResponseType)
+		assert.Empty(t, transfers)
+	})
+
+	t.Run("stops when the node returns a page key again", func(t *testing.T) {
+		// Arrange
+		mock := alchemymock.NewAlchemyHttpMock(paginationSetting, t)
+		defer mock.DeactivateAndReset()
+		mock.RegisterResponderOnce("alchemy_getAssetTransfers", transfersPage("p2", "0x1"))
+		mock.RegisterResponderOnce("alchemy_getAssetTransfers", transfersPage("p2", "0x2"))
+		mock.RegisterResponderOnce("alchemy_getAssetTransfers", transfersPage("", "0x3"))
+		alchemy, _ := gas.NewAlchemy(paginationSetting)
+
+		// Act
+		transfers, err := alchemy.Core.CollectAssetTransfers(params, nil)
+
+		// Assert
+		assert.ErrorIs(t, err, constant.ErrRepeatedPageKey)
+		assert.Equal(t, []string{"0x1", "0x2"}, hashesOf(transfers))
+	})
+
+	t.Run("collects up to MaxItems", func(t *testing.T) {
+		// Arrange
+		mock := alchemymock.NewAlchemyHttpMock(paginationSetting, t)
+		defer mock.DeactivateAndReset()
+		mock.RegisterResponderOnce("alchemy_getAssetTransfers", transfersPage("p2", "0x1", "0x2"))
+		mock.RegisterResponderOnce("alchemy_getAssetTransfers", transfersPage("p3", "0x3", "0x4"))
+		alchemy, _ := gas.NewAlchemy(paginationSetting)
+
+		// Act
+		transfers, err := alchemy.Core.CollectAssetTransfers(params, &types.PageOption{MaxItems: 3})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"0x1", "0x2", "0x3"}, hashesOf(transfers))
+	})
+
+	t.Run("if ctx is done, yield ctx.Err()", func(t *testing.T) {
+		// Arrange
+		mock := alchemymock.NewAlchemyHttpMock(paginationSetting, t)
+		defer mock.DeactivateAndReset()
+		mock.RegisterResponderOnce("alchemy_getAssetTransfers", transfersPage("p2", "0x1"))
+		alchemy, _ := gas.NewAlchemy(paginationSetting)
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		// Act
+		hashes := []string{}
+		var iterErr error
+		for transfer, err := range alchemy.CoreCtx.IterAssetTransfers(ctx, params, nil) {
+			if err != nil {
+				iterErr = err
+				break
+			}
+			hashes = append(hashes, transfer.Hash)
+			cancel()
+		}
+
+		// Assert
+		assert.ErrorIs(t, iterErr, context.Canceled)
+		assert.Equal(t, []string{"0x1"}, hashes)
+	})
+}
+
+func TestCore_IterTokenBalances(t *testing.T) {
+	t.Run("follows the page keys to the last page", func(t *testing.T) {
+		// Arrange
+		mock := alchemymock.NewAlchemyHttpMock(paginationSetting, t)
+		defer mock.DeactivateAndReset()
+		mock.RegisterResponderOnce("alchemy_getTokenBalances", balancesPage("p2", "0x1", "0x2"))
+		mock.RegisterResponderOnce("alchemy_getTokenBalances", pageFailure)
+		mock.RegisterResponderOnce("alchemy_getTokenBalances", balancesPage("", "0x3"))
+		alchemy, _ := gas.NewAlchemy(paginationSetting)
+
+		// Act
+		balances, err := alchemy.Core.CollectTokenBalances("0xabc", &types.TokenBalanceOption{MaxCount: 2}, pageRetry)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, []types.TokenBalance{
+			{ContractAddress: "0x1", TokenBalance: "0x1"},
+			{ContractAddress: "0x2", TokenBalance: "0x1"},
+			{ContractAddress: "0x3", TokenBalance: "0x1"},
+		}, balances)
+	})
+
+	t.Run("the balances of ContractAddresses are a single page", func(t *testing.T) {
+		// Arrange
+		mock := alchemymock.NewAlchemyHttpMock(paginationSetting, t)
+		defer mock.DeactivateAndReset()
+		mock.RegisterResponderOnce("alchemy_getTokenBalances", balancesPage("", "0x1"))
+		alchemy, _ := gas.NewAlchemy(paginationSetting)
+
+		// Act
+		balances, err := alchemy.Core.CollectTokenBalances(
+			"0xabc",
+			&types.TokenBalanceOption{ContractAddresses: []string{"0x1"}},
+			nil,
+		)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Len(t, balances, 1)
+	})
+}
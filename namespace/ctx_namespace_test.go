@@ -0,0 +1,182 @@
+package namespace_test
+
+import (
+	"context"
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/agiledragon/gomonkey"
+	"github.com/ethereum/go-ethereum"
+	"github.com/poteto-go/go-alchemy-sdk/ether"
+	"github.com/poteto-go/go-alchemy-sdk/namespace"
+	"github.com/poteto-go/go-alchemy-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+type ctxKey struct{}
+
+func callerCtx() context.Context {
+	return context.WithValue(context.Background(), ctxKey{}, "caller")
+}
+
+func TestCoreCtx(t *testing.T) {
+	t.Run("GetBlockNumber calls BlockNumberContext with the caller ctx", func(t *testing.T) {
+		patches := gomonkey.NewPatches()
+		defer patches.Reset()
+
+		// Arrange
+		api := newEtherApi()
+		core := namespace.NewCoreCtx(api)
+		var got any
+
+		// Mock
+		patches.ApplyMethod(
+			reflect.TypeOf(api),
+			"BlockNumberContext",
+			func(_ *ether.Ether, ctx context.Context) (uint64, error) {
+				got = ctx.Value(ctxKey{})
+				return 100, nil
+			},
+		)
+
+		// Act
+		blockNumber, err := core.GetBlockNumber(callerCtx())
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, uint64(100), blockNumber)
+		assert.Equal(t, "caller", got)
+	})
+
+	t.Run("GetBlock keeps ICore argument handling", func(t *testing.T) {
+		// Arrange
+		core := namespace.NewCoreCtx(newEtherApi())
+
+		// Act
+		_, err := core.GetBlock(callerCtx(), types.BlockTagOrHash{})
+
+		// Assert
+		assert.Error(t, err)
+	})
+
+	t.Run("GetLogs calls GetLogsContext with the caller ctx", func(t *testing.T) {
+		patches := gomonkey.NewPatches()
+		defer patches.Reset()
+
+		// Arrange
+		api := newEtherApi()
+		core := namespace.NewCoreCtx(api)
+		var got any
+
+		// Mock
+		patches.ApplyMethod(
+			reflect.TypeOf(api),
+			"GetLogsContext",
+			func(_ *ether.Ether, ctx context.Context, _ types.Filter) ([]types.LogResponse, error) {
+				got = ctx.Value(ctxKey{})
+				return []types.LogResponse{}, nil
+			},
+		)
+
+		// Act
+		_, err := core.GetLogs(callerCtx(), types.Filter{})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "caller", got)
+	})
+}
+
+func TestERC20Ctx_BalanceOf(t *testing.T) {
+	patches := gomonkey.NewPatches()
+	defer patches.Reset()
+
+	// Arrange
+	eth := newEtherApi()
+	erc20 := namespace.NewERC20CtxNamespace(eth)
+	var got any
+
+	// Mock
+	patches.ApplyMethod(
+		reflect.TypeOf(eth),
+		"CallContractContext",
+		func(_ *ether.Ether, ctx context.Context, _ ethereum.CallMsg, _ string) ([]byte, error) {
+			got = ctx.Value(ctxKey{})
+			return big.NewInt(1).Bytes(), nil
+		},
+	)
+
+	// Act
+	balance, err := erc20.BalanceOf(
+		callerCtx(),
+		"0x1234567890abcdef1234567890abcdef12345678",
+		"0xabcdef1234567890abcdef1234567890abcdef12",
+	)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 0, balance.Cmp(big.NewInt(1)))
+	assert.Equal(t, "caller", got)
+}
+
+func TestErc1155Ctx_IsApprovedForAll(t *testing.T) {
+	patches := gomonkey.NewPatches()
+	defer patches.Reset()
+
+	// Arrange
+	eth := newEtherApi()
+	erc1155 := namespace.NewErc1155CtxNamespace(eth)
+	var got any
+
+	// Mock
+	patches.ApplyMethod(
+		reflect.TypeOf(eth),
+		"CallContractContext",
+		func(_ *ether.Ether, ctx context.Context, _ ethereum.CallMsg, _ string) ([]byte, error) {
+			got = ctx.Value(ctxKey{})
+			return big.NewInt(1).FillBytes(make([]byte, 32)), nil
+		},
+	)
+
+	// Act
+	approved, err := erc1155.IsApprovedForAll(
+		callerCtx(),
+		"0x1234567890abcdef1234567890abcdef12345678",
+		"0xabcdef1234567890abcdef1234567890abcdef12",
+		"0xabcdef1234567890abcdef1234567890abcdef13",
+	)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.True(t, approved)
+	assert.Equal(t, "caller", got)
+}
+
+func TestDebugCtx_Snapshot(t *testing.T) {
+	patches := gomonkey.NewPatches()
+	defer patches.Reset()
+
+	// Arrange
+	eth := newEtherApi()
+	debug := namespace.NewDebugCtxNamespace(eth)
+	var got any
+
+	// Mock
+	patches.ApplyMethod(
+		reflect.TypeOf(eth),
+		"SnapshotContext",
+		func(_ *ether.Ether, ctx context.Context) (*big.Int, error) {
+			got = ctx.Value(ctxKey{})
+			return big.NewInt(1), nil
+		},
+	)
+
+	// Act
+	snapshotId, err := debug.Snapshot(callerCtx())
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, 0, snapshotId.Cmp(big.NewInt(1)))
+	assert.Equal(t, "caller", got)
+}
@@ -0,0 +1,32 @@
+package namespace
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/poteto-go/go-alchemy-sdk/types"
+)
+
+// IDebugCtx is IDebug with a caller supplied ctx as the first argument.
+type IDebugCtx interface {
+	Snapshot(ctx context.Context) (*big.Int, error)
+	RevertTo(ctx context.Context, snapshotId *big.Int) (bool, error)
+}
+
+type DebugCtx struct {
+	ether types.EtherApi
+}
+
+func NewDebugCtxNamespace(ether types.EtherApi) IDebugCtx {
+	return &DebugCtx{
+		ether: ether,
+	}
+}
+
+func (d *DebugCtx) Snapshot(ctx context.Context) (*big.Int, error) {
+	return (&Debug{ether: withContext(ctx, d.ether)}).Snapshot()
+}
+
+func (d *DebugCtx) RevertTo(ctx context.Context, snapshotId *big.Int) (bool, error) {
+	return (&Debug{ether: withContext(ctx, d.ether)}).RevertTo(snapshotId)
+}
@@ -0,0 +1,44 @@
+package namespace
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/poteto-go/go-alchemy-sdk/types"
+)
+
+// IErc1155Ctx is IErc1155 with a caller supplied ctx as the first argument.
+type IErc1155Ctx interface {
+	iApprovedForAllCtx
+
+	BalanceOfToken(ctx context.Context, contractAddress, account string, tokenId *big.Int) (*big.Int, error)
+	BalanceOfBatch(ctx context.Context, contractAddress string, accounts []string, tokenIds []*big.Int) ([]*big.Int, error)
+	Uri(ctx context.Context, contractAddress string, tokenId *big.Int) (string, error)
+}
+
+// Erc1155Ctx embeds *NftCtx to reuse its IsApprovedForAll implementation.
+type Erc1155Ctx struct {
+	*NftCtx
+}
+
+func NewErc1155CtxNamespace(ether types.EtherApi) IErc1155Ctx {
+	return &Erc1155Ctx{
+		NftCtx: &NftCtx{ether: ether},
+	}
+}
+
+func (e *Erc1155Ctx) erc1155(ctx context.Context) *Erc1155 {
+	return &Erc1155{Nft: e.nft(ctx)}
+}
+
+func (e *Erc1155Ctx) BalanceOfToken(ctx context.Context, contractAddress, account string, tokenId *big.Int) (*big.Int, error) {
+	return e.erc1155(ctx).BalanceOfToken(contractAddress, account, tokenId)
+}
+
+func (e *Erc1155Ctx) BalanceOfBatch(ctx context.Context, contractAddress string, accounts []string, tokenIds []*big.Int) ([]*big.Int, error) {
+	return e.erc1155(ctx).BalanceOfBatch(contractAddress, accounts, tokenIds)
+}
+
+func (e *Erc1155Ctx) Uri(ctx context.Context, contractAddress string, tokenId *big.Int) (string, error) {
+	return e.erc1155(ctx).Uri(contractAddress, tokenId)
+}
@@ -1,6 +1,7 @@
 package namespace_test
 
 import (
+	"context"
 	"math/big"
 	"reflect"
 	"testing"
@@ -37,7 +38,7 @@ func TestErc1155_BalanceOfToken(t *testing.T) {
 		eth := newEtherApi()
 		erc1155 := namespace.NewErc1155Namespace(eth)
 
-		patches.ApplyMethod(reflect.TypeOf(eth), "CallContract", func(_ *ether.Ether, _ ethereum.CallMsg, _ string) ([]byte, error) {
+		patches.ApplyMethod(reflect.TypeOf(eth), "CallContractContext", func(_ *ether.Ether, _ context.Context, _ ethereum.CallMsg, _ string) ([]byte, error) {
 			return encode.ABIUint256(big.NewInt(42)), nil
 		})
 
@@ -54,7 +55,7 @@ func TestErc1155_BalanceOfToken(t *testing.T) {
 		eth := newEtherApi()
 		erc1155 := namespace.NewErc1155Namespace(eth)
 
-		patches.ApplyMethod(reflect.TypeOf(eth), "CallContract", func(_ *ether.Ether, _ ethereum.CallMsg, _ string) ([]byte, error) {
+		patches.ApplyMethod(reflect.TypeOf(eth), "CallContractContext", func(_ *ether.Ether, _ context.Context, _ ethereum.CallMsg, _ string) ([]byte, error) {
 			return nil, assert.AnError
 		})
 
@@ -115,7 +116,7 @@ func TestErc1155_BalanceOfBatch(t *testing.T) {
 		eth := newEtherApi()
 		erc1155 := namespace.NewErc1155Namespace(eth)
 
-		patches.ApplyMethod(reflect.TypeOf(eth), "CallContract", func(_ *ether.Ether, _ ethereum.CallMsg, _ string) ([]byte, error) {
+		patches.ApplyMethod(reflect.TypeOf(eth), "CallContractContext", func(_ *ether.Ether, _ context.Context, _ ethereum.CallMsg, _ string) ([]byte, error) {
 			// offset(0x20) + length(2) + items.
 			out := encode.ABIUint256(big.NewInt(constant.ABIWordSize))
 			out = append(out, encode.ABIUint256Array([]*big.Int{big.NewInt(10), big.NewInt(20)})...)
@@ -137,7 +138,7 @@ func TestErc1155_BalanceOfBatch(t *testing.T) {
 		eth := newEtherApi()
 		erc1155 := namespace.NewErc1155Namespace(eth)
 
-		patches.ApplyMethod(reflect.TypeOf(eth), "CallContract", func(_ *ether.Ether, _ ethereum.CallMsg, _ string) ([]byte, error) {
+		patches.ApplyMethod(reflect.TypeOf(eth), "CallContractContext", func(_ *ether.Ether, _ context.Context, _ ethereum.CallMsg, _ string) ([]byte, error) {
 			// requests 2 balances but the response only carries 1 element.
 			out := encode.ABIUint256(big.NewInt(constant.ABIWordSize))
 			out = append(out, encode.ABIUint256Array([]*big.Int{big.NewInt(10)})...)
@@ -156,7 +157,7 @@ func TestErc1155_BalanceOfBatch(t *testing.T) {
 		eth := newEtherApi()
 		erc1155 := namespace.NewErc1155Namespace(eth)
 
-		patches.ApplyMethod(reflect.TypeOf(eth), "CallContract", func(_ *ether.Ether, _ ethereum.CallMsg, _ string) ([]byte, error) {
+		patches.ApplyMethod(reflect.TypeOf(eth), "CallContractContext", func(_ *ether.Ether, _ context.Context, _ ethereum.CallMsg, _ string) ([]byte, error) {
 			return nil, assert.AnError
 		})
 
@@ -214,7 +215,7 @@ func TestErc1155_Uri(t *testing.T) {
 		erc1155 := namespace.NewErc1155Namespace(eth)
 		expected := "https://example.com/erc1155/{id}.json"
 
-		patches.ApplyMethod(reflect.TypeOf(eth), "CallContract", func(_ *ether.Ether, _ ethereum.CallMsg, _ string) ([]byte, error) {
+		patches.ApplyMethod(reflect.TypeOf(eth), "CallContractContext", func(_ *ether.Ether, _ context.Context, _ ethereum.CallMsg, _ string) ([]byte, error) {
 			return encode.ABIString(expected), nil
 		})
 
@@ -231,7 +232,7 @@ func TestErc1155_Uri(t *testing.T) {
 		eth := newEtherApi()
 		erc1155 := namespace.NewErc1155Namespace(eth)
 
-		patches.ApplyMethod(reflect.TypeOf(eth), "CallContract", func(_ *ether.Ether, _ ethereum.CallMsg, _ string) ([]byte, error) {
+		patches.ApplyMethod(reflect.TypeOf(eth), "CallContractContext", func(_ *ether.Ether, _ context.Context, _ ethereum.CallMsg, _ string) ([]byte, error) {
 			return nil, assert.AnError
 		})
 
@@ -0,0 +1,56 @@
+package namespace
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/poteto-go/go-alchemy-sdk/types"
+)
+
+// IERC20Ctx is IERC20 with a caller supplied ctx as the first argument.
+type IERC20Ctx interface {
+	BalanceOf(ctx context.Context, contractAddress, walletAddress string) (*big.Int, error)
+	TotalSupply(ctx context.Context, contractAddress string) (*big.Int, error)
+	Allowance(ctx context.Context, contractAddress, owner, spender string) (*big.Int, error)
+	Name(ctx context.Context, contractAddress string) (string, error)
+	Symbol(ctx context.Context, contractAddress string) (string, error)
+	Decimals(ctx context.Context, contractAddress string) (uint8, error)
+}
+
+type ERC20Ctx struct {
+	ether types.EtherApi
+}
+
+func NewERC20CtxNamespace(ether types.EtherApi) IERC20Ctx {
+	return &ERC20Ctx{
+		ether: ether,
+	}
+}
+
+func (e *ERC20Ctx) erc20(ctx context.Context) *ERC20 {
+	return &ERC20{ether: withContext(ctx, e.ether)}
+}
+
+func (e *ERC20Ctx) BalanceOf(ctx context.Context, contractAddress, walletAddress string) (*big.Int, error) {
+	return e.erc20(ctx).BalanceOf(contractAddress, walletAddress)
+}
+
+func (e *ERC20Ctx) TotalSupply(ctx context.Context, contractAddress string) (*big.Int, error) {
+	return e.erc20(ctx).TotalSupply(contractAddress)
+}
+
+func (e *ERC20Ctx) Allowance(ctx context.Context, contractAddress, owner, spender string) (*big.Int, error) {
+	return e.erc20(ctx).Allowance(contractAddress, owner, spender)
+}
+
+func (e *ERC20Ctx) Name(ctx context.Context, contractAddress string) (string, error) {
+	return e.erc20(ctx).Name(contractAddress)
+}
+
+func (e *ERC20Ctx) Symbol(ctx context.Context, contractAddress string) (string, error) {
+	return e.erc20(ctx).Symbol(contractAddress)
+}
+
+func (e *ERC20Ctx) Decimals(ctx context.Context, contractAddress string) (uint8, error) {
+	return e.erc20(ctx).Decimals(contractAddress)
+}
@@ -1,6 +1,7 @@
 package namespace_test
 
 import (
+	"context"
 	"math/big"
 	"reflect"
 	"testing"
@@ -37,7 +38,7 @@ func TestERC20_BalanceOf(t *testing.T) {
 		erc20 := namespace.NewERC20Namespace(eth)
 		expected := big.NewInt(1)
 
-		patches.ApplyMethod(reflect.TypeOf(eth), "CallContract", func(_ *ether.Ether, _ ethereum.CallMsg, _ string) ([]byte, error) {
+		patches.ApplyMethod(reflect.TypeOf(eth), "CallContractContext", func(_ *ether.Ether, _ context.Context, _ ethereum.CallMsg, _ string) ([]byte, error) {
 			return expected.Bytes(), nil
 		})
 
@@ -54,7 +55,7 @@ func TestERC20_BalanceOf(t *testing.T) {
 		eth := newEtherApi()
 		erc20 := namespace.NewERC20Namespace(eth)
 
-		patches.ApplyMethod(reflect.TypeOf(eth), "CallContract", func(_ *ether.Ether, _ ethereum.CallMsg, _ string) ([]byte, error) {
+		patches.ApplyMethod(reflect.TypeOf(eth), "CallContractContext", func(_ *ether.Ether, _ context.Context, _ ethereum.CallMsg, _ string) ([]byte, error) {
 			return nil, assert.AnError
 		})
 
@@ -93,7 +94,7 @@ func TestERC20_TotalSupply(t *testing.T) {
 		erc20 := namespace.NewERC20Namespace(eth)
 		expected := big.NewInt(1000)
 
-		patches.ApplyMethod(reflect.TypeOf(eth), "CallContract", func(_ *ether.Ether, _ ethereum.CallMsg, _ string) ([]byte, error) {
+		patches.ApplyMethod(reflect.TypeOf(eth), "CallContractContext", func(_ *ether.Ether, _ context.Context, _ ethereum.CallMsg, _ string) ([]byte, error) {
 			return expected.Bytes(), nil
 		})
 
@@ -108,7 +109,7 @@ func TestERC20_TotalSupply(t *testing.T) {
 		eth := newEtherApi()
 		erc20 := namespace.NewERC20Namespace(eth)
 
-		patches.ApplyMethod(reflect.TypeOf(eth), "CallContract", func(_ *ether.Ether, _ ethereum.CallMsg, _ string) ([]byte, error) {
+		patches.ApplyMethod(reflect.TypeOf(eth), "CallContractContext", func(_ *ether.Ether, _ context.Context, _ ethereum.CallMsg, _ string) ([]byte, error) {
 			return nil, assert.AnError
 		})
 
@@ -138,7 +139,7 @@ func TestERC20_Allowance(t *testing.T) {
 		erc20 := namespace.NewERC20Namespace(eth)
 		expected := big.NewInt(500)
 
-		patches.ApplyMethod(reflect.TypeOf(eth), "CallContract", func(_ *ether.Ether, _ ethereum.CallMsg, _ string) ([]byte, error) {
+		patches.ApplyMethod(reflect.TypeOf(eth), "CallContractContext", func(_ *ether.Ether, _ context.Context, _ ethereum.CallMsg, _ string) ([]byte, error) {
 			return expected.Bytes(), nil
 		})
 
@@ -153,7 +154,7 @@ func TestERC20_Allowance(t *testing.T) {
 		eth := newEtherApi()
 		erc20 := namespace.NewERC20Namespace(eth)
 
-		patches.ApplyMethod(reflect.TypeOf(eth), "CallContract", func(_ *ether.Ether, _ ethereum.CallMsg, _ string) ([]byte, error) {
+		patches.ApplyMethod(reflect.TypeOf(eth), "CallContractContext", func(_ *ether.Ether, _ context.Context, _ ethereum.CallMsg, _ string) ([]byte, error) {
 			return nil, assert.AnError
 		})
 
@@ -199,7 +200,7 @@ func TestERC20_Name(t *testing.T) {
 		erc20 := namespace.NewERC20Namespace(eth)
 		expected := "TestToken"
 
-		patches.ApplyMethod(reflect.TypeOf(eth), "CallContract", func(_ *ether.Ether, _ ethereum.CallMsg, _ string) ([]byte, error) {
+		patches.ApplyMethod(reflect.TypeOf(eth), "CallContractContext", func(_ *ether.Ether, _ context.Context, _ ethereum.CallMsg, _ string) ([]byte, error) {
 			return encode.ABIString(expected), nil
 		})
 
@@ -214,7 +215,7 @@ func TestERC20_Name(t *testing.T) {
 		eth := newEtherApi()
 		erc20 := namespace.NewERC20Namespace(eth)
 
-		patches.ApplyMethod(reflect.TypeOf(eth), "CallContract", func(_ *ether.Ether, _ ethereum.CallMsg, _ string) ([]byte, error) {
+		patches.ApplyMethod(reflect.TypeOf(eth), "CallContractContext", func(_ *ether.Ether, _ context.Context, _ ethereum.CallMsg, _ string) ([]byte, error) {
 			return nil, assert.AnError
 		})
 
@@ -242,7 +243,7 @@ func TestERC20_Symbol(t *testing.T) {
 		erc20 := namespace.NewERC20Namespace(eth)
 		expected := "TEST"
 
-		patches.ApplyMethod(reflect.TypeOf(eth), "CallContract", func(_ *ether.Ether, _ ethereum.CallMsg, _ string) ([]byte, error) {
+		patches.ApplyMethod(reflect.TypeOf(eth), "CallContractContext", func(_ *ether.Ether, _ context.Context, _ ethereum.CallMsg, _ string) ([]byte, error) {
 			return encode.ABIString(expected), nil
 		})
 
@@ -257,7 +258,7 @@ func TestERC20_Symbol(t *testing.T) {
 		eth := newEtherApi()
 		erc20 := namespace.NewERC20Namespace(eth)
 
-		patches.ApplyMethod(reflect.TypeOf(eth), "CallContract", func(_ *ether.Ether, _ ethereum.CallMsg, _ string) ([]byte, error) {
+		patches.ApplyMethod(reflect.TypeOf(eth), "CallContractContext", func(_ *ether.Ether, _ context.Context, _ ethereum.CallMsg, _ string) ([]byte, error) {
 			return nil, assert.AnError
 		})
 
@@ -285,7 +286,7 @@ func TestERC20_Decimals(t *testing.T) {
 		erc20 := namespace.NewERC20Namespace(eth)
 		expected := uint8(18)
 
-		patches.ApplyMethod(reflect.TypeOf(eth), "CallContract", func(_ *ether.Ether, _ ethereum.CallMsg, _ string) ([]byte, error) {
+		patches.ApplyMethod(reflect.TypeOf(eth), "CallContractContext", func(_ *ether.Ether, _ context.Context, _ ethereum.CallMsg, _ string) ([]byte, error) {
 			return big.NewInt(int64(expected)).Bytes(), nil
 		})
 
@@ -300,7 +301,7 @@ func TestERC20_Decimals(t *testing.T) {
 		eth := newEtherApi()
 		erc20 := namespace.NewERC20Namespace(eth)
 
-		patches.ApplyMethod(reflect.TypeOf(eth), "CallContract", func(_ *ether.Ether, _ ethereum.CallMsg, _ string) ([]byte, error) {
+		patches.ApplyMethod(reflect.TypeOf(eth), "CallContractContext", func(_ *ether.Ether, _ context.Context, _ ethereum.CallMsg, _ string) ([]byte, error) {
 			return nil, assert.AnError
 		})
 
@@ -316,7 +317,7 @@ func TestERC20_Decimals(t *testing.T) {
 
 		// 2^64+5: Uint64() silently truncates to 5, bypassing the >255 check
 		val := new(big.Int).Add(new(big.Int).Lsh(big.NewInt(1), 64), big.NewInt(5))
-		patches.ApplyMethod(reflect.TypeOf(eth), "CallContract", func(_ *ether.Ether, _ ethereum.CallMsg, _ string) ([]byte, error) {
+		patches.ApplyMethod(reflect.TypeOf(eth), "CallContractContext", func(_ *ether.Ether, _ context.Context, _ ethereum.CallMsg, _ string) ([]byte, error) {
 			return val.Bytes(), nil
 		})
 
@@ -0,0 +1,66 @@
+package namespace
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/poteto-go/go-alchemy-sdk/types"
+)
+
+type iApprovedForAllCtx interface {
+	IsApprovedForAll(ctx context.Context, contractAddress, owner, operator string) (bool, error)
+}
+
+// INftCtx is INft with a caller supplied ctx as the first argument.
+type INftCtx interface {
+	iApprovedForAllCtx
+
+	BalanceOf(ctx context.Context, contractAddress, owner string) (*big.Int, error)
+	OwnerOf(ctx context.Context, contractAddress string, tokenId *big.Int) (string, error)
+	TokenURI(ctx context.Context, contractAddress string, tokenId *big.Int) (string, error)
+	Name(ctx context.Context, contractAddress string) (string, error)
+	Symbol(ctx context.Context, contractAddress string) (string, error)
+	GetApproved(ctx context.Context, contractAddress string, tokenId *big.Int) (string, error)
+}
+
+type NftCtx struct {
+	ether types.EtherApi
+}
+
+func NewNftCtxNamespace(ether types.EtherApi) INftCtx {
+	return &NftCtx{
+		ether: ether,
+	}
+}
+
+func (n *NftCtx) nft(ctx context.Context) *Nft {
+	return &Nft{ether: withContext(ctx, n.ether)}
+}
+
+func (n *NftCtx) IsApprovedForAll(ctx context.Context, contractAddress, owner, operator string) (bool, error) {
+	return n.nft(ctx).IsApprovedForAll(contractAddress, owner, operator)
+}
+
+func (n *NftCtx) BalanceOf(ctx context.Context, contractAddress, owner string) (*big.Int, error) {
+	return n.nft(ctx).BalanceOf(contractAddress, owner)
+}
+
+func (n *NftCtx) OwnerOf(ctx context.Context, contractAddress string, tokenId *big.Int) (string, error) {
+	return n.nft(ctx).OwnerOf(contractAddress, tokenId)
+}
+
+func (n *NftCtx) TokenURI(ctx context.Context, contractAddress string, tokenId *big.Int) (string, error) {
+	return n.nft(ctx).TokenURI(contractAddress, tokenId)
+}
+
+func (n *NftCtx) Name(ctx context.Context, contractAddress string) (string, error) {
+	return n.nft(ctx).Name(contractAddress)
+}
+
+func (n *NftCtx) Symbol(ctx context.Context, contractAddress string) (string, error) {
+	return n.nft(ctx).Symbol(contractAddress)
+}
+
+func (n *NftCtx) GetApproved(ctx context.Context, contractAddress string, tokenId *big.Int) (string, error) {
+	return n.nft(ctx).GetApproved(contractAddress, tokenId)
+}
@@ -1,6 +1,7 @@
 package namespace_test
 
 import (
+	"context"
 	"math/big"
 	"reflect"
 	"testing"
@@ -36,7 +37,7 @@ func TestNft_BalanceOf(t *testing.T) {
 		eth := newEtherApi()
 		nft := namespace.NewNftNamespace(eth)
 
-		patches.ApplyMethod(reflect.TypeOf(eth), "CallContract", func(_ *ether.Ether, _ ethereum.CallMsg, _ string) ([]byte, error) {
+		patches.ApplyMethod(reflect.TypeOf(eth), "CallContractContext", func(_ *ether.Ether, _ context.Context, _ ethereum.CallMsg, _ string) ([]byte, error) {
 			return encode.ABIUint256(big.NewInt(5)), nil
 		})
 
@@ -53,7 +54,7 @@ func TestNft_BalanceOf(t *testing.T) {
 		eth := newEtherApi()
 		nft := namespace.NewNftNamespace(eth)
 
-		patches.ApplyMethod(reflect.TypeOf(eth), "CallContract", func(_ *ether.Ether, _ ethereum.CallMsg, _ string) ([]byte, error) {
+		patches.ApplyMethod(reflect.TypeOf(eth), "CallContractContext", func(_ *ether.Ether, _ context.Context, _ ethereum.CallMsg, _ string) ([]byte, error) {
 			return nil, assert.AnError
 		})
 
@@ -93,7 +94,7 @@ func TestNft_OwnerOf(t *testing.T) {
 		eth := newEtherApi()
 		nft := namespace.NewNftNamespace(eth)
 
-		patches.ApplyMethod(reflect.TypeOf(eth), "CallContract", func(_ *ether.Ether, _ ethereum.CallMsg, _ string) ([]byte, error) {
+		patches.ApplyMethod(reflect.TypeOf(eth), "CallContractContext", func(_ *ether.Ether, _ context.Context, _ ethereum.CallMsg, _ string) ([]byte, error) {
 			return encode.ABIAddress(expectedOwner), nil
 		})
 
@@ -110,7 +111,7 @@ func TestNft_OwnerOf(t *testing.T) {
 		eth := newEtherApi()
 		nft := namespace.NewNftNamespace(eth)
 
-		patches.ApplyMethod(reflect.TypeOf(eth), "CallContract", func(_ *ether.Ether, _ ethereum.CallMsg, _ string) ([]byte, error) {
+		patches.ApplyMethod(reflect.TypeOf(eth), "CallContractContext", func(_ *ether.Ether, _ context.Context, _ ethereum.CallMsg, _ string) ([]byte, error) {
 			return nil, assert.AnError
 		})
 
@@ -169,7 +170,7 @@ func TestNft_TokenURI(t *testing.T) {
 		nft := namespace.NewNftNamespace(eth)
 		expected := "https://example.com/token/1"
 
-		patches.ApplyMethod(reflect.TypeOf(eth), "CallContract", func(_ *ether.Ether, _ ethereum.CallMsg, _ string) ([]byte, error) {
+		patches.ApplyMethod(reflect.TypeOf(eth), "CallContractContext", func(_ *ether.Ether, _ context.Context, _ ethereum.CallMsg, _ string) ([]byte, error) {
 			return encode.ABIString(expected), nil
 		})
 
@@ -186,7 +187,7 @@ func TestNft_TokenURI(t *testing.T) {
 		eth := newEtherApi()
 		nft := namespace.NewNftNamespace(eth)
 
-		patches.ApplyMethod(reflect.TypeOf(eth), "CallContract", func(_ *ether.Ether, _ ethereum.CallMsg, _ string) ([]byte, error) {
+		patches.ApplyMethod(reflect.TypeOf(eth), "CallContractContext", func(_ *ether.Ether, _ context.Context, _ ethereum.CallMsg, _ string) ([]byte, error) {
 			return nil, assert.AnError
 		})
 
@@ -234,7 +235,7 @@ func TestNft_Name(t *testing.T) {
 		nft := namespace.NewNftNamespace(eth)
 		expected := "MyNFT"
 
-		patches.ApplyMethod(reflect.TypeOf(eth), "CallContract", func(_ *ether.Ether, _ ethereum.CallMsg, _ string) ([]byte, error) {
+		patches.ApplyMethod(reflect.TypeOf(eth), "CallContractContext", func(_ *ether.Ether, _ context.Context, _ ethereum.CallMsg, _ string) ([]byte, error) {
 			return encode.ABIString(expected), nil
 		})
 
@@ -251,7 +252,7 @@ func TestNft_Name(t *testing.T) {
 		eth := newEtherApi()
 		nft := namespace.NewNftNamespace(eth)
 
-		patches.ApplyMethod(reflect.TypeOf(eth), "CallContract", func(_ *ether.Ether, _ ethereum.CallMsg, _ string) ([]byte, error) {
+		patches.ApplyMethod(reflect.TypeOf(eth), "CallContractContext", func(_ *ether.Ether, _ context.Context, _ ethereum.CallMsg, _ string) ([]byte, error) {
 			return nil, assert.AnError
 		})
 
@@ -281,7 +282,7 @@ func TestNft_Symbol(t *testing.T) {
 		nft := namespace.NewNftNamespace(eth)
 		expected := "MNFT"
 
-		patches.ApplyMethod(reflect.TypeOf(eth), "CallContract", func(_ *ether.Ether, _ ethereum.CallMsg, _ string) ([]byte, error) {
+		patches.ApplyMethod(reflect.TypeOf(eth), "CallContractContext", func(_ *ether.Ether, _ context.Context, _ ethereum.CallMsg, _ string) ([]byte, error) {
 			return encode.ABIString(expected), nil
 		})
 
@@ -298,7 +299,7 @@ func TestNft_Symbol(t *testing.T) {
 		eth := newEtherApi()
 		nft := namespace.NewNftNamespace(eth)
 
-		patches.ApplyMethod(reflect.TypeOf(eth), "CallContract", func(_ *ether.Ether, _ ethereum.CallMsg, _ string) ([]byte, error) {
+		patches.ApplyMethod(reflect.TypeOf(eth), "CallContractContext", func(_ *ether.Ether, _ context.Context, _ ethereum.CallMsg, _ string) ([]byte, error) {
 			return nil, assert.AnError
 		})
 
@@ -329,7 +330,7 @@ func TestNft_GetApproved(t *testing.T) {
 		eth := newEtherApi()
 		nft := namespace.NewNftNamespace(eth)
 
-		patches.ApplyMethod(reflect.TypeOf(eth), "CallContract", func(_ *ether.Ether, _ ethereum.CallMsg, _ string) ([]byte, error) {
+		patches.ApplyMethod(reflect.TypeOf(eth), "CallContractContext", func(_ *ether.Ether, _ context.Context, _ ethereum.CallMsg, _ string) ([]byte, error) {
 			return encode.ABIAddress(expectedApproved), nil
 		})
 
@@ -346,7 +347,7 @@ func TestNft_GetApproved(t *testing.T) {
 		eth := newEtherApi()
 		nft := namespace.NewNftNamespace(eth)
 
-		patches.ApplyMethod(reflect.TypeOf(eth), "CallContract", func(_ *ether.Ether, _ ethereum.CallMsg, _ string) ([]byte, error) {
+		patches.ApplyMethod(reflect.TypeOf(eth), "CallContractContext", func(_ *ether.Ether, _ context.Context, _ ethereum.CallMsg, _ string) ([]byte, error) {
 			return nil, assert.AnError
 		})
 
@@ -395,7 +396,7 @@ func TestNft_IsApprovedForAll(t *testing.T) {
 		eth := newEtherApi()
 		nft := namespace.NewNftNamespace(eth)
 
-		patches.ApplyMethod(reflect.TypeOf(eth), "CallContract", func(_ *ether.Ether, _ ethereum.CallMsg, _ string) ([]byte, error) {
+		patches.ApplyMethod(reflect.TypeOf(eth), "CallContractContext", func(_ *ether.Ether, _ context.Context, _ ethereum.CallMsg, _ string) ([]byte, error) {
 			result := make([]byte, 32)
 			result[31] = 0x01
 			return result, nil
@@ -414,7 +415,7 @@ func TestNft_IsApprovedForAll(t *testing.T) {
 		eth := newEtherApi()
 		nft := namespace.NewNftNamespace(eth)
 
-		patches.ApplyMethod(reflect.TypeOf(eth), "CallContract", func(_ *ether.Ether, _ ethereum.CallMsg, _ string) ([]byte, error) {
+		patches.ApplyMethod(reflect.TypeOf(eth), "CallContractContext", func(_ *ether.Ether, _ context.Context, _ ethereum.CallMsg, _ string) ([]byte, error) {
 			return make([]byte, 32), nil
 		})
 
@@ -431,7 +432,7 @@ func TestNft_IsApprovedForAll(t *testing.T) {
 		eth := newEtherApi()
 		nft := namespace.NewNftNamespace(eth)
 
-		patches.ApplyMethod(reflect.TypeOf(eth), "CallContract", func(_ *ether.Ether, _ ethereum.CallMsg, _ string) ([]byte, error) {
+		patches.ApplyMethod(reflect.TypeOf(eth), "CallContractContext", func(_ *ether.Ether, _ context.Context, _ ethereum.CallMsg, _ string) ([]byte, error) {
 			return nil, assert.AnError
 		})
 
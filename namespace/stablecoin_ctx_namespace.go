@@ -0,0 +1,92 @@
+package namespace
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/poteto-go/go-alchemy-sdk/types"
+)
+
+// IStableCoinCtx is IStableCoin with a caller supplied ctx as the first argument.
+type IStableCoinCtx interface {
+	IERC20Ctx
+
+	IsBlacklisted(ctx context.Context, contractAddress, address string) (bool, error)
+	Paused(ctx context.Context, contractAddress string) (bool, error)
+	Owner(ctx context.Context, contractAddress string) (common.Address, error)
+	MasterMinter(ctx context.Context, contractAddress string) (common.Address, error)
+	Pauser(ctx context.Context, contractAddress string) (common.Address, error)
+	Blacklister(ctx context.Context, contractAddress string) (common.Address, error)
+	Currency(ctx context.Context, contractAddress string) (string, error)
+	Version(ctx context.Context, contractAddress string) (string, error)
+	IsMinter(ctx context.Context, contractAddress, address string) (bool, error)
+	MinterAllowance(ctx context.Context, contractAddress, address string) (*big.Int, error)
+	Nonces(ctx context.Context, contractAddress, ownerAddress string) (*big.Int, error)
+	DomainSeparator(ctx context.Context, contractAddress string) ([32]byte, error)
+	AuthorizationState(ctx context.Context, contractAddress, authorizer string, nonce [32]byte) (bool, error)
+}
+
+type stableCoinCtx struct {
+	*ERC20Ctx
+}
+
+func NewStableCoinCtxNamespace(ether types.EtherApi) IStableCoinCtx {
+	return &stableCoinCtx{ERC20Ctx: &ERC20Ctx{ether: ether}}
+}
+
+func (s *stableCoinCtx) stableCoin(ctx context.Context) *stableCoin {
+	return &stableCoin{ERC20: s.erc20(ctx)}
+}
+
+func (s *stableCoinCtx) IsBlacklisted(ctx context.Context, contractAddress, address string) (bool, error) {
+	return s.stableCoin(ctx).IsBlacklisted(contractAddress, address)
+}
+
+func (s *stableCoinCtx) Paused(ctx context.Context, contractAddress string) (bool, error) {
+	return s.stableCoin(ctx).Paused(contractAddress)
+}
+
+func (s *stableCoinCtx) Owner(ctx context.Context, contractAddress string) (common.Address, error) {
+	return s.stableCoin(ctx).Owner(contractAddress)
+}
+
+func (s *stableCoinCtx) MasterMinter(ctx context.Context, contractAddress string) (common.Address, error) {
+	return s.stableCoin(ctx).MasterMinter(contractAddress)
+}
+
+func (s *stableCoinCtx) Pauser(ctx context.Context, contractAddress string) (common.Address, error) {
+	return s.stableCoin(ctx).Pauser(contractAddress)
+}
+
+func (s *stableCoinCtx) Blacklister(ctx context.Context, contractAddress string) (common.Address, error) {
+	return s.stableCoin(ctx).Blacklister(contractAddress)
+}
+
+func (s *stableCoinCtx) Currency(ctx context.Context, contractAddress string) (string, error) {
+	return s.stableCoin(ctx).Currency(contractAddress)
+}
+
+func (s *stableCoinCtx) Version(ctx context.Context, contractAddress string) (string, error) {
+	return s.stableCoin(ctx).Version(contractAddress)
+}
+
+func (s *stableCoinCtx) IsMinter(ctx context.Context, contractAddress, address string) (bool, error) {
+	return s.stableCoin(ctx).IsMinter(contractAddress, address)
+}
+
+func (s *stableCoinCtx) MinterAllowance(ctx context.Context, contractAddress, address string) (*big.Int, error) {
+	return s.stableCoin(ctx).MinterAllowance(contractAddress, address)
+}
+
+func (s *stableCoinCtx) Nonces(ctx context.Context, contractAddress, ownerAddress string) (*big.Int, error) {
+	return s.stableCoin(ctx).Nonces(contractAddress, ownerAddress)
+}
+
+func (s *stableCoinCtx) DomainSeparator(ctx context.Context, contractAddress string) ([32]byte, error) {
+	return s.stableCoin(ctx).DomainSeparator(contractAddress)
+}
+
+func (s *stableCoinCtx) AuthorizationState(ctx context.Context, contractAddress, authorizer string, nonce [32]byte) (bool, error) {
+	return s.stableCoin(ctx).AuthorizationState(contractAddress, authorizer, nonce)
+}
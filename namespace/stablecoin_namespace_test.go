@@ -1,6 +1,7 @@
 package namespace_test
 
 import (
+	"context"
 	"reflect"
 	"testing"
 
@@ -35,7 +36,7 @@ func TestStableCoin_IsBlacklisted(t *testing.T) {
 		expected := make([]byte, 32)
 		expected[31] = 1
 
-		patches.ApplyMethod(reflect.TypeOf(eth), "CallContract", func(_ *ether.Ether, _ ethereum.CallMsg, _ string) ([]byte, error) {
+		patches.ApplyMethod(reflect.TypeOf(eth), "CallContractContext", func(_ *ether.Ether, _ context.Context, _ ethereum.CallMsg, _ string) ([]byte, error) {
 			return expected, nil
 		})
 
@@ -53,7 +54,7 @@ func TestStableCoin_IsBlacklisted(t *testing.T) {
 		sc := namespace.NewStableCoinNamespace(eth)
 		expected := make([]byte, 32)
 
-		patches.ApplyMethod(reflect.TypeOf(eth), "CallContract", func(_ *ether.Ether, _ ethereum.CallMsg, _ string) ([]byte, error) {
+		patches.ApplyMethod(reflect.TypeOf(eth), "CallContractContext", func(_ *ether.Ether, _ context.Context, _ ethereum.CallMsg, _ string) ([]byte, error) {
 			return expected, nil
 		})
 
@@ -70,7 +71,7 @@ func TestStableCoin_IsBlacklisted(t *testing.T) {
 		eth := newEtherApi()
 		sc := namespace.NewStableCoinNamespace(eth)
 
-		patches.ApplyMethod(reflect.TypeOf(eth), "CallContract", func(_ *ether.Ether, _ ethereum.CallMsg, _ string) ([]byte, error) {
+		patches.ApplyMethod(reflect.TypeOf(eth), "CallContractContext", func(_ *ether.Ether, _ context.Context, _ ethereum.CallMsg, _ string) ([]byte, error) {
 			return nil, assert.AnError
 		})
 
@@ -110,7 +111,7 @@ func TestStableCoin_Currency(t *testing.T) {
 		sc := namespace.NewStableCoinNamespace(eth)
 		expected := "USD"
 
-		patches.ApplyMethod(reflect.TypeOf(eth), "CallContract", func(_ *ether.Ether, _ ethereum.CallMsg, _ string) ([]byte, error) {
+		patches.ApplyMethod(reflect.TypeOf(eth), "CallContractContext", func(_ *ether.Ether, _ context.Context, _ ethereum.CallMsg, _ string) ([]byte, error) {
 			return encode.ABIString(expected), nil
 		})
 
@@ -127,7 +128,7 @@ func TestStableCoin_Currency(t *testing.T) {
 		eth := newEtherApi()
 		sc := namespace.NewStableCoinNamespace(eth)
 
-		patches.ApplyMethod(reflect.TypeOf(eth), "CallContract", func(_ *ether.Ether, _ ethereum.CallMsg, _ string) ([]byte, error) {
+		patches.ApplyMethod(reflect.TypeOf(eth), "CallContractContext", func(_ *ether.Ether, _ context.Context, _ ethereum.CallMsg, _ string) ([]byte, error) {
 			return nil, assert.AnError
 		})
 
@@ -158,7 +159,7 @@ func TestStableCoin_Version(t *testing.T) {
 		sc := namespace.NewStableCoinNamespace(eth)
 		expected := "1"
 
-		patches.ApplyMethod(reflect.TypeOf(eth), "CallContract", func(_ *ether.Ether, _ ethereum.CallMsg, _ string) ([]byte, error) {
+		patches.ApplyMethod(reflect.TypeOf(eth), "CallContractContext", func(_ *ether.Ether, _ context.Context, _ ethereum.CallMsg, _ string) ([]byte, error) {
 			return encode.ABIString(expected), nil
 		})
 
@@ -175,7 +176,7 @@ func TestStableCoin_Version(t *testing.T) {
 		eth := newEtherApi()
 		sc := namespace.NewStableCoinNamespace(eth)
 
-		patches.ApplyMethod(reflect.TypeOf(eth), "CallContract", func(_ *ether.Ether, _ ethereum.CallMsg, _ string) ([]byte, error) {
+		patches.ApplyMethod(reflect.TypeOf(eth), "CallContractContext", func(_ *ether.Ether, _ context.Context, _ ethereum.CallMsg, _ string) ([]byte, error) {
 			return nil, assert.AnError
 		})
 
@@ -207,7 +208,7 @@ func TestStableCoin_Paused(t *testing.T) {
 		expected := make([]byte, 32)
 		expected[31] = 1
 
-		patches.ApplyMethod(reflect.TypeOf(eth), "CallContract", func(_ *ether.Ether, _ ethereum.CallMsg, _ string) ([]byte, error) {
+		patches.ApplyMethod(reflect.TypeOf(eth), "CallContractContext", func(_ *ether.Ether, _ context.Context, _ ethereum.CallMsg, _ string) ([]byte, error) {
 			return expected, nil
 		})
 
@@ -225,7 +226,7 @@ func TestStableCoin_Paused(t *testing.T) {
 		sc := namespace.NewStableCoinNamespace(eth)
 		expected := make([]byte, 32)
 
-		patches.ApplyMethod(reflect.TypeOf(eth), "CallContract", func(_ *ether.Ether, _ ethereum.CallMsg, _ string) ([]byte, error) {
+		patches.ApplyMethod(reflect.TypeOf(eth), "CallContractContext", func(_ *ether.Ether, _ context.Context, _ ethereum.CallMsg, _ string) ([]byte, error) {
 			return expected, nil
 		})
 
@@ -242,7 +243,7 @@ func TestStableCoin_Paused(t *testing.T) {
 		eth := newEtherApi()
 		sc := namespace.NewStableCoinNamespace(eth)
 
-		patches.ApplyMethod(reflect.TypeOf(eth), "CallContract", func(_ *ether.Ether, _ ethereum.CallMsg, _ string) ([]byte, error) {
+		patches.ApplyMethod(reflect.TypeOf(eth), "CallContractContext", func(_ *ether.Ether, _ context.Context, _ ethereum.CallMsg, _ string) ([]byte, error) {
 			return nil, assert.AnError
 		})
 
@@ -275,7 +276,7 @@ func TestStableCoin_MasterMinter(t *testing.T) {
 		expected := make([]byte, 32)
 		copy(expected[12:], masterMinterAddress.Bytes())
 
-		patches.ApplyMethod(reflect.TypeOf(eth), "CallContract", func(_ *ether.Ether, _ ethereum.CallMsg, _ string) ([]byte, error) {
+		patches.ApplyMethod(reflect.TypeOf(eth), "CallContractContext", func(_ *ether.Ether, _ context.Context, _ ethereum.CallMsg, _ string) ([]byte, error) {
 			return expected, nil
 		})
 
@@ -292,7 +293,7 @@ func TestStableCoin_MasterMinter(t *testing.T) {
 		eth := newEtherApi()
 		sc := namespace.NewStableCoinNamespace(eth)
 
-		patches.ApplyMethod(reflect.TypeOf(eth), "CallContract", func(_ *ether.Ether, _ ethereum.CallMsg, _ string) ([]byte, error) {
+		patches.ApplyMethod(reflect.TypeOf(eth), "CallContractContext", func(_ *ether.Ether, _ context.Context, _ ethereum.CallMsg, _ string) ([]byte, error) {
 			return nil, assert.AnError
 		})
 
@@ -324,7 +325,7 @@ func TestStableCoin_Pauser(t *testing.T) {
 		expected := make([]byte, 32)
 		copy(expected[12:], pauserAddress.Bytes())
 
-		patches.ApplyMethod(reflect.TypeOf(eth), "CallContract", func(_ *ether.Ether, _ ethereum.CallMsg, _ string) ([]byte, error) {
+		patches.ApplyMethod(reflect.TypeOf(eth), "CallContractContext", func(_ *ether.Ether, _ context.Context, _ ethereum.CallMsg, _ string) ([]byte, error) {
 			return expected, nil
 		})
 
@@ -341,7 +342,7 @@ func TestStableCoin_Pauser(t *testing.T) {
 		eth := newEtherApi()
 		sc := namespace.NewStableCoinNamespace(eth)
 
-		patches.ApplyMethod(reflect.TypeOf(eth), "CallContract", func(_ *ether.Ether, _ ethereum.CallMsg, _ string) ([]byte, error) {
+		patches.ApplyMethod(reflect.TypeOf(eth), "CallContractContext", func(_ *ether.Ether, _ context.Context, _ ethereum.CallMsg, _ string) ([]byte, error) {
 			return nil, assert.AnError
 		})
 
@@ -373,7 +374,7 @@ func TestStableCoin_Blacklister(t *testing.T) {
 		expected := make([]byte, 32)
 		copy(expected[12:], blacklisterAddress.Bytes())
 
-		patches.ApplyMethod(reflect.TypeOf(eth), "CallContract", func(_ *ether.Ether, _ ethereum.CallMsg, _ string) ([]byte, error) {
+		patches.ApplyMethod(reflect.TypeOf(eth), "CallContractContext", func(_ *ether.Ether, _ context.Context, _ ethereum.CallMsg, _ string) ([]byte, error) {
 			return expected, nil
 		})
 
@@ -390,7 +391,7 @@ func TestStableCoin_Blacklister(t *testing.T) {
 		eth := newEtherApi()
 		sc := namespace.NewStableCoinNamespace(eth)
 
-		patches.ApplyMethod(reflect.TypeOf(eth), "CallContract", func(_ *ether.Ether, _ ethereum.CallMsg, _ string) ([]byte, error) {
+		patches.ApplyMethod(reflect.TypeOf(eth), "CallContractContext", func(_ *ether.Ether, _ context.Context, _ ethereum.CallMsg, _ string) ([]byte, error) {
 			return nil, assert.AnError
 		})
 
@@ -422,7 +423,7 @@ func TestStableCoin_Owner(t *testing.T) {
 		expected := make([]byte, 32)
 		copy(expected[12:], ownerAddress.Bytes())
 
-		patches.ApplyMethod(reflect.TypeOf(eth), "CallContract", func(_ *ether.Ether, _ ethereum.CallMsg, _ string) ([]byte, error) {
+		patches.ApplyMethod(reflect.TypeOf(eth), "CallContractContext", func(_ *ether.Ether, _ context.Context, _ ethereum.CallMsg, _ string) ([]byte, error) {
 			return expected, nil
 		})
 
@@ -439,7 +440,7 @@ func TestStableCoin_Owner(t *testing.T) {
 		eth := newEtherApi()
 		sc := namespace.NewStableCoinNamespace(eth)
 
-		patches.ApplyMethod(reflect.TypeOf(eth), "CallContract", func(_ *ether.Ether, _ ethereum.CallMsg, _ string) ([]byte, error) {
+		patches.ApplyMethod(reflect.TypeOf(eth), "CallContractContext", func(_ *ether.Ether, _ context.Context, _ ethereum.CallMsg, _ string) ([]byte, error) {
 			return nil, assert.AnError
 		})
 
@@ -471,7 +472,7 @@ func TestStableCoin_IsMinter(t *testing.T) {
 		expected := make([]byte, 32)
 		expected[31] = 1
 
-		patches.ApplyMethod(reflect.TypeOf(eth), "CallContract", func(_ *ether.Ether, _ ethereum.CallMsg, _ string) ([]byte, error) {
+		patches.ApplyMethod(reflect.TypeOf(eth), "CallContractContext", func(_ *ether.Ether, _ context.Context, _ ethereum.CallMsg, _ string) ([]byte, error) {
 			return expected, nil
 		})
 
@@ -489,7 +490,7 @@ func TestStableCoin_IsMinter(t *testing.T) {
 		sc := namespace.NewStableCoinNamespace(eth)
 		expected := make([]byte, 32)
 
-		patches.ApplyMethod(reflect.TypeOf(eth), "CallContract", func(_ *ether.Ether, _ ethereum.CallMsg, _ string) ([]byte, error) {
+		patches.ApplyMethod(reflect.TypeOf(eth), "CallContractContext", func(_ *ether.Ether, _ context.Context, _ ethereum.CallMsg, _ string) ([]byte, error) {
 			return expected, nil
 		})
 
@@ -506,7 +507,7 @@ func TestStableCoin_IsMinter(t *testing.T) {
 		eth := newEtherApi()
 		sc := namespace.NewStableCoinNamespace(eth)
 
-		patches.ApplyMethod(reflect.TypeOf(eth), "CallContract", func(_ *ether.Ether, _ ethereum.CallMsg, _ string) ([]byte, error) {
+		patches.ApplyMethod(reflect.TypeOf(eth), "CallContractContext", func(_ *ether.Ether, _ context.Context, _ ethereum.CallMsg, _ string) ([]byte, error) {
 			return nil, assert.AnError
 		})
 
@@ -548,7 +549,7 @@ func TestStableCoin_MinterAllowance(t *testing.T) {
 		expected := make([]byte, 32)
 		expected[31] = 100
 
-		patches.ApplyMethod(reflect.TypeOf(eth), "CallContract", func(_ *ether.Ether, _ ethereum.CallMsg, _ string) ([]byte, error) {
+		patches.ApplyMethod(reflect.TypeOf(eth), "CallContractContext", func(_ *ether.Ether, _ context.Context, _ ethereum.CallMsg, _ string) ([]byte, error) {
 			return expected, nil
 		})
 
@@ -565,7 +566,7 @@ func TestStableCoin_MinterAllowance(t *testing.T) {
 		eth := newEtherApi()
 		sc := namespace.NewStableCoinNamespace(eth)
 
-		patches.ApplyMethod(reflect.TypeOf(eth), "CallContract", func(_ *ether.Ether, _ ethereum.CallMsg, _ string) ([]byte, error) {
+		patches.ApplyMethod(reflect.TypeOf(eth), "CallContractContext", func(_ *ether.Ether, _ context.Context, _ ethereum.CallMsg, _ string) ([]byte, error) {
 			return nil, assert.AnError
 		})
 
@@ -607,7 +608,7 @@ func TestStableCoin_Nonces(t *testing.T) {
 		expected := make([]byte, 32)
 		expected[31] = 5
 
-		patches.ApplyMethod(reflect.TypeOf(eth), "CallContract", func(_ *ether.Ether, _ ethereum.CallMsg, _ string) ([]byte, error) {
+		patches.ApplyMethod(reflect.TypeOf(eth), "CallContractContext", func(_ *ether.Ether, _ context.Context, _ ethereum.CallMsg, _ string) ([]byte, error) {
 			return expected, nil
 		})
 
@@ -624,7 +625,7 @@ func TestStableCoin_Nonces(t *testing.T) {
 		eth := newEtherApi()
 		sc := namespace.NewStableCoinNamespace(eth)
 
-		patches.ApplyMethod(reflect.TypeOf(eth), "CallContract", func(_ *ether.Ether, _ ethereum.CallMsg, _ string) ([]byte, error) {
+		patches.ApplyMethod(reflect.TypeOf(eth), "CallContractContext", func(_ *ether.Ether, _ context.Context, _ ethereum.CallMsg, _ string) ([]byte, error) {
 			return make([]byte, 32), nil
 		})
 
@@ -641,7 +642,7 @@ func TestStableCoin_Nonces(t *testing.T) {
 		eth := newEtherApi()
 		sc := namespace.NewStableCoinNamespace(eth)
 
-		patches.ApplyMethod(reflect.TypeOf(eth), "CallContract", func(_ *ether.Ether, _ ethereum.CallMsg, _ string) ([]byte, error) {
+		patches.ApplyMethod(reflect.TypeOf(eth), "CallContractContext", func(_ *ether.Ether, _ context.Context, _ ethereum.CallMsg, _ string) ([]byte, error) {
 			return nil, assert.AnError
 		})
 
@@ -683,7 +684,7 @@ func TestStableCoin_DomainSeparator(t *testing.T) {
 		expected[0] = 0xab
 		expected[31] = 0xcd
 
-		patches.ApplyMethod(reflect.TypeOf(eth), "CallContract", func(_ *ether.Ether, _ ethereum.CallMsg, _ string) ([]byte, error) {
+		patches.ApplyMethod(reflect.TypeOf(eth), "CallContractContext", func(_ *ether.Ether, _ context.Context, _ ethereum.CallMsg, _ string) ([]byte, error) {
 			return expected, nil
 		})
 
@@ -700,7 +701,7 @@ func TestStableCoin_DomainSeparator(t *testing.T) {
 		eth := newEtherApi()
 		sc := namespace.NewStableCoinNamespace(eth)
 
-		patches.ApplyMethod(reflect.TypeOf(eth), "CallContract", func(_ *ether.Ether, _ ethereum.CallMsg, _ string) ([]byte, error) {
+		patches.ApplyMethod(reflect.TypeOf(eth), "CallContractContext", func(_ *ether.Ether, _ context.Context, _ ethereum.CallMsg, _ string) ([]byte, error) {
 			return []byte{0x01, 0x02}, nil
 		})
 
@@ -717,7 +718,7 @@ func TestStableCoin_DomainSeparator(t *testing.T) {
 		eth := newEtherApi()
 		sc := namespace.NewStableCoinNamespace(eth)
 
-		patches.ApplyMethod(reflect.TypeOf(eth), "CallContract", func(_ *ether.Ether, _ ethereum.CallMsg, _ string) ([]byte, error) {
+		patches.ApplyMethod(reflect.TypeOf(eth), "CallContractContext", func(_ *ether.Ether, _ context.Context, _ ethereum.CallMsg, _ string) ([]byte, error) {
 			return nil, assert.AnError
 		})
 
@@ -753,7 +754,7 @@ func TestStableCoin_AuthorizationState(t *testing.T) {
 		expected := make([]byte, 32)
 		expected[31] = 1
 
-		patches.ApplyMethod(reflect.TypeOf(eth), "CallContract", func(_ *ether.Ether, _ ethereum.CallMsg, _ string) ([]byte, error) {
+		patches.ApplyMethod(reflect.TypeOf(eth), "CallContractContext", func(_ *ether.Ether, _ context.Context, _ ethereum.CallMsg, _ string) ([]byte, error) {
 			return expected, nil
 		})
 
@@ -770,7 +771,7 @@ func TestStableCoin_AuthorizationState(t *testing.T) {
 		eth := newEtherApi()
 		sc := namespace.NewStableCoinNamespace(eth)
 
-		patches.ApplyMethod(reflect.TypeOf(eth), "CallContract", func(_ *ether.Ether, _ ethereum.CallMsg, _ string) ([]byte, error) {
+		patches.ApplyMethod(reflect.TypeOf(eth), "CallContractContext", func(_ *ether.Ether, _ context.Context, _ ethereum.CallMsg, _ string) ([]byte, error) {
 			return make([]byte, 32), nil
 		})
 
@@ -787,7 +788,7 @@ func TestStableCoin_AuthorizationState(t *testing.T) {
 		eth := newEtherApi()
 		sc := namespace.NewStableCoinNamespace(eth)
 
-		patches.ApplyMethod(reflect.TypeOf(eth), "CallContract", func(_ *ether.Ether, _ ethereum.CallMsg, _ string) ([]byte, error) {
+		patches.ApplyMethod(reflect.TypeOf(eth), "CallContractContext", func(_ *ether.Ether, _ context.Context, _ ethereum.CallMsg, _ string) ([]byte, error) {
 			return nil, assert.AnError
 		})
 
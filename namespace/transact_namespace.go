@@ -16,12 +16,32 @@ type ITransact interface {
 	*/
 	WaitMined(ctx context.Context, txHash string) (*gethTypes.Receipt, error)
 
+	/*
+		WaitMinedOrReplaced is WaitMined that also returns when another tx of
+		the same sender & nonce is mined instead, e.g. by Wallet.SpeedUp or
+		Wallet.Cancel: it returns the receipt of that replacement with a
+		*types.ReplacedError.
+
+		It checks the sender's nonce along with each receipt poll, so it costs
+		an eth_getTransactionCount per poll on top of WaitMined. The node must
+		know the tx when the wait starts: an unknown tx returns
+		constant.ErrTxNotFound.
+	*/
+	WaitMinedOrReplaced(ctx context.Context, txHash string) (*gethTypes.Receipt, error)
+
 	/*
 		WaitDeployed waits for a contract deployment transaction with the provided hash and
 		returns the contract address.
 		It stops waiting when ctx is canceled.
 	*/
 	WaitDeployed(ctx context.Context, txHash string) (common.Address, error)
+
+	/*
+		RevertReason replays a mined transaction that failed (status=0) with
+		eth_call at its block, and returns its decoded revert.
+		It returns nil for a successful transaction.
+	*/
+	RevertReason(ctx context.Context, txHash string) (*types.RevertError, error)
 }
 
 type Transact struct {
@@ -43,6 +63,29 @@ func (t *Transact) WaitMined(ctx context.Context, txHash string) (*gethTypes.Rec
 	return txReceipt, nil
 }
 
+func (t *Transact) WaitMinedOrReplaced(ctx context.Context, txHash string) (*gethTypes.Receipt, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	mined := make(chan waitResult, 1)
+	go func() {
+		receipt, err := t.ether.WaitMined(ctx, common.HexToHash(txHash))
+		mined <- waitResult{receipt, err}
+	}()
+	replaced := make(chan waitResult, 1)
+	go t.watchReplacement(ctx, txHash, replaced)
+
+	select {
+	case result := <-mined:
+		if result.err != nil {
+			return nil, result.err
+		}
+		return result.receipt, nil
+	case result := <-replaced:
+		return result.receipt, result.err
+	}
+}
+
 func (t *Transact) WaitDeployed(ctx context.Context, txHash string) (common.Address, error) {
 	address, err := t.ether.WaitDeployed(ctx, common.HexToHash(txHash))
 	if err != nil {
@@ -51,3 +94,7 @@ func (t *Transact) WaitDeployed(ctx context.Context, txHash string) (common.Addr
 
 	return address, nil
 }
+
+func (t *Transact) RevertReason(ctx context.Context, txHash string) (*types.RevertError, error) {
+	return t.ether.RevertReason(ctx, common.HexToHash(txHash))
+}
@@ -3,14 +3,21 @@ package namespace_test
 import (
 	"context"
 	"errors"
+	"math/big"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/agiledragon/gomonkey"
+	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
 	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/poteto-go/go-alchemy-sdk/constant"
 	"github.com/poteto-go/go-alchemy-sdk/ether"
 	"github.com/poteto-go/go-alchemy-sdk/namespace"
+	"github.com/poteto-go/go-alchemy-sdk/types"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -138,3 +145,183 @@ func Test_WaitDeployed(t *testing.T) {
 		assert.ErrorIs(t, err, expectedErr)
 	})
 }
+
+// replacingChain is a chain where the pending tx original of from is mined
+// at minedBlock as mined: itself, or a replacement of the same nonce.
+type replacingChain struct {
+	types.EtherApi
+	original, mined *gethTypes.Transaction
+	latest          uint64
+	minedBlock      uint64
+	nonceCalls      int
+}
+
+func (c *replacingChain) WaitMined(ctx context.Context, hash common.Hash) (*gethTypes.Receipt, error) {
+	if hash == c.mined.Hash() {
+		return &gethTypes.Receipt{TxHash: hash}, nil
+	}
+	<-ctx.Done()
+	return nil, ctx.Err()
+}
+
+func (c *replacingChain) GetTransactionContext(_ context.Context, hash string) (*gethTypes.Transaction, bool, error) {
+	if hash != c.original.Hash().Hex() {
+		return nil, false, ethereum.NotFound
+	}
+	return c.original, true, nil
+}
+
+func (c *replacingChain) ChainIDContext(context.Context) (*big.Int, error) {
+	return big.NewInt(1337), nil
+}
+
+func (c *replacingChain) BlockNumberContext(context.Context) (uint64, error) {
+	return c.latest, nil
+}
+
+func (c *replacingChain) NonceAtContext(_ context.Context, _ string, blockTag string) (uint64, error) {
+	c.nonceCalls++
+	blockNumber := c.latest
+	if blockTag != "latest" {
+		blockNumber = hexutil.MustDecodeUint64(blockTag)
+	}
+	if blockNumber >= c.minedBlock {
+		return c.original.Nonce() + 1, nil
+	}
+	return c.original.Nonce(), nil
+}
+
+func (c *replacingChain) GetBlockByNumberContext(_ context.Context, blockTag string) (*gethTypes.Block, error) {
+	if hexutil.MustDecodeUint64(blockTag) != c.minedBlock {
+		return gethTypes.NewBlockWithHeader(&gethTypes.Header{}), nil
+	}
+	other := gethTypes.NewTx(&gethTypes.LegacyTx{Nonce: c.original.Nonce()})
+	return gethTypes.NewBlockWithHeader(&gethTypes.Header{}).WithBody(gethTypes.Body{
+		Transactions: []*gethTypes.Transaction{other, c.mined},
+	}), nil
+}
+
+func (c *replacingChain) GetTransactionReceiptContext(_ context.Context, hash string) (*gethTypes.Receipt, error) {
+	return &gethTypes.Receipt{TxHash: common.HexToHash(hash), BlockNumber: new(big.Int).SetUint64(c.minedBlock)}, nil
+}
+
+func Test_WaitMinedOrReplaced(t *testing.T) {
+	key, _ := crypto.GenerateKey()
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	to := common.HexToAddress("0xabc")
+	sign := func(txData gethTypes.TxData) *gethTypes.Transaction {
+		tx, err := gethTypes.SignTx(gethTypes.NewTx(txData), gethTypes.LatestSignerForChainID(big.NewInt(1337)), key)
+		assert.NoError(t, err)
+		return tx
+	}
+	original := sign(&gethTypes.LegacyTx{Nonce: 4, GasPrice: big.NewInt(10), Gas: 30000, To: &to, Data: []byte{1}})
+
+	t.Run("returns the receipt of the replacement with a ReplacedError", func(t *testing.T) {
+		tests := []struct {
+			name   string
+			mined  *gethTypes.Transaction
+			reason types.ReplacedReason
+		}{
+			{
+				name:   "sped up",
+				mined:  sign(&gethTypes.LegacyTx{Nonce: 4, GasPrice: big.NewInt(11), Gas: 30000, To: &to, Data: []byte{1}}),
+				reason: types.ReplacedRepriced,
+			},
+			{
+				name:   "cancelled",
+				mined:  sign(&gethTypes.LegacyTx{Nonce: 4, GasPrice: big.NewInt(11), Gas: 21000, To: &from, Value: big.NewInt(0)}),
+				reason: types.ReplacedCancelled,
+			},
+			{
+				name:   "other",
+				mined:  sign(&gethTypes.LegacyTx{Nonce: 4, GasPrice: big.NewInt(11), Gas: 21000, To: &to, Value: big.NewInt(1)}),
+				reason: types.ReplacedOther,
+			},
+		}
+		for _, tt := range tests {
+			t.Run(tt.name, func(t *testing.T) {
+				// Arrange
+				chain := &replacingChain{original: original, mined: tt.mined, latest: 1000, minedBlock: 937}
+				transact := namespace.NewTransactNamespace(chain)
+
+				// Act
+				receipt, err := transact.WaitMinedOrReplaced(context.Background(), original.Hash().Hex())
+
+				// Assert
+				replacedErr, ok := errors.AsType[*types.ReplacedError](err)
+				assert.True(t, ok)
+				assert.Equal(t, original.Hash(), replacedErr.TxHash)
+				assert.Equal(t, tt.mined.Hash(), replacedErr.Replacement.Hash())
+				assert.Equal(t, tt.reason, replacedErr.Reason)
+				assert.Equal(t, tt.mined.Hash(), receipt.TxHash)
+				assert.Equal(t, big.NewInt(937), receipt.BlockNumber)
+				assert.Less(t, chain.nonceCalls, 20)
+			})
+		}
+	})
+
+	t.Run("if the tx itself is mined, return its receipt", func(t *testing.T) {
+		// Arrange
+		chain := &replacingChain{original: original, mined: original, latest: 5, minedBlock: 0}
+		transact := namespace.NewTransactNamespace(chain)
+
+		// Act
+		receipt, err := transact.WaitMinedOrReplaced(context.Background(), original.Hash().Hex())
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, original.Hash(), receipt.TxHash)
+	})
+
+	t.Run("if the tx is unknown, return ErrTxNotFound", func(t *testing.T) {
+		// Arrange
+		chain := &replacingChain{original: original, mined: original, latest: 5, minedBlock: 6}
+		transact := namespace.NewTransactNamespace(chain)
+		unknown := sign(&gethTypes.LegacyTx{Nonce: 5, GasPrice: big.NewInt(10), To: &to})
+
+		// Act
+		receipt, err := transact.WaitMinedOrReplaced(context.Background(), unknown.Hash().Hex())
+
+		// Assert
+		assert.ErrorIs(t, err, constant.ErrTxNotFound)
+		assert.ErrorIs(t, err, ethereum.NotFound)
+		assert.Nil(t, receipt)
+	})
+
+	t.Run("if ctx is done before either, return ctx.Err()", func(t *testing.T) {
+		// Arrange
+		pending := sign(&gethTypes.LegacyTx{Nonce: 4, GasPrice: big.NewInt(11), To: &to})
+		chain := &replacingChain{original: original, mined: pending, latest: 5, minedBlock: 6}
+		transact := namespace.NewTransactNamespace(chain)
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		// Act
+		receipt, err := transact.WaitMinedOrReplaced(ctx, original.Hash().Hex())
+
+		// Assert
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Nil(t, receipt)
+	})
+}
+
+func Test_WaitMined_DoesNotWatchReplacement(t *testing.T) {
+	// Arrange
+	key, _ := crypto.GenerateKey()
+	to := common.HexToAddress("0xabc")
+	original, _ := gethTypes.SignTx(
+		gethTypes.NewTx(&gethTypes.LegacyTx{Nonce: 4, GasPrice: big.NewInt(10), To: &to}),
+		gethTypes.LatestSignerForChainID(big.NewInt(1337)),
+		key,
+	)
+	chain := &replacingChain{original: original, mined: original, latest: 5, minedBlock: 0}
+	transact := namespace.NewTransactNamespace(chain)
+
+	// Act
+	receipt, err := transact.WaitMined(context.Background(), original.Hash().Hex())
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, original.Hash(), receipt.TxHash)
+	assert.Equal(t, 0, chain.nonceCalls)
+}
@@ -0,0 +1,185 @@
+package namespace
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/poteto-go/go-alchemy-sdk/constant"
+	"github.com/poteto-go/go-alchemy-sdk/types"
+)
+
+// replacementPollInterval is how often WaitMinedOrReplaced checks whether the
+// nonce of the awaited tx was used by another one: as often as bind.WaitMined
+// polls the receipt.
+const replacementPollInterval = time.Second
+
+type waitResult struct {
+	receipt *gethTypes.Receipt
+	err     error
+}
+
+/*
+watchReplacement sends the receipt of the tx mined in place of txHash, with a
+*types.ReplacedError, on replaced. If it cannot tell the sender & nonce of
+txHash, e.g. the tx is unknown, it sends the error instead. It gives up
+silently - leaving WaitMinedOrReplaced to ether.WaitMined - once the original
+is mined or ctx is done.
+*/
+func (t *Transact) watchReplacement(ctx context.Context, txHash string, replaced chan<- waitResult) {
+	tx, _, err := t.ether.GetTransactionContext(ctx, txHash)
+	if errors.Is(err, ethereum.NotFound) {
+		err = errors.Join(constant.ErrTxNotFound, fmt.Errorf("tx %s", txHash), err)
+	}
+	if err != nil {
+		replaced <- waitResult{nil, err}
+		return
+	}
+	chainID, err := t.ether.ChainIDContext(ctx)
+	if err != nil {
+		replaced <- waitResult{nil, err}
+		return
+	}
+	signer := gethTypes.LatestSignerForChainID(chainID)
+	from, err := gethTypes.Sender(signer, tx)
+	if err != nil {
+		replaced <- waitResult{nil, err}
+		return
+	}
+
+	ticker := time.NewTicker(replacementPollInterval)
+	defer ticker.Stop()
+	for {
+		// transient errors are retried on the next tick
+		if nonce, err := t.ether.NonceAtContext(ctx, from.Hex(), "latest"); err == nil && nonce > tx.Nonce() {
+			mined, receipt, err := t.minedWithNonce(ctx, signer, from, tx.Nonce())
+			if err == nil {
+				if mined.Hash() != tx.Hash() {
+					replaced <- waitResult{receipt, &types.ReplacedError{
+						TxHash:      tx.Hash(),
+						Replacement: mined,
+						Reason:      replacedReason(from, tx, mined),
+					}}
+				}
+				return
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// minedWithNonce returns the mined tx of from with nonce, and its receipt.
+func (t *Transact) minedWithNonce(
+	ctx context.Context,
+	signer gethTypes.Signer,
+	from common.Address,
+	nonce uint64,
+) (*gethTypes.Transaction, *gethTypes.Receipt, error) {
+	blockNumber, err := t.minedAt(ctx, from, nonce)
+	if err != nil {
+		return nil, nil, err
+	}
+	block, err := t.ether.GetBlockByNumberContext(ctx, hexutil.EncodeUint64(blockNumber))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, tx := range block.Transactions() {
+		if tx.Nonce() != nonce {
+			continue
+		}
+		if sender, err := gethTypes.Sender(signer, tx); err != nil || sender != from {
+			continue
+		}
+		receipt, err := t.ether.GetTransactionReceiptContext(ctx, tx.Hash().Hex())
+		if err != nil {
+			return nil, nil, err
+		}
+		return tx, receipt, nil
+	}
+	// the block was reorged away since: try again on the next tick
+	return nil, nil, ethereum.NotFound
+}
+
+/*
+minedAt returns the block that mined the tx of from with nonce: the first one
+whose state has a higher nonce for from. It gallops back from the latest block
+and then bisects, so a recent replacement takes a few eth_getTransactionCount.
+*/
+func (t *Transact) minedAt(ctx context.Context, from common.Address, nonce uint64) (uint64, error) {
+	nonceAt := func(blockNumber uint64) (uint64, error) {
+		return t.ether.NonceAtContext(ctx, from.Hex(), hexutil.EncodeUint64(blockNumber))
+	}
+
+	hi, err := t.ether.BlockNumberContext(ctx)
+	if err != nil {
+		return 0, err
+	}
+	n, err := nonceAt(hi)
+	if err != nil {
+		return 0, err
+	}
+	if n <= nonce {
+		return 0, ethereum.NotFound
+	}
+
+	// nonceAt(hi) > nonce >= nonceAt(lo)
+	var lo uint64
+	for step := uint64(1); ; step *= 2 {
+		if hi == 0 {
+			return 0, nil
+		}
+		lo = hi - min(step, hi)
+		n, err := nonceAt(lo)
+		if err != nil {
+			return 0, err
+		}
+		if n <= nonce {
+			break
+		}
+		hi = lo
+	}
+	for hi-lo > 1 {
+		mid := lo + (hi-lo)/2
+		n, err := nonceAt(mid)
+		if err != nil {
+			return 0, err
+		}
+		if n > nonce {
+			hi = mid
+		} else {
+			lo = mid
+		}
+	}
+	return hi, nil
+}
+
+// replacedReason tells how mined, sent by from, differs from tx it replaced.
+func replacedReason(from common.Address, tx, mined *gethTypes.Transaction) types.ReplacedReason {
+	switch {
+	case mined.To() != nil && *mined.To() == from && mined.Value().Sign() == 0 && len(mined.Data()) == 0:
+		return types.ReplacedCancelled
+	case sameTo(tx, mined) && tx.Value().Cmp(mined.Value()) == 0 && bytes.Equal(tx.Data(), mined.Data()):
+		return types.ReplacedRepriced
+	default:
+		return types.ReplacedOther
+	}
+}
+
+func sameTo(a, b *gethTypes.Transaction) bool {
+	if a.To() == nil || b.To() == nil {
+		return a.To() == b.To()
+	}
+	return *a.To() == *b.To()
+}
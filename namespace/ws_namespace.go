@@ -6,6 +6,8 @@ import (
 	"github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
 	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	gethEvent "github.com/ethereum/go-ethereum/event"
+	"github.com/poteto-go/go-alchemy-sdk/event"
 	"github.com/poteto-go/go-alchemy-sdk/types"
 )
 
@@ -19,6 +21,10 @@ type IWS interface {
 	// subscribe event logs by filter query
 	SubscribeLogs(ctx context.Context, query ethereum.FilterQuery, logChan chan<- gethTypes.Log) (ethereum.Subscription, error)
 
+	// subscribe event logs by filter query, decoded by decoder (event.Standard()
+	// if nil); logs the decoder cannot decode are dropped
+	SubscribeEvents(ctx context.Context, query ethereum.FilterQuery, decoder *event.Decoder, eventChan chan<- any) (ethereum.Subscription, error)
+
 	// subscribe event logs of a specific contract address
 	SubscribeContractLogs(ctx context.Context, contractAddress common.Address, logChan chan<- gethTypes.Log) (ethereum.Subscription, error)
 
@@ -48,6 +54,40 @@ func (w *WS) SubscribeLogs(ctx context.Context, query ethereum.FilterQuery, logC
 	return w.ether.SubscribeFilterLogs(ctx, query, logChan)
 }
 
+func (w *WS) SubscribeEvents(ctx context.Context, query ethereum.FilterQuery, decoder *event.Decoder, eventChan chan<- any) (ethereum.Subscription, error) {
+	if decoder == nil {
+		decoder = event.Standard()
+	}
+
+	logChan := make(chan gethTypes.Log)
+	sub, err := w.ether.SubscribeFilterLogs(ctx, query, logChan)
+	if err != nil {
+		return nil, err
+	}
+
+	return gethEvent.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logChan:
+				decoded, err := decoder.DecodeLog(log)
+				if err != nil {
+					continue
+				}
+				select {
+				case eventChan <- decoded:
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
 func (w *WS) SubscribeContractLogs(ctx context.Context, contractAddress common.Address, logChan chan<- gethTypes.Log) (ethereum.Subscription, error) {
 	query := ethereum.FilterQuery{
 		Addresses: []common.Address{contractAddress},
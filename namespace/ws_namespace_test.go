@@ -11,10 +11,12 @@ import (
 	ethereum "github.com/ethereum/go-ethereum"
 	"github.com/ethereum/go-ethereum/common"
 	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/poteto-go/go-alchemy-sdk/alchemymock"
 	"github.com/poteto-go/go-alchemy-sdk/constant"
 	"github.com/poteto-go/go-alchemy-sdk/ether"
 	eth "github.com/poteto-go/go-alchemy-sdk/ether"
+	"github.com/poteto-go/go-alchemy-sdk/event"
 	"github.com/poteto-go/go-alchemy-sdk/gas"
 	"github.com/poteto-go/go-alchemy-sdk/namespace"
 	"github.com/poteto-go/go-alchemy-sdk/types"
@@ -47,6 +49,8 @@ func newEtherWsApiForTest() *eth.Ether {
 			[]byte(""),
 			5<<20,
 			nil,
+			nil,
+			nil,
 		),
 	).(*eth.Ether)
 }
@@ -70,6 +74,8 @@ func newWsNamespaceForTestWithUrl(wsUrl string) namespace.IWS {
 			nil,
 			5<<20,
 			nil,
+			nil,
+			nil,
 		),
 	)
 	return namespace.NewWSNamespace(e)
@@ -245,6 +251,58 @@ func TestWS_SubscribeLogs(t *testing.T) {
 	})
 }
 
+func TestWS_SubscribeEvents(t *testing.T) {
+	query := ethereum.FilterQuery{}
+
+	t.Run("returns error if provider is not websocket", func(t *testing.T) {
+		// Arrange
+		ws := namespace.NewWSNamespace(newEtherApi())
+
+		// Act
+		_, err := ws.SubscribeEvents(context.Background(), query, nil, make(chan any))
+
+		// Assert
+		assert.ErrorIs(t, err, constant.ErrUnsupportedNotWebsocketProvider)
+	})
+
+	t.Run("streams decoded events, dropping unknown ones", func(t *testing.T) {
+		// Arrange
+		mock := alchemymock.NewAlchemyWsMock(utWsAlchemySetting, t)
+		ws := newWsNamespaceForTestWithUrl(mock.URL())
+
+		ch := make(chan any, 1)
+		ctx, cancel := context.WithTimeout(context.Background(), 2*time.Second)
+		defer cancel()
+
+		// Act
+		sub, err := ws.SubscribeEvents(ctx, query, nil, ch)
+		require.NoError(t, err)
+		defer sub.Unsubscribe()
+
+		addr := common.HexToAddress("0xdeadbeef00000000000000000000000000000042")
+		unknown, err := json.Marshal(newWsMinimalLog(addr))
+		require.NoError(t, err)
+		mock.Emit("logs", unknown)
+
+		pauseLog := newWsMinimalLog(addr)
+		pauseLog.Topics = []common.Hash{crypto.Keccak256Hash([]byte("Pause()"))}
+		pause, err := json.Marshal(pauseLog)
+		require.NoError(t, err)
+		mock.Emit("logs", pause)
+
+		// Assert
+		select {
+		case got := <-ch:
+			assert.IsType(t, &event.Pause{}, got)
+			assert.Equal(t, addr, got.(*event.Pause).Raw.Address)
+		case err := <-sub.Err():
+			t.Fatalf("subscription errored: %v", err)
+		case <-time.After(2 * time.Second):
+			t.Fatal("timed out waiting for subscription notification")
+		}
+	})
+}
+
 func TestWS_SubscribeContractLogs(t *testing.T) {
 	contractAddress := common.HexToAddress("0xdeadbeef00000000000000000000000000000042")
 
@@ -0,0 +1,178 @@
+/*
+Package nonce hands out the nonces of one account locally, so that goroutines
+sending from the same wallet do not race on eth_getTransactionCount.
+
+Attach a Manager to a wallet with Wallet.UseNonceManager:
+
+	w.UseNonceManager(nonce.New(w.PendingNonceAt))
+
+The Manager syncs with the chain's pending nonce on first use, then:
+
+  - Next hands out strictly increasing nonces under a lock
+  - Release gives back the nonce of a tx that failed before broadcast; Next
+    hands it out again before any new one
+  - Failed resyncs from the chain on a "nonce too low" / "nonce too high"
+    error, releases the nonce on any other JSON-RPC error, and resyncs on
+    a transport error, as the node may have taken the tx
+  - Gaps reports the released nonces not handed out again yet
+*/
+package nonce
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/poteto-go/go-alchemy-sdk/types"
+)
+
+var _ types.NonceManager = (*Manager)(nil)
+
+type Manager struct {
+	mu      sync.Mutex
+	pending func() (uint64, error)
+	synced  bool
+	next    uint64
+	// released nonces below next, in ascending order
+	released []uint64
+}
+
+// New creates a Manager that syncs from pending, the pending nonce of the
+// account on chain (e.g. Wallet.PendingNonceAt).
+func New(pending func() (uint64, error)) *Manager {
+	return &Manager{pending: pending}
+}
+
+// Next reserves the nonce of the next tx: the lowest released one, if any,
+// or else the one after the last handed out.
+func (m *Manager) Next() (uint64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if !m.synced {
+		if err := m.sync(); err != nil {
+			return 0, err
+		}
+	}
+
+	if len(m.released) > 0 {
+		nonce := m.released[0]
+		m.released = m.released[1:]
+		return nonce, nil
+	}
+
+	nonce := m.next
+	m.next++
+	return nonce, nil
+}
+
+/*
+Release gives back nonce, of a tx that failed before it was broadcast.
+
+Releasing the last nonce handed out rewinds Next. An earlier one leaves a gap:
+the txs sent with higher nonces wait in the mempool until Next hands it out
+again.
+*/
+func (m *Manager) Release(nonce uint64) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if !m.synced || nonce >= m.next {
+		return
+	}
+	i, found := slices.BinarySearch(m.released, nonce)
+	if found {
+		return
+	}
+
+	if nonce < m.next-1 {
+		m.released = slices.Insert(m.released, i, nonce)
+		return
+	}
+	m.next--
+	for len(m.released) > 0 && m.released[len(m.released)-1] == m.next-1 {
+		m.released = m.released[:len(m.released)-1]
+		m.next--
+	}
+}
+
+/*
+Resync drops the local state and continues from the pending nonce on chain.
+
+The nonces of txs not broadcast yet may be handed out again: their sends fail
+on the nonce, and Failed resyncs again.
+*/
+func (m *Manager) Resync() error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.sync()
+}
+
+/*
+Failed settles nonce, of a tx whose send failed with err.
+
+On a "nonce too low" or "nonce too high" error it resyncs and reports whether
+the tx can be sent again with a fresh nonce. On any other JSON-RPC error the
+node rejected the tx, so it releases nonce.
+
+Any other error (a timeout, a reset connection, a 5xx, ...) leaves it unknown
+whether the node took the tx: it resyncs, so that nonce is handed out again
+only if the tx is not pending on chain, and keeps it reserved if the resync
+fails too. Both report false.
+*/
+func (m *Manager) Failed(nonce uint64, err error) bool {
+	if isNonceError(err) {
+		return m.Resync() == nil
+	}
+
+	if isRejected(err) {
+		m.Release(nonce)
+		return false
+	}
+
+	_ = m.Resync()
+	return false
+}
+
+// Gaps returns the released nonces Next has not handed out again, in
+// ascending order.
+func (m *Manager) Gaps() []uint64 {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return slices.Clone(m.released)
+}
+
+// sync must be called with m.mu held.
+func (m *Manager) sync() error {
+	pending, err := m.pending()
+	if err != nil {
+		return err
+	}
+
+	m.next = pending
+	m.released = nil
+	m.synced = true
+	return nil
+}
+
+// isRejected reports whether err is a JSON-RPC error of the node, i.e. the
+// node answered and did not take the tx.
+func isRejected(err error) bool {
+	rpcErr, ok := errors.AsType[rpc.Error](err)
+	return ok && rpcErr.ErrorCode() != 0
+}
+
+// isNonceError reports whether err is a node rejecting the nonce of a tx.
+// Nodes return it as a JSON-RPC message, so it is matched by text.
+func isNonceError(err error) bool {
+	if err == nil {
+		return false
+	}
+
+	message := strings.ToLower(err.Error())
+	return strings.Contains(message, "nonce too low") || strings.Contains(message, "nonce too high")
+}
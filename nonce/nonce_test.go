@@ -0,0 +1,269 @@
+package nonce_test
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/poteto-go/go-alchemy-sdk/nonce"
+	"github.com/poteto-go/go-alchemy-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// pendingAt returns a pending nonce source that yields nonces in turn, and
+// counts its calls.
+func pendingAt(calls *int, nonces ...uint64) func() (uint64, error) {
+	return func() (uint64, error) {
+		n := nonces[min(*calls, len(nonces)-1)]
+		*calls++
+		return n, nil
+	}
+}
+
+func TestManager_Next(t *testing.T) {
+	t.Run("syncs once, then hands out increasing nonces", func(t *testing.T) {
+		// Arrange
+		calls := 0
+		m := nonce.New(pendingAt(&calls, 5))
+
+		// Act
+		nonces := []uint64{}
+		for range 3 {
+			n, err := m.Next()
+			assert.NoError(t, err)
+			nonces = append(nonces, n)
+		}
+
+		// Assert
+		assert.Equal(t, []uint64{5, 6, 7}, nonces)
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("hands out distinct nonces to concurrent callers", func(t *testing.T) {
+		// Arrange
+		calls := 0
+		m := nonce.New(pendingAt(&calls, 0))
+		var (
+			mu     sync.Mutex
+			wg     sync.WaitGroup
+			nonces []uint64
+		)
+
+		// Act
+		for range 50 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				n, err := m.Next()
+				assert.NoError(t, err)
+				mu.Lock()
+				nonces = append(nonces, n)
+				mu.Unlock()
+			}()
+		}
+		wg.Wait()
+
+		// Assert
+		slices.Sort(nonces)
+		for i, n := range nonces {
+			assert.Equal(t, uint64(i), n)
+		}
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("if failed to sync, return error & sync on the next call", func(t *testing.T) {
+		// Arrange
+		fail := true
+		m := nonce.New(func() (uint64, error) {
+			if fail {
+				return 0, errors.New("error")
+			}
+			return 3, nil
+		})
+
+		// Act
+		_, err := m.Next()
+		fail = false
+		n, nextErr := m.Next()
+
+		// Assert
+		assert.Error(t, err)
+		assert.NoError(t, nextErr)
+		assert.Equal(t, uint64(3), n)
+	})
+}
+
+func TestManager_Release(t *testing.T) {
+	t.Run("releasing the last nonce rewinds Next", func(t *testing.T) {
+		// Arrange
+		calls := 0
+		m := nonce.New(pendingAt(&calls, 0))
+		m.Next()
+		last, _ := m.Next()
+
+		// Act
+		m.Release(last)
+		n, _ := m.Next()
+
+		// Assert
+		assert.Equal(t, last, n)
+		assert.Empty(t, m.Gaps())
+	})
+
+	t.Run("releasing an earlier nonce leaves a gap, filled first", func(t *testing.T) {
+		// Arrange
+		calls := 0
+		m := nonce.New(pendingAt(&calls, 0))
+		for range 4 {
+			m.Next()
+		}
+
+		// Act
+		m.Release(2)
+		m.Release(1)
+		gaps := m.Gaps()
+		first, _ := m.Next()
+		second, _ := m.Next()
+		third, _ := m.Next()
+
+		// Assert
+		assert.Equal(t, []uint64{1, 2}, gaps)
+		assert.Equal(t, []uint64{1, 2, 4}, []uint64{first, second, third})
+		assert.Empty(t, m.Gaps())
+	})
+
+	t.Run("releasing the last nonce also rewinds over the gaps below it", func(t *testing.T) {
+		// Arrange
+		calls := 0
+		m := nonce.New(pendingAt(&calls, 0))
+		for range 4 {
+			m.Next()
+		}
+		m.Release(2)
+
+		// Act
+		m.Release(3)
+		n, _ := m.Next()
+
+		// Assert
+		assert.Empty(t, m.Gaps())
+		assert.Equal(t, uint64(2), n)
+	})
+
+	t.Run("ignores nonces not handed out, or released twice", func(t *testing.T) {
+		// Arrange
+		calls := 0
+		m := nonce.New(pendingAt(&calls, 0))
+		m.Release(0)
+		for range 3 {
+			m.Next()
+		}
+
+		// Act
+		m.Release(7)
+		m.Release(0)
+		m.Release(0)
+
+		// Assert
+		assert.Equal(t, []uint64{0}, m.Gaps())
+	})
+}
+
+func TestManager_Failed(t *testing.T) {
+	t.Run("on a nonce error, resync & retry", func(t *testing.T) {
+		for _, message := range []string{"nonce too low", "Nonce too high: next nonce 9"} {
+			// Arrange
+			calls := 0
+			m := nonce.New(pendingAt(&calls, 0, 9))
+			n, _ := m.Next()
+			m.Next()
+			m.Release(n)
+
+			// Act
+			retry := m.Failed(1, errors.New(message))
+			next, _ := m.Next()
+
+			// Assert
+			assert.True(t, retry)
+			assert.Equal(t, uint64(9), next)
+			assert.Empty(t, m.Gaps())
+		}
+	})
+
+	t.Run("on any other JSON-RPC error, release the nonce", func(t *testing.T) {
+		// Arrange
+		calls := 0
+		m := nonce.New(pendingAt(&calls, 0))
+		m.Next()
+		m.Next()
+
+		// Act
+		retry := m.Failed(0, &types.RpcError{Code: -32000, Message: "insufficient funds for gas * price + value"})
+
+		// Assert
+		assert.False(t, retry)
+		assert.Equal(t, []uint64{0}, m.Gaps())
+		assert.Equal(t, 1, calls)
+	})
+
+	t.Run("on a transport error, resync instead of releasing the nonce", func(t *testing.T) {
+		// Arrange
+		calls := 0
+		m := nonce.New(pendingAt(&calls, 0, 1))
+		m.Next()
+
+		// Act
+		retry := m.Failed(0, &url.Error{Op: "Post", URL: "https://example.com", Err: context.DeadlineExceeded})
+		next, _ := m.Next()
+
+		// Assert
+		assert.False(t, retry)
+		assert.Empty(t, m.Gaps())
+		assert.Equal(t, 2, calls)
+		assert.Equal(t, uint64(1), next)
+	})
+
+	t.Run("on a transport error, if failed to resync, keep the nonce reserved", func(t *testing.T) {
+		// Arrange
+		calls := 0
+		m := nonce.New(func() (uint64, error) {
+			calls++
+			if calls > 1 {
+				return 0, errors.New("error")
+			}
+			return 0, nil
+		})
+		m.Next()
+
+		// Act
+		retry := m.Failed(0, errors.New("connection reset by peer"))
+		next, _ := m.Next()
+
+		// Assert
+		assert.False(t, retry)
+		assert.Empty(t, m.Gaps())
+		assert.Equal(t, uint64(1), next)
+	})
+
+	t.Run("if failed to resync, do not retry", func(t *testing.T) {
+		// Arrange
+		calls := 0
+		m := nonce.New(func() (uint64, error) {
+			calls++
+			if calls > 1 {
+				return 0, errors.New("error")
+			}
+			return 0, nil
+		})
+		m.Next()
+
+		// Act
+		retry := m.Failed(0, errors.New("nonce too low"))
+
+		// Assert
+		assert.False(t, retry)
+	})
+}
@@ -0,0 +1,68 @@
+package ratelimit
+
+import (
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/poteto-go/go-alchemy-sdk/constant"
+	"github.com/poteto-go/go-alchemy-sdk/types"
+)
+
+// DefaultComputeUnitCost is charged for methods missing from
+// DefaultComputeUnits.
+const DefaultComputeUnitCost = 26
+
+// DefaultComputeUnits is Alchemy's compute-unit cost of the methods the SDK
+// calls.
+//
+// refs:
+//   - https://www.alchemy.com/docs/reference/compute-unit-costs
+var DefaultComputeUnits = map[string]int{
+	constant.Eth_BlockNumber:             10,
+	constant.Eth_ChainId:                 0,
+	constant.Eth_GasPrice:                19,
+	constant.Eth_MaxPriorityFeePerGas:    10,
+	constant.Eth_GetBalance:              19,
+	constant.Eth_GetCode:                 26,
+	constant.Eth_GetStorageAt:            17,
+	constant.Eth_GetTransactionCount:     26,
+	constant.Eth_GetTransactionByHash:    17,
+	constant.Eth_GetTransactionReceipt:   15,
+	constant.Eth_GetBlockByNumber:        16,
+	constant.Eth_GetBlockByHash:          21,
+	constant.Eth_GetLogs:                 75,
+	constant.Eth_Call:                    26,
+	constant.Eth_EstimateGas:             87,
+	constant.Eth_SendRawTransaction:      250,
+	constant.Net_PeerCount:               10,
+	constant.Alchemy_GetTokenBalances:    26,
+	constant.Alchemy_GetTokenMetadata:    10,
+	constant.Alchemy_GetAssetTransfers:   150,
+	constant.Alchemy_TransactionReceipts: 250,
+	constant.Evm_Snapshot:                0,
+	constant.Evm_Revert:                  0,
+}
+
+// Cost returns the compute units of req; a constant.Rpc_Batch costs the sum
+// of its calls.
+func (l *Limiter) Cost(req types.RpcRequest) int {
+	if req.Method != constant.Rpc_Batch {
+		return l.methodCost(req.Method)
+	}
+
+	if len(req.Params) == 0 {
+		return 0
+	}
+	elems, _ := req.Params[0].([]rpc.BatchElem)
+	cost := 0
+	for _, elem := range elems {
+		cost += l.methodCost(elem.Method)
+	}
+	return cost
+}
+
+func (l *Limiter) methodCost(method string) int {
+	if cost, ok := l.costs[method]; ok {
+		return cost
+	}
+	return DefaultComputeUnitCost
+}
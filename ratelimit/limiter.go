@@ -0,0 +1,201 @@
+/*
+Package ratelimit keeps the SDK within an Alchemy compute-unit (CU) limit on
+the client side, instead of finding out through 429 responses.
+
+Enable it with gas.AlchemySetting.RateLimit. Every JSON-RPC call is charged
+its CU cost (see DefaultComputeUnits), including the ones bind makes for
+wallet sends, deploys, contract calls and WaitMined receipt polls, against:
+
+  - a token bucket refilled at Config.ComputeUnitsPerSecond
+  - a daily budget of Config.DailyBudget CUs, reset at 00:00 UTC
+
+A call that does not fit waits until it does, or fails with a
+*types.ComputeUnitError under Config.FailFast. Backoff retries are charged
+too, as Alchemy bills them.
+*/
+package ratelimit
+
+import (
+	"context"
+	"maps"
+	"sync"
+	"time"
+
+	"github.com/poteto-go/go-alchemy-sdk/constant"
+	"github.com/poteto-go/go-alchemy-sdk/internal"
+	"github.com/poteto-go/go-alchemy-sdk/types"
+)
+
+type Config struct {
+	// ComputeUnitsPerSecond is the sustained CU rate; 0 means no rate limit.
+	ComputeUnitsPerSecond float64
+
+	// Burst is how many CUs may be spent at once; 0 uses one second of
+	// ComputeUnitsPerSecond.
+	Burst float64
+
+	// DailyBudget caps the CUs spent per UTC day; 0 means no budget.
+	DailyBudget int64
+
+	// FailFast returns a *types.ComputeUnitError instead of waiting.
+	FailFast bool
+
+	// ComputeUnits overrides or adds to DefaultComputeUnits.
+	ComputeUnits map[string]int
+}
+
+type Limiter struct {
+	mu sync.Mutex
+
+	rate     float64
+	burst    float64
+	budget   int64
+	failFast bool
+	costs    map[string]int
+
+	tokens float64
+	last   time.Time
+	day    time.Time
+	used   int64
+
+	now func() time.Time
+}
+
+func New(config Config) *Limiter {
+	costs := maps.Clone(DefaultComputeUnits)
+	maps.Copy(costs, config.ComputeUnits)
+
+	burst := config.Burst
+	if burst <= 0 {
+		burst = config.ComputeUnitsPerSecond
+	}
+
+	return &Limiter{
+		rate:     config.ComputeUnitsPerSecond,
+		burst:    burst,
+		budget:   config.DailyBudget,
+		failFast: config.FailFast,
+		costs:    costs,
+		tokens:   burst,
+		now:      time.Now,
+	}
+}
+
+// Used returns the CUs spent since 00:00 UTC.
+func (l *Limiter) Used() int64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.rollDay(l.now())
+	return l.used
+}
+
+/*
+Middleware returns the types.Middleware that charges every call. gas wires
+it after the cache, so cache hits are free.
+*/
+func (l *Limiter) Middleware() types.Middleware {
+	return func(next types.RpcHandler) types.RpcHandler {
+		return func(ctx context.Context, req types.RpcRequest) (any, error) {
+			cost := l.Cost(req)
+			if err := l.wait(ctx, req.Method, cost); err != nil {
+				return nil, err
+			}
+
+			ctx = internal.WithRetryObserver(ctx, func(error) {
+				l.charge(cost)
+			})
+			return next(ctx, req)
+		}
+	}
+}
+
+// wait blocks until cost fits, or ctx is done.
+func (l *Limiter) wait(ctx context.Context, method string, cost int) error {
+	for {
+		delay, err := l.reserve(method, cost)
+		if err != nil || delay == 0 {
+			return err
+		}
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// reserve takes cost if it fits, else returns how long until it may.
+func (l *Limiter) reserve(method string, cost int) (time.Duration, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.rollDay(now)
+
+	if l.budget > 0 && l.used+int64(cost) > l.budget {
+		retryAfter := l.day.AddDate(0, 0, 1).Sub(now)
+		if l.failFast || int64(cost) > l.budget {
+			return 0, &types.ComputeUnitError{
+				Method:     method,
+				Cost:       cost,
+				RetryAfter: retryAfter,
+				Err:        constant.ErrComputeUnitBudgetExceeded,
+			}
+		}
+		return retryAfter, nil
+	}
+
+	if l.rate > 0 {
+		l.refill(now)
+		// a call costing more than the burst goes once the bucket is full
+		need := min(float64(cost), l.burst)
+		if l.tokens < need {
+			retryAfter := time.Duration((need - l.tokens) / l.rate * float64(time.Second))
+			if l.failFast {
+				return 0, &types.ComputeUnitError{
+					Method:     method,
+					Cost:       cost,
+					RetryAfter: retryAfter,
+					Err:        constant.ErrComputeUnitRateExceeded,
+				}
+			}
+			return max(retryAfter, time.Millisecond), nil
+		}
+		l.tokens -= float64(cost)
+	}
+
+	l.used += int64(cost)
+	return 0, nil
+}
+
+// charge takes cost for a call already on its way, e.g. a backoff retry.
+func (l *Limiter) charge(cost int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	l.rollDay(now)
+	if l.rate > 0 {
+		l.refill(now)
+		l.tokens -= float64(cost)
+	}
+	l.used += int64(cost)
+}
+
+func (l *Limiter) refill(now time.Time) {
+	if !l.last.IsZero() {
+		l.tokens = min(l.burst, l.tokens+now.Sub(l.last).Seconds()*l.rate)
+	}
+	l.last = now
+}
+
+func (l *Limiter) rollDay(now time.Time) {
+	day := now.UTC().Truncate(24 * time.Hour)
+	if day.After(l.day) {
+		l.day = day
+		l.used = 0
+	}
+}
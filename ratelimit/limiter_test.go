@@ -0,0 +1,200 @@
+package ratelimit
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/poteto-go/go-alchemy-sdk/constant"
+	"github.com/poteto-go/go-alchemy-sdk/internal"
+	"github.com/poteto-go/go-alchemy-sdk/types"
+)
+
+// fakeClock is a Limiter.now that only moves when told to.
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time { return c.now }
+
+func (c *fakeClock) Advance(d time.Duration) { c.now = c.now.Add(d) }
+
+func newLimiterForTest(config Config) (*Limiter, *fakeClock) {
+	clock := &fakeClock{now: time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)}
+	limiter := New(config)
+	limiter.now = clock.Now
+	return limiter, clock
+}
+
+func okHandler(ctx context.Context, req types.RpcRequest) (any, error) {
+	return "0x1", nil
+}
+
+func TestLimiter_Cost(t *testing.T) {
+	t.Run("known, unknown and overridden methods", func(t *testing.T) {
+		// Arrange
+		limiter := New(Config{ComputeUnits: map[string]int{constant.Eth_Call: 40, "custom_method": 5}})
+
+		// Act & Assert
+		assert.Equal(t, 75, limiter.Cost(types.RpcRequest{Method: constant.Eth_GetLogs}))
+		assert.Equal(t, 40, limiter.Cost(types.RpcRequest{Method: constant.Eth_Call}))
+		assert.Equal(t, 5, limiter.Cost(types.RpcRequest{Method: "custom_method"}))
+		assert.Equal(t, DefaultComputeUnitCost, limiter.Cost(types.RpcRequest{Method: "unknown_method"}))
+		assert.Equal(t, 26, DefaultComputeUnits[constant.Eth_Call])
+	})
+
+	t.Run("a batch costs the sum of its calls", func(t *testing.T) {
+		// Arrange
+		limiter := New(Config{})
+		elems := []rpc.BatchElem{{Method: constant.Eth_BlockNumber}, {Method: constant.Eth_GetLogs}}
+
+		// Act
+		cost := limiter.Cost(types.RpcRequest{Method: constant.Rpc_Batch, Params: types.RequestArgs{elems}})
+
+		// Assert
+		assert.Equal(t, 85, cost)
+	})
+}
+
+func TestLimiter_Middleware_FailFast(t *testing.T) {
+	t.Run("rate limit", func(t *testing.T) {
+		// Arrange
+		limiter, clock := newLimiterForTest(Config{ComputeUnitsPerSecond: 100, FailFast: true})
+		handler := limiter.Middleware()(okHandler)
+		req := types.RpcRequest{Method: constant.Eth_GetLogs}
+
+		// Act
+		_, err1 := handler(context.Background(), req)
+		_, err2 := handler(context.Background(), req)
+		clock.Advance(500 * time.Millisecond)
+		_, err3 := handler(context.Background(), req)
+
+		// Assert
+		assert.NoError(t, err1)
+		assert.ErrorIs(t, err2, constant.ErrComputeUnitRateExceeded)
+		var cuErr *types.ComputeUnitError
+		assert.True(t, errors.As(err2, &cuErr))
+		assert.Equal(t, constant.Eth_GetLogs, cuErr.Method)
+		assert.Equal(t, 75, cuErr.Cost)
+		assert.Equal(t, 500*time.Millisecond, cuErr.RetryAfter)
+		assert.NoError(t, err3)
+		assert.Equal(t, int64(150), limiter.Used())
+	})
+
+	t.Run("daily budget resets at 00:00 UTC", func(t *testing.T) {
+		// Arrange
+		limiter, clock := newLimiterForTest(Config{DailyBudget: 100, FailFast: true})
+		handler := limiter.Middleware()(okHandler)
+		req := types.RpcRequest{Method: constant.Eth_GetLogs}
+
+		// Act
+		_, err1 := handler(context.Background(), req)
+		_, err2 := handler(context.Background(), req)
+		clock.Advance(12 * time.Hour)
+		_, err3 := handler(context.Background(), req)
+
+		// Assert
+		assert.NoError(t, err1)
+		assert.ErrorIs(t, err2, constant.ErrComputeUnitBudgetExceeded)
+		var cuErr *types.ComputeUnitError
+		assert.True(t, errors.As(err2, &cuErr))
+		assert.Equal(t, 12*time.Hour, cuErr.RetryAfter)
+		assert.NoError(t, err3)
+		assert.Equal(t, int64(75), limiter.Used())
+	})
+
+	t.Run("a call over the whole budget fails even when waiting", func(t *testing.T) {
+		// Arrange
+		limiter, _ := newLimiterForTest(Config{DailyBudget: 50})
+		handler := limiter.Middleware()(okHandler)
+
+		// Act
+		_, err := handler(context.Background(), types.RpcRequest{Method: constant.Eth_GetLogs})
+
+		// Assert
+		assert.ErrorIs(t, err, constant.ErrComputeUnitBudgetExceeded)
+	})
+}
+
+func TestLimiter_Middleware_Wait(t *testing.T) {
+	t.Run("waits for the bucket to refill", func(t *testing.T) {
+		// Arrange
+		limiter := New(Config{ComputeUnitsPerSecond: 1000, Burst: 75})
+		handler := limiter.Middleware()(okHandler)
+		req := types.RpcRequest{Method: constant.Eth_GetLogs}
+
+		// Act
+		start := time.Now()
+		_, err1 := handler(context.Background(), req)
+		_, err2 := handler(context.Background(), req)
+		elapsed := time.Since(start)
+
+		// Assert
+		assert.NoError(t, err1)
+		assert.NoError(t, err2)
+		assert.GreaterOrEqual(t, elapsed, 70*time.Millisecond)
+	})
+
+	t.Run("a call over the burst goes once the bucket is full", func(t *testing.T) {
+		// Arrange
+		limiter := New(Config{ComputeUnitsPerSecond: 10})
+		handler := limiter.Middleware()(okHandler)
+
+		// Act
+		_, err := handler(context.Background(), types.RpcRequest{Method: constant.Eth_GetLogs})
+
+		// Assert
+		assert.NoError(t, err)
+	})
+
+	t.Run("stops waiting when ctx is done", func(t *testing.T) {
+		// Arrange
+		limiter := New(Config{ComputeUnitsPerSecond: 1, Burst: 26})
+		handler := limiter.Middleware()(okHandler)
+		req := types.RpcRequest{Method: constant.Eth_Call}
+		_, err := handler(context.Background(), req)
+		assert.NoError(t, err)
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+
+		// Act
+		_, err = handler(ctx, req)
+
+		// Assert
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Equal(t, int64(26), limiter.Used())
+	})
+}
+
+func TestLimiter_Middleware_Retries(t *testing.T) {
+	t.Run("backoff retries are charged", func(t *testing.T) {
+		// Arrange
+		limiter, _ := newLimiterForTest(Config{ComputeUnitsPerSecond: 1000})
+		callCount := 0
+		handler := limiter.Middleware()(func(ctx context.Context, req types.RpcRequest) (any, error) {
+			return internal.GethRequestWithBackOff(
+				ctx,
+				&types.BackoffConfig{Mode: "exponential", MaxRetries: 3, InitialDelayMs: 1, MaxDelayMs: 1},
+				time.Second,
+				func(context.Context) (any, error) {
+					callCount++
+					if callCount < 3 {
+						return nil, errors.New("test error")
+					}
+					return "0x1", nil
+				},
+			)
+		})
+
+		// Act
+		_, err := handler(context.Background(), types.RpcRequest{Method: constant.Eth_Call})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, int64(3*26), limiter.Used())
+	})
+}
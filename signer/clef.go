@@ -0,0 +1,65 @@
+package signer
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/external"
+	"github.com/ethereum/go-ethereum/common"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/poteto-go/go-alchemy-sdk/constant"
+	"github.com/poteto-go/go-alchemy-sdk/types"
+)
+
+var _ types.Signer = (*Clef)(nil)
+
+/*
+Clef signs with an account of a geth Clef external signer, over its JSON-RPC
+(account_signTransaction), so the key stays in Clef and each request follows
+its rules or waits for approval.
+
+Clef signs no raw hash: SignHash, and so Wallet.SignEIP712 and
+StableCoin().Permit, return constant.ErrSignHashUnsupported.
+*/
+type Clef struct {
+	clef    *external.ExternalSigner
+	account accounts.Account
+}
+
+// NewClef connects to the Clef at endpoint (http(s), ws(s) or an IPC path),
+// to sign with its account of address.
+func NewClef(endpoint string, address string) (*Clef, error) {
+	clef, err := external.NewExternalSigner(endpoint)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Clef{
+		clef:    clef,
+		account: accounts.Account{Address: common.HexToAddress(address)},
+	}, nil
+}
+
+func (s *Clef) Address() common.Address {
+	return s.account.Address
+}
+
+func (s *Clef) SignHash(common.Hash) ([]byte, error) {
+	return nil, constant.ErrSignHashUnsupported
+}
+
+func (s *Clef) SignTx(tx *gethTypes.Transaction, chainID *big.Int) (*gethTypes.Transaction, error) {
+	signedTx, err := s.clef.SignTx(s.account, tx, chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	sender, err := gethTypes.Sender(gethTypes.LatestSignerForChainID(chainID), signedTx)
+	if err != nil {
+		return nil, err
+	}
+	if sender != s.account.Address {
+		return nil, constant.ErrSignerAddressMismatch
+	}
+	return signedTx, nil
+}
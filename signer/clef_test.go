@@ -0,0 +1,134 @@
+package signer_test
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"math/big"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/poteto-go/go-alchemy-sdk/constant"
+	"github.com/poteto-go/go-alchemy-sdk/signer"
+	"github.com/stretchr/testify/assert"
+)
+
+// clefStandIn serves the account_ API of Clef that signer.Clef calls, and
+// signs with key (or rejects, if reject).
+type clefStandIn struct {
+	key    *ecdsa.PrivateKey
+	reject bool
+}
+
+type clefSignTxResult struct {
+	Raw hexutil.Bytes          `json:"raw"`
+	Tx  *gethTypes.Transaction `json:"tx"`
+}
+
+func (c *clefStandIn) Version() string {
+	return "7.0.1"
+}
+
+func (c *clefStandIn) SignTransaction(args apitypes.SendTxArgs) (*clefSignTxResult, error) {
+	if c.reject {
+		return nil, errors.New("request denied")
+	}
+
+	tx, err := args.ToTransaction()
+	if err != nil {
+		return nil, err
+	}
+	signedTx, err := gethTypes.SignTx(tx, gethTypes.LatestSignerForChainID(args.ChainID.ToInt()), c.key)
+	if err != nil {
+		return nil, err
+	}
+	raw, err := signedTx.MarshalBinary()
+	if err != nil {
+		return nil, err
+	}
+	return &clefSignTxResult{Raw: raw, Tx: signedTx}, nil
+}
+
+func newClefStandIn(t *testing.T, clef *clefStandIn) string {
+	t.Helper()
+
+	server := rpc.NewServer()
+	assert.NoError(t, server.RegisterName("account", clef))
+	httpServer := httptest.NewServer(server)
+	t.Cleanup(func() {
+		httpServer.Close()
+		server.Stop()
+	})
+	return httpServer.URL
+}
+
+func TestClef(t *testing.T) {
+	t.Run("signs txs by Clef", func(t *testing.T) {
+		// Arrange
+		endpoint := newClefStandIn(t, &clefStandIn{key: testKey})
+		s, err := signer.NewClef(endpoint, testAddr.Hex())
+		assert.NoError(t, err)
+		tx := newTestTx()
+
+		// Act
+		signedTx, err := s.SignTx(tx, big.NewInt(1))
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, testAddr, s.Address())
+		assertSignedBy(t, tx, signedTx)
+	})
+
+	t.Run("if Clef rejects, return error", func(t *testing.T) {
+		// Arrange
+		endpoint := newClefStandIn(t, &clefStandIn{key: testKey, reject: true})
+		s, _ := signer.NewClef(endpoint, testAddr.Hex())
+
+		// Act
+		_, err := s.SignTx(newTestTx(), big.NewInt(1))
+
+		// Assert
+		assert.ErrorContains(t, err, "request denied")
+	})
+
+	t.Run("if Clef signs by another account, return ErrSignerAddressMismatch", func(t *testing.T) {
+		// Arrange
+		other, _ := crypto.GenerateKey()
+		endpoint := newClefStandIn(t, &clefStandIn{key: other})
+		s, _ := signer.NewClef(endpoint, testAddr.Hex())
+
+		// Act
+		_, err := s.SignTx(newTestTx(), big.NewInt(1))
+
+		// Assert
+		assert.ErrorIs(t, err, constant.ErrSignerAddressMismatch)
+	})
+
+	t.Run("SignHash returns ErrSignHashUnsupported", func(t *testing.T) {
+		// Arrange
+		endpoint := newClefStandIn(t, &clefStandIn{key: testKey})
+		s, _ := signer.NewClef(endpoint, testAddr.Hex())
+
+		// Act
+		_, err := s.SignHash(testHash)
+
+		// Assert
+		assert.ErrorIs(t, err, constant.ErrSignHashUnsupported)
+	})
+
+	t.Run("if Clef is not reachable, return error", func(t *testing.T) {
+		// Arrange
+		httpServer := httptest.NewServer(nil)
+		httpServer.Close()
+
+		// Act
+		_, err := signer.NewClef(httpServer.URL, testAddr.Hex())
+
+		// Assert
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,145 @@
+package signer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/poteto-go/go-alchemy-sdk/constant"
+	"github.com/poteto-go/go-alchemy-sdk/types"
+)
+
+var _ types.Signer = (*Remote)(nil)
+
+/*
+Remote signs with a key held by an HTTP remote signer, e.g. a service in front
+of a KMS. It only asks it to sign hashes; txs are signed by their signing hash.
+
+For each hash, it POSTs to the endpoint
+
+	{"address": "0x<address>", "hash": "0x<32 bytes>"}
+
+and expects a 2xx response
+
+	{"signature": "0x<65 bytes [R || S || V]>"}
+
+with V 0 / 1 or 27 / 28. A signature not by address returns
+constant.ErrSignerAddressMismatch.
+*/
+type Remote struct {
+	endpoint string
+	address  common.Address
+	client   *http.Client
+	header   http.Header
+}
+
+type RemoteOption struct {
+	// Client sends the requests; nil is a client with
+	// constant.DefaultRemoteSignerTimeout.
+	Client *http.Client
+
+	// Header is added to each request, e.g. Authorization.
+	Header http.Header
+}
+
+type remoteSignRequest struct {
+	Address common.Address `json:"address"`
+	Hash    common.Hash    `json:"hash"`
+}
+
+type remoteSignResponse struct {
+	Signature hexutil.Bytes `json:"signature"`
+}
+
+// NewRemote creates a Signer of the key of address at the remote signer at
+// endpoint. option may be nil.
+func NewRemote(endpoint string, address string, option *RemoteOption) *Remote {
+	var config RemoteOption
+	if option != nil {
+		config = *option
+	}
+	if config.Client == nil {
+		config.Client = &http.Client{Timeout: constant.DefaultRemoteSignerTimeout}
+	}
+
+	return &Remote{
+		endpoint: endpoint,
+		address:  common.HexToAddress(address),
+		client:   config.Client,
+		header:   config.Header,
+	}
+}
+
+func (s *Remote) Address() common.Address {
+	return s.address
+}
+
+func (s *Remote) SignHash(hash common.Hash) ([]byte, error) {
+	return s.SignHashContext(context.Background(), hash)
+}
+
+// SignHashContext is SignHash, whose request stops when ctx is done.
+func (s *Remote) SignHashContext(ctx context.Context, hash common.Hash) ([]byte, error) {
+	body, err := json.Marshal(remoteSignRequest{Address: s.address, Hash: hash})
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	for key, values := range s.header {
+		for _, value := range values {
+			req.Header.Add(key, value)
+		}
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := s.client.Do(req)
+	if err != nil {
+		return nil, errors.Join(constant.ErrRemoteSignerFailed, err)
+	}
+	defer res.Body.Close()
+
+	resBody, err := io.ReadAll(io.LimitReader(res.Body, constant.MaxRemoteSignerResponseBytes))
+	if err != nil {
+		return nil, errors.Join(constant.ErrRemoteSignerFailed, err)
+	}
+	if res.StatusCode/100 != 2 {
+		return nil, errors.Join(constant.ErrRemoteSignerFailed, fmt.Errorf("%s: %s", res.Status, bytes.TrimSpace(resBody)))
+	}
+
+	var result remoteSignResponse
+	if err := json.Unmarshal(resBody, &result); err != nil {
+		return nil, errors.Join(constant.ErrRemoteSignerFailed, err)
+	}
+
+	sig := []byte(result.Signature)
+	if len(sig) == crypto.SignatureLength && sig[crypto.RecoveryIDOffset] >= constant.ECDSALegacyVOffset {
+		sig[crypto.RecoveryIDOffset] -= constant.ECDSALegacyVOffset
+	}
+	if err := verifySignature(s.address, hash, sig); err != nil {
+		return nil, err
+	}
+	return sig, nil
+}
+
+func (s *Remote) SignTx(tx *gethTypes.Transaction, chainID *big.Int) (*gethTypes.Transaction, error) {
+	return s.SignTxContext(context.Background(), tx, chainID)
+}
+
+// SignTxContext is SignTx, whose request stops when ctx is done.
+func (s *Remote) SignTxContext(ctx context.Context, tx *gethTypes.Transaction, chainID *big.Int) (*gethTypes.Transaction, error) {
+	return signTxByHash(func(hash common.Hash) ([]byte, error) {
+		return s.SignHashContext(ctx, hash)
+	}, tx, chainID)
+}
@@ -0,0 +1,175 @@
+package signer_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/poteto-go/go-alchemy-sdk/constant"
+	"github.com/poteto-go/go-alchemy-sdk/signer"
+	"github.com/stretchr/testify/assert"
+)
+
+// newRemoteStandIn serves the protocol of signer.Remote, signing with key
+// after sign changes the signature; it records the requests in *requests.
+func newRemoteStandIn(t *testing.T, key *ecdsa.PrivateKey, sign func([]byte) []byte, requests *[]*http.Request) string {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		*requests = append(*requests, r)
+		if r.Header.Get("Authorization") != "Bearer token" {
+			http.Error(w, "unauthorized", http.StatusUnauthorized)
+			return
+		}
+
+		var req struct {
+			Address common.Address `json:"address"`
+			Hash    common.Hash    `json:"hash"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		assert.Equal(t, testAddr, req.Address)
+
+		sig, err := crypto.Sign(req.Hash.Bytes(), key)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]string{"signature": hexutil.Encode(sign(sig))})
+	}))
+	t.Cleanup(server.Close)
+	return server.URL
+}
+
+func asIs(sig []byte) []byte {
+	return sig
+}
+
+var authorized = &signer.RemoteOption{Header: http.Header{"Authorization": {"Bearer token"}}}
+
+func TestRemote(t *testing.T) {
+	t.Run("signs hashes & txs by the remote signer", func(t *testing.T) {
+		// Arrange
+		var requests []*http.Request
+		s := signer.NewRemote(newRemoteStandIn(t, testKey, asIs, &requests), testAddr.Hex(), authorized)
+		tx := newTestTx()
+
+		// Act
+		sig, hashErr := s.SignHash(testHash)
+		signedTx, txErr := s.SignTx(tx, big.NewInt(1))
+
+		// Assert
+		assert.NoError(t, hashErr)
+		expected, _ := crypto.Sign(testHash.Bytes(), testKey)
+		assert.Equal(t, expected, sig)
+		assert.NoError(t, txErr)
+		assertSignedBy(t, tx, signedTx)
+		assert.Len(t, requests, 2)
+		assert.Equal(t, http.MethodPost, requests[0].Method)
+		assert.Equal(t, "application/json", requests[0].Header.Get("Content-Type"))
+	})
+
+	t.Run("accepts a V of 27 / 28", func(t *testing.T) {
+		// Arrange
+		var requests []*http.Request
+		legacyV := func(sig []byte) []byte {
+			sig[crypto.RecoveryIDOffset] += 27
+			return sig
+		}
+		s := signer.NewRemote(newRemoteStandIn(t, testKey, legacyV, &requests), testAddr.Hex(), authorized)
+
+		// Act
+		sig, err := s.SignHash(testHash)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Less(t, sig[crypto.RecoveryIDOffset], byte(2))
+	})
+
+	t.Run("if the remote signer fails, return ErrRemoteSignerFailed", func(t *testing.T) {
+		// Arrange
+		var requests []*http.Request
+		s := signer.NewRemote(newRemoteStandIn(t, testKey, asIs, &requests), testAddr.Hex(), nil)
+
+		// Act
+		_, err := s.SignHash(testHash)
+
+		// Assert
+		assert.ErrorIs(t, err, constant.ErrRemoteSignerFailed)
+		assert.ErrorContains(t, err, "401 Unauthorized: unauthorized")
+	})
+
+	t.Run("if signed by another key, return ErrSignerAddressMismatch", func(t *testing.T) {
+		// Arrange
+		var requests []*http.Request
+		other, _ := crypto.GenerateKey()
+		s := signer.NewRemote(newRemoteStandIn(t, other, asIs, &requests), testAddr.Hex(), authorized)
+
+		// Act
+		_, err := s.SignTx(newTestTx(), big.NewInt(1))
+
+		// Assert
+		assert.ErrorIs(t, err, constant.ErrSignerAddressMismatch)
+	})
+
+	t.Run("if the signature is not 65 bytes, return ErrInvalidSignatureLength", func(t *testing.T) {
+		// Arrange
+		var requests []*http.Request
+		truncate := func(sig []byte) []byte {
+			return sig[:64]
+		}
+		s := signer.NewRemote(newRemoteStandIn(t, testKey, truncate, &requests), testAddr.Hex(), authorized)
+
+		// Act
+		_, err := s.SignHash(testHash)
+
+		// Assert
+		assert.ErrorIs(t, err, constant.ErrInvalidSignatureLength)
+	})
+
+	t.Run("stops the request when ctx is done", func(t *testing.T) {
+		// Arrange
+		release := make(chan struct{})
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			<-release
+		}))
+		t.Cleanup(server.Close)
+		t.Cleanup(func() { close(release) })
+		s := signer.NewRemote(server.URL, testAddr.Hex(), authorized)
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		// Act
+		_, err := s.SignTxContext(ctx, newTestTx(), big.NewInt(1))
+
+		// Assert
+		assert.ErrorIs(t, err, constant.ErrRemoteSignerFailed)
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+
+	t.Run("reads at most MaxRemoteSignerResponseBytes of the response", func(t *testing.T) {
+		// Arrange
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`{"signature":"0x` + strings.Repeat("00", constant.MaxRemoteSignerResponseBytes) + `"}`))
+		}))
+		t.Cleanup(server.Close)
+		s := signer.NewRemote(server.URL, testAddr.Hex(), authorized)
+
+		// Act
+		_, err := s.SignHash(testHash)
+
+		// Assert
+		assert.ErrorIs(t, err, constant.ErrRemoteSignerFailed)
+	})
+}
@@ -0,0 +1,84 @@
+/*
+Package signer has the types.Signer of a wallet's key:
+
+  - Local holds a private key in the process
+  - Clef asks a geth Clef external signer over JSON-RPC
+  - Remote asks an HTTP remote signer, e.g. in front of a KMS
+
+Build a wallet of any of them with wallet.FromSigner:
+
+	s, _ := signer.NewClef("http://localhost:8550", "0x...")
+	w := wallet.FromSigner(s)
+*/
+package signer
+
+import (
+	"crypto/ecdsa"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/poteto-go/go-alchemy-sdk/constant"
+	"github.com/poteto-go/go-alchemy-sdk/types"
+)
+
+var _ types.Signer = (*Local)(nil)
+
+type Local struct {
+	privateKey *ecdsa.PrivateKey
+	address    common.Address
+}
+
+// NewLocal creates a Signer of privateKey.
+func NewLocal(privateKey *ecdsa.PrivateKey) *Local {
+	return &Local{
+		privateKey: privateKey,
+		address:    crypto.PubkeyToAddress(privateKey.PublicKey),
+	}
+}
+
+func (s *Local) Address() common.Address {
+	return s.address
+}
+
+// PrivateKey returns the key, e.g. to export it to a keystore file.
+func (s *Local) PrivateKey() *ecdsa.PrivateKey {
+	return s.privateKey
+}
+
+func (s *Local) SignHash(hash common.Hash) ([]byte, error) {
+	return crypto.Sign(hash.Bytes(), s.privateKey)
+}
+
+func (s *Local) SignTx(tx *gethTypes.Transaction, chainID *big.Int) (*gethTypes.Transaction, error) {
+	return gethTypes.SignTx(tx, gethTypes.LatestSignerForChainID(chainID), s.privateKey)
+}
+
+// signTxByHash signs tx with the signature of its signing hash by signHash,
+// for signers that only sign hashes.
+func signTxByHash(signHash func(common.Hash) ([]byte, error), tx *gethTypes.Transaction, chainID *big.Int) (*gethTypes.Transaction, error) {
+	txSigner := gethTypes.LatestSignerForChainID(chainID)
+	sig, err := signHash(txSigner.Hash(tx))
+	if err != nil {
+		return nil, err
+	}
+	return tx.WithSignature(txSigner, sig)
+}
+
+// verifySignature checks that sig, of a signer out of the process, is a
+// signature of hash by address.
+func verifySignature(address common.Address, hash common.Hash, sig []byte) error {
+	if len(sig) != crypto.SignatureLength {
+		return constant.ErrInvalidSignatureLength
+	}
+
+	publicKey, err := crypto.SigToPub(hash.Bytes(), sig)
+	if err != nil {
+		return err
+	}
+	if crypto.PubkeyToAddress(*publicKey) != address {
+		return constant.ErrSignerAddressMismatch
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+package signer_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/poteto-go/go-alchemy-sdk/signer"
+	"github.com/stretchr/testify/assert"
+)
+
+const testPrivHex = "c3ac2a9d5b1d2e7c8e4d1e7a0ef5b1c0b5b9b5b7f1e0c3f5e9a7b1c3d5e7f901"
+
+var (
+	testKey, _ = crypto.HexToECDSA(testPrivHex)
+	testAddr   = crypto.PubkeyToAddress(testKey.PublicKey)
+	testTo     = common.HexToAddress("0x970e8128ab834e8eac17ab8e3812f010678cf792")
+	testHash   = crypto.Keccak256Hash([]byte("hash"))
+)
+
+func newTestTx() *gethTypes.Transaction {
+	return gethTypes.NewTx(&gethTypes.DynamicFeeTx{
+		ChainID:   big.NewInt(1),
+		Nonce:     3,
+		GasTipCap: big.NewInt(1),
+		GasFeeCap: big.NewInt(100),
+		Gas:       21000,
+		To:        &testTo,
+		Value:     big.NewInt(5),
+		Data:      []byte{0x12},
+	})
+}
+
+// assertSignedBy asserts that signedTx is tx, signed by testKey.
+func assertSignedBy(t *testing.T, tx, signedTx *gethTypes.Transaction) {
+	t.Helper()
+
+	txSigner := gethTypes.LatestSignerForChainID(big.NewInt(1))
+	sender, err := gethTypes.Sender(txSigner, signedTx)
+	assert.NoError(t, err)
+	assert.Equal(t, testAddr, sender)
+	assert.Equal(t, txSigner.Hash(tx), txSigner.Hash(signedTx))
+}
+
+func TestLocal(t *testing.T) {
+	t.Run("signs hashes & txs with its key", func(t *testing.T) {
+		// Arrange
+		s := signer.NewLocal(testKey)
+		tx := newTestTx()
+
+		// Act
+		sig, hashErr := s.SignHash(testHash)
+		signedTx, txErr := s.SignTx(tx, big.NewInt(1))
+
+		// Assert
+		assert.Equal(t, testAddr, s.Address())
+		assert.Equal(t, testKey, s.PrivateKey())
+		assert.NoError(t, hashErr)
+		publicKey, err := crypto.SigToPub(testHash.Bytes(), sig)
+		assert.NoError(t, err)
+		assert.Equal(t, testAddr, crypto.PubkeyToAddress(*publicKey))
+		assert.NoError(t, txErr)
+		assertSignedBy(t, tx, signedTx)
+	})
+}
@@ -0,0 +1,320 @@
+package telemetry
+
+import (
+	"context"
+	"iter"
+	"math/big"
+
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/poteto-go/go-alchemy-sdk/event"
+	"github.com/poteto-go/go-alchemy-sdk/namespace"
+	"github.com/poteto-go/go-alchemy-sdk/types"
+)
+
+type coreCtx struct {
+	t    *Telemetry
+	next namespace.ICoreCtx
+}
+
+// CoreCtx wraps next so every call produces a "Core.<Method>" span.
+func (t *Telemetry) CoreCtx(next namespace.ICoreCtx) namespace.ICoreCtx {
+	return &coreCtx{t: t, next: next}
+}
+
+// Core is CoreCtx for ctx-less calls, each one a root span.
+func (t *Telemetry) Core(next namespace.ICoreCtx) namespace.ICore {
+	return &core{ctx: t.CoreCtx(next)}
+}
+
+func (c *coreCtx) GetBlockNumber(ctx context.Context) (uint64, error) {
+	return traced(ctx, c.t, "Core.GetBlockNumber", func(ctx context.Context) (uint64, error) {
+		return c.next.GetBlockNumber(ctx)
+	})
+}
+
+func (c *coreCtx) GetGasPrice(ctx context.Context) (*big.Int, error) {
+	return traced(ctx, c.t, "Core.GetGasPrice", func(ctx context.Context) (*big.Int, error) {
+		return c.next.GetGasPrice(ctx)
+	})
+}
+
+func (c *coreCtx) SuggestGasTipCap(ctx context.Context) (*big.Int, error) {
+	return traced(ctx, c.t, "Core.SuggestGasTipCap", func(ctx context.Context) (*big.Int, error) {
+		return c.next.SuggestGasTipCap(ctx)
+	})
+}
+
+func (c *coreCtx) SuggestEIP1559Fees(ctx context.Context) (*big.Int, *big.Int, error) {
+	return tracedTuple(ctx, c.t, "Core.SuggestEIP1559Fees", func(ctx context.Context) (*big.Int, *big.Int, error) {
+		return c.next.SuggestEIP1559Fees(ctx)
+	})
+}
+
+func (c *coreCtx) PeerCount(ctx context.Context) (uint64, error) {
+	return traced(ctx, c.t, "Core.PeerCount", func(ctx context.Context) (uint64, error) {
+		return c.next.PeerCount(ctx)
+	})
+}
+
+func (c *coreCtx) GetBalance(ctx context.Context, address string, blockTag string) (*big.Int, error) {
+	return traced(ctx, c.t, "Core.GetBalance", func(ctx context.Context) (*big.Int, error) {
+		return c.next.GetBalance(ctx, address, blockTag)
+	})
+}
+
+func (c *coreCtx) GetCode(ctx context.Context, address string, arg types.BlockTagOrHash) (string, error) {
+	return traced(ctx, c.t, "Core.GetCode", func(ctx context.Context) (string, error) {
+		return c.next.GetCode(ctx, address, arg)
+	})
+}
+
+func (c *coreCtx) IsContractAddress(ctx context.Context, address string) bool {
+	ctx, span := c.t.start(ctx, "Core.IsContractAddress")
+	defer span.End()
+	return c.next.IsContractAddress(ctx, address)
+}
+
+func (c *coreCtx) GetTransaction(ctx context.Context, hash string) (*gethTypes.Transaction, bool, error) {
+	return tracedTuple(ctx, c.t, "Core.GetTransaction", func(ctx context.Context) (*gethTypes.Transaction, bool, error) {
+		return c.next.GetTransaction(ctx, hash)
+	})
+}
+
+func (c *coreCtx) GetStorageAt(ctx context.Context, address, position, blockTag string) (string, error) {
+	return traced(ctx, c.t, "Core.GetStorageAt", func(ctx context.Context) (string, error) {
+		return c.next.GetStorageAt(ctx, address, position, blockTag)
+	})
+}
+
+func (c *coreCtx) GetTokenBalances(ctx context.Context, address string, option *types.TokenBalanceOption) (types.TokenBalanceResponse, error) {
+	return traced(ctx, c.t, "Core.GetTokenBalances", func(ctx context.Context) (types.TokenBalanceResponse, error) {
+		return c.next.GetTokenBalances(ctx, address, option)
+	})
+}
+
+func (c *coreCtx) GetTokenMetadata(ctx context.Context, address string) (types.TokenMetadataResponse, error) {
+	return traced(ctx, c.t, "Core.GetTokenMetadata", func(ctx context.Context) (types.TokenMetadataResponse, error) {
+		return c.next.GetTokenMetadata(ctx, address)
+	})
+}
+
+func (c *coreCtx) GetLogs(ctx context.Context, filter types.Filter) ([]types.LogResponse, error) {
+	return traced(ctx, c.t, "Core.GetLogs", func(ctx context.Context) ([]types.LogResponse, error) {
+		return c.next.GetLogs(ctx, filter)
+	})
+}
+
+func (c *coreCtx) GetLogsRange(ctx context.Context, filter types.Filter, option *types.LogsRangeOption) ([]types.LogResponse, error) {
+	return traced(ctx, c.t, "Core.GetLogsRange", func(ctx context.Context) ([]types.LogResponse, error) {
+		return c.next.GetLogsRange(ctx, filter, option)
+	})
+}
+
+func (c *coreCtx) GetEvents(ctx context.Context, filter types.Filter, decoder *event.Decoder) ([]any, error) {
+	return traced(ctx, c.t, "Core.GetEvents", func(ctx context.Context) ([]any, error) {
+		return c.next.GetEvents(ctx, filter, decoder)
+	})
+}
+
+func (c *coreCtx) EstimateGas(ctx context.Context, tx types.TransactionRequest) (*big.Int, error) {
+	return traced(ctx, c.t, "Core.EstimateGas", func(ctx context.Context) (*big.Int, error) {
+		return c.next.EstimateGas(ctx, tx)
+	})
+}
+
+func (c *coreCtx) Call(ctx context.Context, tx types.TransactionRequest, blockTag string) (string, error) {
+	return traced(ctx, c.t, "Core.Call", func(ctx context.Context) (string, error) {
+		return c.next.Call(ctx, tx, blockTag)
+	})
+}
+
+func (c *coreCtx) GetTransactionReceipt(ctx context.Context, hash string) (*gethTypes.Receipt, error) {
+	return traced(ctx, c.t, "Core.GetTransactionReceipt", func(ctx context.Context) (*gethTypes.Receipt, error) {
+		return c.next.GetTransactionReceipt(ctx, hash)
+	})
+}
+
+func (c *coreCtx) GetTransactionReceipts(ctx context.Context, arg types.BlockNumberOrHash) ([]*gethTypes.Receipt, error) {
+	return traced(ctx, c.t, "Core.GetTransactionReceipts", func(ctx context.Context) ([]*gethTypes.Receipt, error) {
+		return c.next.GetTransactionReceipts(ctx, arg)
+	})
+}
+
+func (c *coreCtx) GetBlock(ctx context.Context, blockHashOrBlockTag types.BlockTagOrHash) (*gethTypes.Block, error) {
+	return traced(ctx, c.t, "Core.GetBlock", func(ctx context.Context) (*gethTypes.Block, error) {
+		return c.next.GetBlock(ctx, blockHashOrBlockTag)
+	})
+}
+
+func (c *coreCtx) ResolveName(ctx context.Context, name string) (string, error) {
+	return traced(ctx, c.t, "Core.ResolveName", func(ctx context.Context) (string, error) {
+		return c.next.ResolveName(ctx, name)
+	})
+}
+
+func (c *coreCtx) ResolveNameBy(ctx context.Context, registryAddress string, name string) (string, error) {
+	return traced(ctx, c.t, "Core.ResolveNameBy", func(ctx context.Context) (string, error) {
+		return c.next.ResolveNameBy(ctx, registryAddress, name)
+	})
+}
+
+func (c *coreCtx) LookupAddress(ctx context.Context, address string) (string, error) {
+	return traced(ctx, c.t, "Core.LookupAddress", func(ctx context.Context) (string, error) {
+		return c.next.LookupAddress(ctx, address)
+	})
+}
+
+func (c *coreCtx) LookupAddressBy(ctx context.Context, registryAddress string, address string) (string, error) {
+	return traced(ctx, c.t, "Core.LookupAddressBy", func(ctx context.Context) (string, error) {
+		return c.next.LookupAddressBy(ctx, registryAddress, address)
+	})
+}
+
+func (c *coreCtx) GetAssetTransfers(ctx context.Context, params types.AssetTransfersParams) (types.AssetTransfersResponse, error) {
+	return traced(ctx, c.t, "Core.GetAssetTransfers", func(ctx context.Context) (types.AssetTransfersResponse, error) {
+		return c.next.GetAssetTransfers(ctx, params)
+	})
+}
+
+// IterAssetTransfers is not traced as a whole: it lives as long as the
+// caller ranges over it. Its JSON-RPC calls still are.
+func (c *coreCtx) IterAssetTransfers(ctx context.Context, params types.AssetTransfersParams, option *types.PageOption) iter.Seq2[types.AssetTransfer, error] {
+	return c.next.IterAssetTransfers(ctx, params, option)
+}
+
+func (c *coreCtx) CollectAssetTransfers(ctx context.Context, params types.AssetTransfersParams, option *types.PageOption) ([]types.AssetTransfer, error) {
+	return traced(ctx, c.t, "Core.CollectAssetTransfers", func(ctx context.Context) ([]types.AssetTransfer, error) {
+		return c.next.CollectAssetTransfers(ctx, params, option)
+	})
+}
+
+// IterTokenBalances is not traced as a whole, as IterAssetTransfers.
+func (c *coreCtx) IterTokenBalances(ctx context.Context, address string, option *types.TokenBalanceOption, pageOption *types.PageOption) iter.Seq2[types.TokenBalance, error] {
+	return c.next.IterTokenBalances(ctx, address, option, pageOption)
+}
+
+func (c *coreCtx) CollectTokenBalances(ctx context.Context, address string, option *types.TokenBalanceOption, pageOption *types.PageOption) ([]types.TokenBalance, error) {
+	return traced(ctx, c.t, "Core.CollectTokenBalances", func(ctx context.Context) ([]types.TokenBalance, error) {
+		return c.next.CollectTokenBalances(ctx, address, option, pageOption)
+	})
+}
+
+type core struct {
+	ctx namespace.ICoreCtx
+}
+
+func (c *core) GetBlockNumber() (uint64, error) {
+	return c.ctx.GetBlockNumber(context.Background())
+}
+
+func (c *core) GetGasPrice() (*big.Int, error) {
+	return c.ctx.GetGasPrice(context.Background())
+}
+
+func (c *core) SuggestGasTipCap() (*big.Int, error) {
+	return c.ctx.SuggestGasTipCap(context.Background())
+}
+
+func (c *core) SuggestEIP1559Fees() (*big.Int, *big.Int, error) {
+	return c.ctx.SuggestEIP1559Fees(context.Background())
+}
+
+func (c *core) PeerCount() (uint64, error) {
+	return c.ctx.PeerCount(context.Background())
+}
+
+func (c *core) GetBalance(address string, blockTag string) (*big.Int, error) {
+	return c.ctx.GetBalance(context.Background(), address, blockTag)
+}
+
+func (c *core) GetCode(address string, arg types.BlockTagOrHash) (string, error) {
+	return c.ctx.GetCode(context.Background(), address, arg)
+}
+
+func (c *core) IsContractAddress(address string) bool {
+	return c.ctx.IsContractAddress(context.Background(), address)
+}
+
+func (c *core) GetTransaction(hash string) (*gethTypes.Transaction, bool, error) {
+	return c.ctx.GetTransaction(context.Background(), hash)
+}
+
+func (c *core) GetStorageAt(address, position, blockTag string) (string, error) {
+	return c.ctx.GetStorageAt(context.Background(), address, position, blockTag)
+}
+
+func (c *core) GetTokenBalances(address string, option *types.TokenBalanceOption) (types.TokenBalanceResponse, error) {
+	return c.ctx.GetTokenBalances(context.Background(), address, option)
+}
+
+func (c *core) GetTokenMetadata(address string) (types.TokenMetadataResponse, error) {
+	return c.ctx.GetTokenMetadata(context.Background(), address)
+}
+
+func (c *core) GetLogs(filter types.Filter) ([]types.LogResponse, error) {
+	return c.ctx.GetLogs(context.Background(), filter)
+}
+
+func (c *core) GetLogsRange(filter types.Filter, option *types.LogsRangeOption) ([]types.LogResponse, error) {
+	return c.ctx.GetLogsRange(context.Background(), filter, option)
+}
+
+func (c *core) GetEvents(filter types.Filter, decoder *event.Decoder) ([]any, error) {
+	return c.ctx.GetEvents(context.Background(), filter, decoder)
+}
+
+func (c *core) EstimateGas(tx types.TransactionRequest) (*big.Int, error) {
+	return c.ctx.EstimateGas(context.Background(), tx)
+}
+
+func (c *core) Call(tx types.TransactionRequest, blockTag string) (string, error) {
+	return c.ctx.Call(context.Background(), tx, blockTag)
+}
+
+func (c *core) GetTransactionReceipt(hash string) (*gethTypes.Receipt, error) {
+	return c.ctx.GetTransactionReceipt(context.Background(), hash)
+}
+
+func (c *core) GetTransactionReceipts(arg types.BlockNumberOrHash) ([]*gethTypes.Receipt, error) {
+	return c.ctx.GetTransactionReceipts(context.Background(), arg)
+}
+
+func (c *core) GetBlock(blockHashOrBlockTag types.BlockTagOrHash) (*gethTypes.Block, error) {
+	return c.ctx.GetBlock(context.Background(), blockHashOrBlockTag)
+}
+
+func (c *core) ResolveName(name string) (string, error) {
+	return c.ctx.ResolveName(context.Background(), name)
+}
+
+func (c *core) ResolveNameBy(registryAddress string, name string) (string, error) {
+	return c.ctx.ResolveNameBy(context.Background(), registryAddress, name)
+}
+
+func (c *core) LookupAddress(address string) (string, error) {
+	return c.ctx.LookupAddress(context.Background(), address)
+}
+
+func (c *core) LookupAddressBy(registryAddress string, address string) (string, error) {
+	return c.ctx.LookupAddressBy(context.Background(), registryAddress, address)
+}
+
+func (c *core) GetAssetTransfers(params types.AssetTransfersParams) (types.AssetTransfersResponse, error) {
+	return c.ctx.GetAssetTransfers(context.Background(), params)
+}
+
+func (c *core) IterAssetTransfers(params types.AssetTransfersParams, option *types.PageOption) iter.Seq2[types.AssetTransfer, error] {
+	return c.ctx.IterAssetTransfers(context.Background(), params, option)
+}
+
+func (c *core) CollectAssetTransfers(params types.AssetTransfersParams, option *types.PageOption) ([]types.AssetTransfer, error) {
+	return c.ctx.CollectAssetTransfers(context.Background(), params, option)
+}
+
+func (c *core) IterTokenBalances(address string, option *types.TokenBalanceOption, pageOption *types.PageOption) iter.Seq2[types.TokenBalance, error] {
+	return c.ctx.IterTokenBalances(context.Background(), address, option, pageOption)
+}
+
+func (c *core) CollectTokenBalances(address string, option *types.TokenBalanceOption, pageOption *types.PageOption) ([]types.TokenBalance, error) {
+	return c.ctx.CollectTokenBalances(context.Background(), address, option, pageOption)
+}
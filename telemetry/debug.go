@@ -0,0 +1,47 @@
+package telemetry
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/poteto-go/go-alchemy-sdk/namespace"
+)
+
+type debugCtx struct {
+	t    *Telemetry
+	next namespace.IDebugCtx
+}
+
+// DebugCtx wraps next so every call produces a "Debug.<Method>" span.
+func (t *Telemetry) DebugCtx(next namespace.IDebugCtx) namespace.IDebugCtx {
+	return &debugCtx{t: t, next: next}
+}
+
+// Debug is DebugCtx for ctx-less calls, each one a root span.
+func (t *Telemetry) Debug(next namespace.IDebugCtx) namespace.IDebug {
+	return &debug{ctx: t.DebugCtx(next)}
+}
+
+func (c *debugCtx) Snapshot(ctx context.Context) (*big.Int, error) {
+	return traced(ctx, c.t, "Debug.Snapshot", func(ctx context.Context) (*big.Int, error) {
+		return c.next.Snapshot(ctx)
+	})
+}
+
+func (c *debugCtx) RevertTo(ctx context.Context, snapshotId *big.Int) (bool, error) {
+	return traced(ctx, c.t, "Debug.RevertTo", func(ctx context.Context) (bool, error) {
+		return c.next.RevertTo(ctx, snapshotId)
+	})
+}
+
+type debug struct {
+	ctx namespace.IDebugCtx
+}
+
+func (c *debug) Snapshot() (*big.Int, error) {
+	return c.ctx.Snapshot(context.Background())
+}
+
+func (c *debug) RevertTo(snapshotId *big.Int) (bool, error) {
+	return c.ctx.RevertTo(context.Background(), snapshotId)
+}
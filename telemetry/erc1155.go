@@ -0,0 +1,67 @@
+package telemetry
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/poteto-go/go-alchemy-sdk/namespace"
+)
+
+type erc1155Ctx struct {
+	t    *Telemetry
+	next namespace.IErc1155Ctx
+}
+
+// ERC1155Ctx wraps next so every call produces a "ERC1155.<Method>" span.
+func (t *Telemetry) ERC1155Ctx(next namespace.IErc1155Ctx) namespace.IErc1155Ctx {
+	return &erc1155Ctx{t: t, next: next}
+}
+
+// ERC1155 is ERC1155Ctx for ctx-less calls, each one a root span.
+func (t *Telemetry) ERC1155(next namespace.IErc1155Ctx) namespace.IErc1155 {
+	return &erc1155{ctx: t.ERC1155Ctx(next)}
+}
+
+func (c *erc1155Ctx) IsApprovedForAll(ctx context.Context, contractAddress, owner, operator string) (bool, error) {
+	return traced(ctx, c.t, "ERC1155.IsApprovedForAll", func(ctx context.Context) (bool, error) {
+		return c.next.IsApprovedForAll(ctx, contractAddress, owner, operator)
+	})
+}
+
+func (c *erc1155Ctx) BalanceOfToken(ctx context.Context, contractAddress, account string, tokenId *big.Int) (*big.Int, error) {
+	return traced(ctx, c.t, "ERC1155.BalanceOfToken", func(ctx context.Context) (*big.Int, error) {
+		return c.next.BalanceOfToken(ctx, contractAddress, account, tokenId)
+	})
+}
+
+func (c *erc1155Ctx) BalanceOfBatch(ctx context.Context, contractAddress string, accounts []string, tokenIds []*big.Int) ([]*big.Int, error) {
+	return traced(ctx, c.t, "ERC1155.BalanceOfBatch", func(ctx context.Context) ([]*big.Int, error) {
+		return c.next.BalanceOfBatch(ctx, contractAddress, accounts, tokenIds)
+	})
+}
+
+func (c *erc1155Ctx) Uri(ctx context.Context, contractAddress string, tokenId *big.Int) (string, error) {
+	return traced(ctx, c.t, "ERC1155.Uri", func(ctx context.Context) (string, error) {
+		return c.next.Uri(ctx, contractAddress, tokenId)
+	})
+}
+
+type erc1155 struct {
+	ctx namespace.IErc1155Ctx
+}
+
+func (c *erc1155) IsApprovedForAll(contractAddress, owner, operator string) (bool, error) {
+	return c.ctx.IsApprovedForAll(context.Background(), contractAddress, owner, operator)
+}
+
+func (c *erc1155) BalanceOfToken(contractAddress, account string, tokenId *big.Int) (*big.Int, error) {
+	return c.ctx.BalanceOfToken(context.Background(), contractAddress, account, tokenId)
+}
+
+func (c *erc1155) BalanceOfBatch(contractAddress string, accounts []string, tokenIds []*big.Int) ([]*big.Int, error) {
+	return c.ctx.BalanceOfBatch(context.Background(), contractAddress, accounts, tokenIds)
+}
+
+func (c *erc1155) Uri(contractAddress string, tokenId *big.Int) (string, error) {
+	return c.ctx.Uri(context.Background(), contractAddress, tokenId)
+}
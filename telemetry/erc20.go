@@ -0,0 +1,87 @@
+package telemetry
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/poteto-go/go-alchemy-sdk/namespace"
+)
+
+type erc20Ctx struct {
+	t    *Telemetry
+	next namespace.IERC20Ctx
+}
+
+// ERC20Ctx wraps next so every call produces a "ERC20.<Method>" span.
+func (t *Telemetry) ERC20Ctx(next namespace.IERC20Ctx) namespace.IERC20Ctx {
+	return &erc20Ctx{t: t, next: next}
+}
+
+// ERC20 is ERC20Ctx for ctx-less calls, each one a root span.
+func (t *Telemetry) ERC20(next namespace.IERC20Ctx) namespace.IERC20 {
+	return &erc20{ctx: t.ERC20Ctx(next)}
+}
+
+func (c *erc20Ctx) BalanceOf(ctx context.Context, contractAddress, walletAddress string) (*big.Int, error) {
+	return traced(ctx, c.t, "ERC20.BalanceOf", func(ctx context.Context) (*big.Int, error) {
+		return c.next.BalanceOf(ctx, contractAddress, walletAddress)
+	})
+}
+
+func (c *erc20Ctx) TotalSupply(ctx context.Context, contractAddress string) (*big.Int, error) {
+	return traced(ctx, c.t, "ERC20.TotalSupply", func(ctx context.Context) (*big.Int, error) {
+		return c.next.TotalSupply(ctx, contractAddress)
+	})
+}
+
+func (c *erc20Ctx) Allowance(ctx context.Context, contractAddress, owner, spender string) (*big.Int, error) {
+	return traced(ctx, c.t, "ERC20.Allowance", func(ctx context.Context) (*big.Int, error) {
+		return c.next.Allowance(ctx, contractAddress, owner, spender)
+	})
+}
+
+func (c *erc20Ctx) Name(ctx context.Context, contractAddress string) (string, error) {
+	return traced(ctx, c.t, "ERC20.Name", func(ctx context.Context) (string, error) {
+		return c.next.Name(ctx, contractAddress)
+	})
+}
+
+func (c *erc20Ctx) Symbol(ctx context.Context, contractAddress string) (string, error) {
+	return traced(ctx, c.t, "ERC20.Symbol", func(ctx context.Context) (string, error) {
+		return c.next.Symbol(ctx, contractAddress)
+	})
+}
+
+func (c *erc20Ctx) Decimals(ctx context.Context, contractAddress string) (uint8, error) {
+	return traced(ctx, c.t, "ERC20.Decimals", func(ctx context.Context) (uint8, error) {
+		return c.next.Decimals(ctx, contractAddress)
+	})
+}
+
+type erc20 struct {
+	ctx namespace.IERC20Ctx
+}
+
+func (c *erc20) BalanceOf(contractAddress, walletAddress string) (*big.Int, error) {
+	return c.ctx.BalanceOf(context.Background(), contractAddress, walletAddress)
+}
+
+func (c *erc20) TotalSupply(contractAddress string) (*big.Int, error) {
+	return c.ctx.TotalSupply(context.Background(), contractAddress)
+}
+
+func (c *erc20) Allowance(contractAddress, owner, spender string) (*big.Int, error) {
+	return c.ctx.Allowance(context.Background(), contractAddress, owner, spender)
+}
+
+func (c *erc20) Name(contractAddress string) (string, error) {
+	return c.ctx.Name(context.Background(), contractAddress)
+}
+
+func (c *erc20) Symbol(contractAddress string) (string, error) {
+	return c.ctx.Symbol(context.Background(), contractAddress)
+}
+
+func (c *erc20) Decimals(contractAddress string) (uint8, error) {
+	return c.ctx.Decimals(context.Background(), contractAddress)
+}
@@ -0,0 +1,97 @@
+package telemetry
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/poteto-go/go-alchemy-sdk/namespace"
+)
+
+type nftCtx struct {
+	t    *Telemetry
+	next namespace.INftCtx
+}
+
+// NftCtx wraps next so every call produces a "Nft.<Method>" span.
+func (t *Telemetry) NftCtx(next namespace.INftCtx) namespace.INftCtx {
+	return &nftCtx{t: t, next: next}
+}
+
+// Nft is NftCtx for ctx-less calls, each one a root span.
+func (t *Telemetry) Nft(next namespace.INftCtx) namespace.INft {
+	return &nft{ctx: t.NftCtx(next)}
+}
+
+func (c *nftCtx) IsApprovedForAll(ctx context.Context, contractAddress, owner, operator string) (bool, error) {
+	return traced(ctx, c.t, "Nft.IsApprovedForAll", func(ctx context.Context) (bool, error) {
+		return c.next.IsApprovedForAll(ctx, contractAddress, owner, operator)
+	})
+}
+
+func (c *nftCtx) BalanceOf(ctx context.Context, contractAddress, owner string) (*big.Int, error) {
+	return traced(ctx, c.t, "Nft.BalanceOf", func(ctx context.Context) (*big.Int, error) {
+		return c.next.BalanceOf(ctx, contractAddress, owner)
+	})
+}
+
+func (c *nftCtx) OwnerOf(ctx context.Context, contractAddress string, tokenId *big.Int) (string, error) {
+	return traced(ctx, c.t, "Nft.OwnerOf", func(ctx context.Context) (string, error) {
+		return c.next.OwnerOf(ctx, contractAddress, tokenId)
+	})
+}
+
+func (c *nftCtx) TokenURI(ctx context.Context, contractAddress string, tokenId *big.Int) (string, error) {
+	return traced(ctx, c.t, "Nft.TokenURI", func(ctx context.Context) (string, error) {
+		return c.next.TokenURI(ctx, contractAddress, tokenId)
+	})
+}
+
+func (c *nftCtx) Name(ctx context.Context, contractAddress string) (string, error) {
+	return traced(ctx, c.t, "Nft.Name", func(ctx context.Context) (string, error) {
+		return c.next.Name(ctx, contractAddress)
+	})
+}
+
+func (c *nftCtx) Symbol(ctx context.Context, contractAddress string) (string, error) {
+	return traced(ctx, c.t, "Nft.Symbol", func(ctx context.Context) (string, error) {
+		return c.next.Symbol(ctx, contractAddress)
+	})
+}
+
+func (c *nftCtx) GetApproved(ctx context.Context, contractAddress string, tokenId *big.Int) (string, error) {
+	return traced(ctx, c.t, "Nft.GetApproved", func(ctx context.Context) (string, error) {
+		return c.next.GetApproved(ctx, contractAddress, tokenId)
+	})
+}
+
+type nft struct {
+	ctx namespace.INftCtx
+}
+
+func (c *nft) IsApprovedForAll(contractAddress, owner, operator string) (bool, error) {
+	return c.ctx.IsApprovedForAll(context.Background(), contractAddress, owner, operator)
+}
+
+func (c *nft) BalanceOf(contractAddress, owner string) (*big.Int, error) {
+	return c.ctx.BalanceOf(context.Background(), contractAddress, owner)
+}
+
+func (c *nft) OwnerOf(contractAddress string, tokenId *big.Int) (string, error) {
+	return c.ctx.OwnerOf(context.Background(), contractAddress, tokenId)
+}
+
+func (c *nft) TokenURI(contractAddress string, tokenId *big.Int) (string, error) {
+	return c.ctx.TokenURI(context.Background(), contractAddress, tokenId)
+}
+
+func (c *nft) Name(contractAddress string) (string, error) {
+	return c.ctx.Name(context.Background(), contractAddress)
+}
+
+func (c *nft) Symbol(contractAddress string) (string, error) {
+	return c.ctx.Symbol(context.Background(), contractAddress)
+}
+
+func (c *nft) GetApproved(contractAddress string, tokenId *big.Int) (string, error) {
+	return c.ctx.GetApproved(context.Background(), contractAddress, tokenId)
+}
@@ -0,0 +1,218 @@
+package telemetry
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/poteto-go/go-alchemy-sdk/namespace"
+)
+
+type stableCoinCtx struct {
+	t    *Telemetry
+	next namespace.IStableCoinCtx
+}
+
+// StableCoinCtx wraps next so every call produces a "StableCoin.<Method>" span.
+func (t *Telemetry) StableCoinCtx(next namespace.IStableCoinCtx) namespace.IStableCoinCtx {
+	return &stableCoinCtx{t: t, next: next}
+}
+
+// StableCoin is StableCoinCtx for ctx-less calls, each one a root span.
+func (t *Telemetry) StableCoin(next namespace.IStableCoinCtx) namespace.IStableCoin {
+	return &stableCoin{ctx: t.StableCoinCtx(next)}
+}
+
+func (c *stableCoinCtx) BalanceOf(ctx context.Context, contractAddress, walletAddress string) (*big.Int, error) {
+	return traced(ctx, c.t, "StableCoin.BalanceOf", func(ctx context.Context) (*big.Int, error) {
+		return c.next.BalanceOf(ctx, contractAddress, walletAddress)
+	})
+}
+
+func (c *stableCoinCtx) TotalSupply(ctx context.Context, contractAddress string) (*big.Int, error) {
+	return traced(ctx, c.t, "StableCoin.TotalSupply", func(ctx context.Context) (*big.Int, error) {
+		return c.next.TotalSupply(ctx, contractAddress)
+	})
+}
+
+func (c *stableCoinCtx) Allowance(ctx context.Context, contractAddress, owner, spender string) (*big.Int, error) {
+	return traced(ctx, c.t, "StableCoin.Allowance", func(ctx context.Context) (*big.Int, error) {
+		return c.next.Allowance(ctx, contractAddress, owner, spender)
+	})
+}
+
+func (c *stableCoinCtx) Name(ctx context.Context, contractAddress string) (string, error) {
+	return traced(ctx, c.t, "StableCoin.Name", func(ctx context.Context) (string, error) {
+		return c.next.Name(ctx, contractAddress)
+	})
+}
+
+func (c *stableCoinCtx) Symbol(ctx context.Context, contractAddress string) (string, error) {
+	return traced(ctx, c.t, "StableCoin.Symbol", func(ctx context.Context) (string, error) {
+		return c.next.Symbol(ctx, contractAddress)
+	})
+}
+
+func (c *stableCoinCtx) Decimals(ctx context.Context, contractAddress string) (uint8, error) {
+	return traced(ctx, c.t, "StableCoin.Decimals", func(ctx context.Context) (uint8, error) {
+		return c.next.Decimals(ctx, contractAddress)
+	})
+}
+
+func (c *stableCoinCtx) IsBlacklisted(ctx context.Context, contractAddress, address string) (bool, error) {
+	return traced(ctx, c.t, "StableCoin.IsBlacklisted", func(ctx context.Context) (bool, error) {
+		return c.next.IsBlacklisted(ctx, contractAddress, address)
+	})
+}
+
+func (c *stableCoinCtx) Paused(ctx context.Context, contractAddress string) (bool, error) {
+	return traced(ctx, c.t, "StableCoin.Paused", func(ctx context.Context) (bool, error) {
+		return c.next.Paused(ctx, contractAddress)
+	})
+}
+
+func (c *stableCoinCtx) Owner(ctx context.Context, contractAddress string) (common.Address, error) {
+	return traced(ctx, c.t, "StableCoin.Owner", func(ctx context.Context) (common.Address, error) {
+		return c.next.Owner(ctx, contractAddress)
+	})
+}
+
+func (c *stableCoinCtx) MasterMinter(ctx context.Context, contractAddress string) (common.Address, error) {
+	return traced(ctx, c.t, "StableCoin.MasterMinter", func(ctx context.Context) (common.Address, error) {
+		return c.next.MasterMinter(ctx, contractAddress)
+	})
+}
+
+func (c *stableCoinCtx) Pauser(ctx context.Context, contractAddress string) (common.Address, error) {
+	return traced(ctx, c.t, "StableCoin.Pauser", func(ctx context.Context) (common.Address, error) {
+		return c.next.Pauser(ctx, contractAddress)
+	})
+}
+
+func (c *stableCoinCtx) Blacklister(ctx context.Context, contractAddress string) (common.Address, error) {
+	return traced(ctx, c.t, "StableCoin.Blacklister", func(ctx context.Context) (common.Address, error) {
+		return c.next.Blacklister(ctx, contractAddress)
+	})
+}
+
+func (c *stableCoinCtx) Currency(ctx context.Context, contractAddress string) (string, error) {
+	return traced(ctx, c.t, "StableCoin.Currency", func(ctx context.Context) (string, error) {
+		return c.next.Currency(ctx, contractAddress)
+	})
+}
+
+func (c *stableCoinCtx) Version(ctx context.Context, contractAddress string) (string, error) {
+	return traced(ctx, c.t, "StableCoin.Version", func(ctx context.Context) (string, error) {
+		return c.next.Version(ctx, contractAddress)
+	})
+}
+
+func (c *stableCoinCtx) IsMinter(ctx context.Context, contractAddress, address string) (bool, error) {
+	return traced(ctx, c.t, "StableCoin.IsMinter", func(ctx context.Context) (bool, error) {
+		return c.next.IsMinter(ctx, contractAddress, address)
+	})
+}
+
+func (c *stableCoinCtx) MinterAllowance(ctx context.Context, contractAddress, address string) (*big.Int, error) {
+	return traced(ctx, c.t, "StableCoin.MinterAllowance", func(ctx context.Context) (*big.Int, error) {
+		return c.next.MinterAllowance(ctx, contractAddress, address)
+	})
+}
+
+func (c *stableCoinCtx) Nonces(ctx context.Context, contractAddress, ownerAddress string) (*big.Int, error) {
+	return traced(ctx, c.t, "StableCoin.Nonces", func(ctx context.Context) (*big.Int, error) {
+		return c.next.Nonces(ctx, contractAddress, ownerAddress)
+	})
+}
+
+func (c *stableCoinCtx) DomainSeparator(ctx context.Context, contractAddress string) ([32]byte, error) {
+	return traced(ctx, c.t, "StableCoin.DomainSeparator", func(ctx context.Context) ([32]byte, error) {
+		return c.next.DomainSeparator(ctx, contractAddress)
+	})
+}
+
+func (c *stableCoinCtx) AuthorizationState(ctx context.Context, contractAddress, authorizer string, nonce [32]byte) (bool, error) {
+	return traced(ctx, c.t, "StableCoin.AuthorizationState", func(ctx context.Context) (bool, error) {
+		return c.next.AuthorizationState(ctx, contractAddress, authorizer, nonce)
+	})
+}
+
+type stableCoin struct {
+	ctx namespace.IStableCoinCtx
+}
+
+func (c *stableCoin) BalanceOf(contractAddress, walletAddress string) (*big.Int, error) {
+	return c.ctx.BalanceOf(context.Background(), contractAddress, walletAddress)
+}
+
+func (c *stableCoin) TotalSupply(contractAddress string) (*big.Int, error) {
+	return c.ctx.TotalSupply(context.Background(), contractAddress)
+}
+
+func (c *stableCoin) Allowance(contractAddress, owner, spender string) (*big.Int, error) {
+	return c.ctx.Allowance(context.Background(), contractAddress, owner, spender)
+}
+
+func (c *stableCoin) Name(contractAddress string) (string, error) {
+	return c.ctx.Name(context.Background(), contractAddress)
+}
+
+func (c *stableCoin) Symbol(contractAddress string) (string, error) {
+	return c.ctx.Symbol(context.Background(), contractAddress)
+}
+
+func (c *stableCoin) Decimals(contractAddress string) (uint8, error) {
+	return c.ctx.Decimals(context.Background(), contractAddress)
+}
+
+func (c *stableCoin) IsBlacklisted(contractAddress, address string) (bool, error) {
+	return c.ctx.IsBlacklisted(context.Background(), contractAddress, address)
+}
+
+func (c *stableCoin) Paused(contractAddress string) (bool, error) {
+	return c.ctx.Paused(context.Background(), contractAddress)
+}
+
+func (c *stableCoin) Owner(contractAddress string) (common.Address, error) {
+	return c.ctx.Owner(context.Background(), contractAddress)
+}
+
+func (c *stableCoin) MasterMinter(contractAddress string) (common.Address, error) {
+	return c.ctx.MasterMinter(context.Background(), contractAddress)
+}
+
+func (c *stableCoin) Pauser(contractAddress string) (common.Address, error) {
+	return c.ctx.Pauser(context.Background(), contractAddress)
+}
+
+func (c *stableCoin) Blacklister(contractAddress string) (common.Address, error) {
+	return c.ctx.Blacklister(context.Background(), contractAddress)
+}
+
+func (c *stableCoin) Currency(contractAddress string) (string, error) {
+	return c.ctx.Currency(context.Background(), contractAddress)
+}
+
+func (c *stableCoin) Version(contractAddress string) (string, error) {
+	return c.ctx.Version(context.Background(), contractAddress)
+}
+
+func (c *stableCoin) IsMinter(contractAddress, address string) (bool, error) {
+	return c.ctx.IsMinter(context.Background(), contractAddress, address)
+}
+
+func (c *stableCoin) MinterAllowance(contractAddress, address string) (*big.Int, error) {
+	return c.ctx.MinterAllowance(context.Background(), contractAddress, address)
+}
+
+func (c *stableCoin) Nonces(contractAddress, ownerAddress string) (*big.Int, error) {
+	return c.ctx.Nonces(context.Background(), contractAddress, ownerAddress)
+}
+
+func (c *stableCoin) DomainSeparator(contractAddress string) ([32]byte, error) {
+	return c.ctx.DomainSeparator(context.Background(), contractAddress)
+}
+
+func (c *stableCoin) AuthorizationState(contractAddress, authorizer string, nonce [32]byte) (bool, error) {
+	return c.ctx.AuthorizationState(context.Background(), contractAddress, authorizer, nonce)
+}
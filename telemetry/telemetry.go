@@ -0,0 +1,223 @@
+/*
+Package telemetry instruments the SDK with OpenTelemetry.
+
+Enable it with gas.AlchemySetting.Telemetry. Every namespace call (e.g.
+Core.GetBalance, ERC20.BalanceOf) then produces a span, and every JSON-RPC
+call it makes a child span carrying the method, network, retry count and
+JSON-RPC error code. The JSON-RPC spans come from the middleware, so the
+calls made outside of a namespace (e.g. by a wallet or a contract binding)
+have them too. Alongside the spans it records:
+
+  - alchemy.rpc.duration: latency of each JSON-RPC call in seconds
+  - alchemy.rpc.errors: failed JSON-RPC calls, by error code
+  - alchemy.rpc.retries: backoff retries
+  - alchemy.batch.size: number of requests in each BatchCall (batch.Batcher.Send)
+
+Every metric is attributed with the method and network, so per-method latency
+and error rates per chain can be derived.
+*/
+package telemetry
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/ethereum/go-ethereum/rpc"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/metric"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/poteto-go/go-alchemy-sdk/constant"
+	"github.com/poteto-go/go-alchemy-sdk/internal"
+	"github.com/poteto-go/go-alchemy-sdk/types"
+)
+
+const instrumentationName = "github.com/poteto-go/go-alchemy-sdk"
+
+const (
+	AttrRpcSystem  = attribute.Key("rpc.system")
+	AttrRpcMethod  = attribute.Key("rpc.method")
+	AttrErrorCode  = attribute.Key("rpc.jsonrpc.error_code")
+	AttrNetwork    = attribute.Key("alchemy.network")
+	AttrRetryCount = attribute.Key("alchemy.retry_count")
+	AttrBatchSize  = attribute.Key("alchemy.batch_size")
+)
+
+// Config picks the providers to report to; a nil one falls back to the
+// otel global provider.
+type Config struct {
+	TracerProvider trace.TracerProvider
+	MeterProvider  metric.MeterProvider
+}
+
+type Telemetry struct {
+	tracer  trace.Tracer
+	network types.Network
+
+	rpcDuration metric.Float64Histogram
+	rpcErrors   metric.Int64Counter
+	rpcRetries  metric.Int64Counter
+	batchSize   metric.Int64Histogram
+}
+
+func New(config Config, network types.Network) (*Telemetry, error) {
+	tracerProvider := config.TracerProvider
+	if tracerProvider == nil {
+		tracerProvider = otel.GetTracerProvider()
+	}
+	meterProvider := config.MeterProvider
+	if meterProvider == nil {
+		meterProvider = otel.GetMeterProvider()
+	}
+	meter := meterProvider.Meter(instrumentationName)
+
+	rpcDuration, err := meter.Float64Histogram(
+		"alchemy.rpc.duration",
+		metric.WithDescription("Duration of JSON-RPC calls, backoff retries included."),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		return nil, err
+	}
+	rpcErrors, err := meter.Int64Counter(
+		"alchemy.rpc.errors",
+		metric.WithDescription("Number of failed JSON-RPC calls."),
+	)
+	if err != nil {
+		return nil, err
+	}
+	rpcRetries, err := meter.Int64Counter(
+		"alchemy.rpc.retries",
+		metric.WithDescription("Number of backoff retries of JSON-RPC calls."),
+	)
+	if err != nil {
+		return nil, err
+	}
+	batchSize, err := meter.Int64Histogram(
+		"alchemy.batch.size",
+		metric.WithDescription("Number of requests in a JSON-RPC batch."),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Telemetry{
+		tracer:      tracerProvider.Tracer(instrumentationName),
+		network:     network,
+		rpcDuration: rpcDuration,
+		rpcErrors:   rpcErrors,
+		rpcRetries:  rpcRetries,
+		batchSize:   batchSize,
+	}, nil
+}
+
+/*
+Middleware returns the types.Middleware that produces the JSON-RPC spans
+and metrics. gas wires it in front of AlchemySetting.Middlewares.
+*/
+func (t *Telemetry) Middleware() types.Middleware {
+	return func(next types.RpcHandler) types.RpcHandler {
+		return func(ctx context.Context, req types.RpcRequest) (any, error) {
+			ctx, span := t.tracer.Start(
+				ctx,
+				req.Method,
+				trace.WithSpanKind(trace.SpanKindClient),
+				trace.WithAttributes(
+					AttrRpcSystem.String("jsonrpc"),
+					AttrRpcMethod.String(req.Method),
+					AttrNetwork.String(string(t.network)),
+				),
+			)
+			defer span.End()
+
+			retries := 0
+			ctx = internal.WithRetryObserver(ctx, func(error) {
+				retries++
+			})
+
+			attrs := []attribute.KeyValue{
+				AttrRpcMethod.String(req.Method),
+				AttrNetwork.String(string(t.network)),
+			}
+			if req.Method == constant.Rpc_Batch {
+				size := batchSizeOf(req.Params)
+				span.SetAttributes(AttrBatchSize.Int(size))
+				t.batchSize.Record(ctx, int64(size), metric.WithAttributes(AttrNetwork.String(string(t.network))))
+			}
+
+			start := time.Now()
+			res, err := next(ctx, req)
+			elapsed := time.Since(start).Seconds()
+
+			span.SetAttributes(AttrRetryCount.Int(retries))
+			if retries > 0 {
+				t.rpcRetries.Add(ctx, int64(retries), metric.WithAttributes(attrs...))
+			}
+			if err != nil {
+				errAttrs := attrs
+				if code, ok := errorCode(err); ok {
+					span.SetAttributes(AttrErrorCode.Int(code))
+					errAttrs = append(errAttrs, AttrErrorCode.Int(code))
+				}
+				span.RecordError(err)
+				span.SetStatus(codes.Error, err.Error())
+				t.rpcErrors.Add(ctx, 1, metric.WithAttributes(errAttrs...))
+			}
+			t.rpcDuration.Record(ctx, elapsed, metric.WithAttributes(attrs...))
+			return res, err
+		}
+	}
+}
+
+// start opens the span of a namespace call, e.g. "Core.GetBalance".
+func (t *Telemetry) start(ctx context.Context, name string) (context.Context, trace.Span) {
+	return t.tracer.Start(
+		ctx,
+		name,
+		trace.WithAttributes(AttrNetwork.String(string(t.network))),
+	)
+}
+
+// traced runs call within a namespace span named name.
+func traced[T any](ctx context.Context, t *Telemetry, name string, call func(ctx context.Context) (T, error)) (T, error) {
+	ctx, span := t.start(ctx, name)
+	res, err := call(ctx)
+	end(span, err)
+	return res, err
+}
+
+// tracedTuple is traced for calls returning two values.
+func tracedTuple[T, U any](ctx context.Context, t *Telemetry, name string, call func(ctx context.Context) (T, U, error)) (T, U, error) {
+	ctx, span := t.start(ctx, name)
+	first, second, err := call(ctx)
+	end(span, err)
+	return first, second, err
+}
+
+func end(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// errorCode returns the JSON-RPC error code of err, if it carries one.
+func errorCode(err error) (int, bool) {
+	var rpcErr rpc.Error
+	if errors.As(err, &rpcErr) {
+		return rpcErr.ErrorCode(), true
+	}
+	return 0, false
+}
+
+func batchSizeOf(params types.RequestArgs) int {
+	if len(params) == 0 {
+		return 0
+	}
+	elems, _ := params[0].([]rpc.BatchElem)
+	return len(elems)
+}
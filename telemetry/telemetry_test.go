@@ -0,0 +1,267 @@
+package telemetry
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/poteto-go/go-alchemy-sdk/constant"
+	"github.com/poteto-go/go-alchemy-sdk/internal"
+	"github.com/poteto-go/go-alchemy-sdk/namespace"
+	"github.com/poteto-go/go-alchemy-sdk/types"
+)
+
+type rpcCodeError struct {
+	code int
+}
+
+func (e rpcCodeError) Error() string  { return "rpc error" }
+func (e rpcCodeError) ErrorCode() int { return e.code }
+
+func newTelemetryForTest(t *testing.T) (*Telemetry, *tracetest.SpanRecorder, *sdkmetric.ManualReader) {
+	recorder := tracetest.NewSpanRecorder()
+	reader := sdkmetric.NewManualReader()
+	telemetry, err := New(Config{
+		TracerProvider: sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)),
+		MeterProvider:  sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)),
+	}, types.EthMainnet)
+	assert.NoError(t, err)
+	return telemetry, recorder, reader
+}
+
+func collectMetrics(t *testing.T, reader *sdkmetric.ManualReader) map[string]metricdata.Aggregation {
+	var rm metricdata.ResourceMetrics
+	assert.NoError(t, reader.Collect(context.Background(), &rm))
+	metrics := map[string]metricdata.Aggregation{}
+	for _, scope := range rm.ScopeMetrics {
+		for _, m := range scope.Metrics {
+			metrics[m.Name] = m.Data
+		}
+	}
+	return metrics
+}
+
+func spanAttr(span sdktrace.ReadOnlySpan, key attribute.Key) (attribute.Value, bool) {
+	for _, kv := range span.Attributes() {
+		if kv.Key == key {
+			return kv.Value, true
+		}
+	}
+	return attribute.Value{}, false
+}
+
+func TestTelemetry_Middleware(t *testing.T) {
+	t.Run("records a client span and the duration of the call", func(t *testing.T) {
+		// Arrange
+		telemetry, recorder, reader := newTelemetryForTest(t)
+		handler := telemetry.Middleware()(func(ctx context.Context, req types.RpcRequest) (any, error) {
+			return "0x1", nil
+		})
+
+		// Act
+		res, err := handler(context.Background(), types.RpcRequest{Method: "eth_blockNumber"})
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, "0x1", res)
+		spans := recorder.Ended()
+		assert.Len(t, spans, 1)
+		assert.Equal(t, "eth_blockNumber", spans[0].Name())
+		assert.Equal(t, trace.SpanKindClient, spans[0].SpanKind())
+		method, _ := spanAttr(spans[0], AttrRpcMethod)
+		assert.Equal(t, "eth_blockNumber", method.AsString())
+		network, _ := spanAttr(spans[0], AttrNetwork)
+		assert.Equal(t, string(types.EthMainnet), network.AsString())
+		retries, _ := spanAttr(spans[0], AttrRetryCount)
+		assert.Equal(t, int64(0), retries.AsInt64())
+
+		metrics := collectMetrics(t, reader)
+		duration := metrics["alchemy.rpc.duration"].(metricdata.Histogram[float64])
+		assert.Len(t, duration.DataPoints, 1)
+		assert.Equal(t, uint64(1), duration.DataPoints[0].Count)
+		assert.NotContains(t, metrics, "alchemy.rpc.errors")
+	})
+
+	t.Run("records the json-rpc error code", func(t *testing.T) {
+		// Arrange
+		telemetry, recorder, reader := newTelemetryForTest(t)
+		handler := telemetry.Middleware()(func(ctx context.Context, req types.RpcRequest) (any, error) {
+			return nil, rpcCodeError{code: -32000}
+		})
+
+		// Act
+		_, err := handler(context.Background(), types.RpcRequest{Method: "eth_call"})
+
+		// Assert
+		assert.Error(t, err)
+		spans := recorder.Ended()
+		assert.Len(t, spans, 1)
+		assert.Equal(t, codes.Error, spans[0].Status().Code)
+		code, ok := spanAttr(spans[0], AttrErrorCode)
+		assert.True(t, ok)
+		assert.Equal(t, int64(-32000), code.AsInt64())
+
+		errorsSum := collectMetrics(t, reader)["alchemy.rpc.errors"].(metricdata.Sum[int64])
+		assert.Len(t, errorsSum.DataPoints, 1)
+		assert.Equal(t, int64(1), errorsSum.DataPoints[0].Value)
+		dpCode, ok := errorsSum.DataPoints[0].Attributes.Value(AttrErrorCode)
+		assert.True(t, ok)
+		assert.Equal(t, int64(-32000), dpCode.AsInt64())
+	})
+
+	t.Run("counts backoff retries", func(t *testing.T) {
+		// Arrange
+		telemetry, recorder, reader := newTelemetryForTest(t)
+		callCount := 0
+		handler := telemetry.Middleware()(func(ctx context.Context, req types.RpcRequest) (any, error) {
+			return internal.GethRequestWithBackOff(
+				ctx,
+				&types.BackoffConfig{Mode: "exponential", MaxRetries: 3, InitialDelayMs: 1, MaxDelayMs: 1},
+				time.Second,
+				func(context.Context) (any, error) {
+					callCount++
+					if callCount < 3 {
+						return nil, errors.New("test error")
+					}
+					return "0x1", nil
+				},
+			)
+		})
+
+		// Act
+		_, err := handler(context.Background(), types.RpcRequest{Method: "eth_blockNumber"})
+
+		// Assert
+		assert.NoError(t, err)
+		retries, _ := spanAttr(recorder.Ended()[0], AttrRetryCount)
+		assert.Equal(t, int64(2), retries.AsInt64())
+		retriesSum := collectMetrics(t, reader)["alchemy.rpc.retries"].(metricdata.Sum[int64])
+		assert.Equal(t, int64(2), retriesSum.DataPoints[0].Value)
+	})
+
+	t.Run("records the size of a batch", func(t *testing.T) {
+		// Arrange
+		telemetry, recorder, reader := newTelemetryForTest(t)
+		handler := telemetry.Middleware()(func(ctx context.Context, req types.RpcRequest) (any, error) {
+			return nil, nil
+		})
+		elems := []rpc.BatchElem{{Method: "eth_blockNumber"}, {Method: "eth_chainId"}, {Method: "eth_gasPrice"}}
+
+		// Act
+		_, err := handler(context.Background(), types.RpcRequest{Method: constant.Rpc_Batch, Params: types.RequestArgs{elems}})
+
+		// Assert
+		assert.NoError(t, err)
+		size, _ := spanAttr(recorder.Ended()[0], AttrBatchSize)
+		assert.Equal(t, int64(3), size.AsInt64())
+		batchSize := collectMetrics(t, reader)["alchemy.batch.size"].(metricdata.Histogram[int64])
+		assert.Equal(t, int64(3), batchSize.DataPoints[0].Sum)
+	})
+}
+
+type fakeCoreCtx struct {
+	namespace.ICoreCtx
+	getBalance func(ctx context.Context, address string, blockTag string) (*big.Int, error)
+}
+
+func (f *fakeCoreCtx) GetBalance(ctx context.Context, address string, blockTag string) (*big.Int, error) {
+	return f.getBalance(ctx, address, blockTag)
+}
+
+func TestTelemetry_Core(t *testing.T) {
+	t.Run("rpc spans are children of the namespace span", func(t *testing.T) {
+		// Arrange
+		telemetry, recorder, _ := newTelemetryForTest(t)
+		rpcHandler := telemetry.Middleware()(func(ctx context.Context, req types.RpcRequest) (any, error) {
+			return big.NewInt(1), nil
+		})
+		core := telemetry.Core(&fakeCoreCtx{
+			getBalance: func(ctx context.Context, address string, blockTag string) (*big.Int, error) {
+				res, err := rpcHandler(ctx, types.RpcRequest{Method: "eth_getBalance"})
+				return res.(*big.Int), err
+			},
+		})
+
+		// Act
+		balance, err := core.GetBalance("0xabc", "latest")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, big.NewInt(1), balance)
+		spans := recorder.Ended()
+		assert.Len(t, spans, 2)
+		rpcSpan, namespaceSpan := spans[0], spans[1]
+		assert.Equal(t, "eth_getBalance", rpcSpan.Name())
+		assert.Equal(t, "Core.GetBalance", namespaceSpan.Name())
+		assert.Equal(t, namespaceSpan.SpanContext().SpanID(), rpcSpan.Parent().SpanID())
+		assert.False(t, namespaceSpan.Parent().IsValid())
+	})
+
+	t.Run("ctx variant continues the caller's trace and records the error", func(t *testing.T) {
+		// Arrange
+		telemetry, recorder, _ := newTelemetryForTest(t)
+		parentCtx, parent := sdktrace.NewTracerProvider().Tracer("test").Start(context.Background(), "parent")
+		defer parent.End()
+		core := telemetry.CoreCtx(&fakeCoreCtx{
+			getBalance: func(ctx context.Context, address string, blockTag string) (*big.Int, error) {
+				return nil, constant.ErrResultIsNil
+			},
+		})
+
+		// Act
+		_, err := core.GetBalance(parentCtx, "0xabc", "latest")
+
+		// Assert
+		assert.ErrorIs(t, err, constant.ErrResultIsNil)
+		spans := recorder.Ended()
+		assert.Len(t, spans, 1)
+		assert.Equal(t, parent.SpanContext().SpanID(), spans[0].Parent().SpanID())
+		assert.Equal(t, codes.Error, spans[0].Status().Code)
+	})
+}
+
+type fakeTransact struct {
+	namespace.ITransact
+	revertReason func(ctx context.Context, txHash string) (*types.RevertError, error)
+}
+
+func (f *fakeTransact) RevertReason(ctx context.Context, txHash string) (*types.RevertError, error) {
+	return f.revertReason(ctx, txHash)
+}
+
+func TestTelemetry_Transact(t *testing.T) {
+	// Arrange
+	telemetry, recorder, _ := newTelemetryForTest(t)
+	rpcHandler := telemetry.Middleware()(func(ctx context.Context, req types.RpcRequest) (any, error) {
+		return nil, nil
+	})
+	transact := telemetry.Transact(&fakeTransact{
+		revertReason: func(ctx context.Context, txHash string) (*types.RevertError, error) {
+			_, err := rpcHandler(ctx, types.RpcRequest{Method: "eth_getTransactionByHash"})
+			return nil, err
+		},
+	})
+
+	// Act
+	_, err := transact.RevertReason(context.Background(), "0xabc")
+
+	// Assert
+	assert.NoError(t, err)
+	spans := recorder.Ended()
+	assert.Len(t, spans, 2)
+	rpcSpan, namespaceSpan := spans[0], spans[1]
+	assert.Equal(t, "Transact.RevertReason", namespaceSpan.Name())
+	assert.Equal(t, namespaceSpan.SpanContext().SpanID(), rpcSpan.Parent().SpanID())
+}
@@ -0,0 +1,45 @@
+package telemetry
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/poteto-go/go-alchemy-sdk/namespace"
+	"github.com/poteto-go/go-alchemy-sdk/types"
+)
+
+type transact struct {
+	t    *Telemetry
+	next namespace.ITransact
+}
+
+// Transact wraps next so every call produces a "Transact.<Method>" span,
+// which lasts as long as the wait.
+func (t *Telemetry) Transact(next namespace.ITransact) namespace.ITransact {
+	return &transact{t: t, next: next}
+}
+
+func (c *transact) WaitMined(ctx context.Context, txHash string) (*gethTypes.Receipt, error) {
+	return traced(ctx, c.t, "Transact.WaitMined", func(ctx context.Context) (*gethTypes.Receipt, error) {
+		return c.next.WaitMined(ctx, txHash)
+	})
+}
+
+func (c *transact) WaitMinedOrReplaced(ctx context.Context, txHash string) (*gethTypes.Receipt, error) {
+	return traced(ctx, c.t, "Transact.WaitMinedOrReplaced", func(ctx context.Context) (*gethTypes.Receipt, error) {
+		return c.next.WaitMinedOrReplaced(ctx, txHash)
+	})
+}
+
+func (c *transact) WaitDeployed(ctx context.Context, txHash string) (common.Address, error) {
+	return traced(ctx, c.t, "Transact.WaitDeployed", func(ctx context.Context) (common.Address, error) {
+		return c.next.WaitDeployed(ctx, txHash)
+	})
+}
+
+func (c *transact) RevertReason(ctx context.Context, txHash string) (*types.RevertError, error) {
+	return traced(ctx, c.t, "Transact.RevertReason", func(ctx context.Context) (*types.RevertError, error) {
+		return c.next.RevertReason(ctx, txHash)
+	})
+}
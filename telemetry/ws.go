@@ -0,0 +1,58 @@
+package telemetry
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/poteto-go/go-alchemy-sdk/event"
+	"github.com/poteto-go/go-alchemy-sdk/namespace"
+)
+
+type ws struct {
+	t    *Telemetry
+	next namespace.IWS
+}
+
+// WS wraps next so every subscribe produces a "WS.<Method>" span. The span
+// covers the eth_subscribe call, not the stream that follows.
+func (t *Telemetry) WS(next namespace.IWS) namespace.IWS {
+	return &ws{t: t, next: next}
+}
+
+func (c *ws) Subscribe(ctx context.Context, channel any, params ...any) (ethereum.Subscription, error) {
+	return traced(ctx, c.t, "WS.Subscribe", func(ctx context.Context) (ethereum.Subscription, error) {
+		return c.next.Subscribe(ctx, channel, params...)
+	})
+}
+
+func (c *ws) SubscribeNewHead(ctx context.Context, headerChan chan<- *gethTypes.Header) (ethereum.Subscription, error) {
+	return traced(ctx, c.t, "WS.SubscribeNewHead", func(ctx context.Context) (ethereum.Subscription, error) {
+		return c.next.SubscribeNewHead(ctx, headerChan)
+	})
+}
+
+func (c *ws) SubscribeLogs(ctx context.Context, query ethereum.FilterQuery, logChan chan<- gethTypes.Log) (ethereum.Subscription, error) {
+	return traced(ctx, c.t, "WS.SubscribeLogs", func(ctx context.Context) (ethereum.Subscription, error) {
+		return c.next.SubscribeLogs(ctx, query, logChan)
+	})
+}
+
+func (c *ws) SubscribeEvents(ctx context.Context, query ethereum.FilterQuery, decoder *event.Decoder, eventChan chan<- any) (ethereum.Subscription, error) {
+	return traced(ctx, c.t, "WS.SubscribeEvents", func(ctx context.Context) (ethereum.Subscription, error) {
+		return c.next.SubscribeEvents(ctx, query, decoder, eventChan)
+	})
+}
+
+func (c *ws) SubscribeContractLogs(ctx context.Context, contractAddress common.Address, logChan chan<- gethTypes.Log) (ethereum.Subscription, error) {
+	return traced(ctx, c.t, "WS.SubscribeContractLogs", func(ctx context.Context) (ethereum.Subscription, error) {
+		return c.next.SubscribeContractLogs(ctx, contractAddress, logChan)
+	})
+}
+
+func (c *ws) SubscribeTxReceipts(ctx context.Context, query *ethereum.TransactionReceiptsQuery, receiptsChan chan<- []*gethTypes.Receipt) (ethereum.Subscription, error) {
+	return traced(ctx, c.t, "WS.SubscribeTxReceipts", func(ctx context.Context) (ethereum.Subscription, error) {
+		return c.next.SubscribeTxReceipts(ctx, query, receiptsChan)
+	})
+}
@@ -68,19 +68,44 @@ refs: https://eips.ethereum.org/EIPS/eip-712
 func SignEIP712(
 	privateKey *ecdsa.PrivateKey, domainSeparator [32]byte, encoded []byte,
 ) (types.Signature, error) {
+	sig, err := crypto.Sign(eip712Hash(domainSeparator, encoded).Bytes(), privateKey)
+	if err != nil {
+		return types.Signature{}, err
+	}
+
+	return signatureOf(sig), nil
+}
+
+// SignEIP712BySigner is SignEIP712 by a types.Signer, for keys held out of the
+// process.
+func SignEIP712BySigner(
+	signer types.Signer, domainSeparator [32]byte, encoded []byte,
+) (types.Signature, error) {
+	sig, err := signer.SignHash(eip712Hash(domainSeparator, encoded))
+	if err != nil {
+		return types.Signature{}, err
+	}
+	if len(sig) != crypto.SignatureLength {
+		return types.Signature{}, constant.ErrInvalidSignatureLength
+	}
+
+	return signatureOf(sig), nil
+}
+
+// eip712Hash is the hash EIP-712 signs: keccak256("\x19\x01" ‖ domainSeparator ‖ keccak256(encoded)).
+func eip712Hash(domainSeparator [32]byte, encoded []byte) common.Hash {
 	structHash := crypto.Keccak256(encoded)
 
 	msg := make([]byte, 0, 2+constant.ABIWordSize*2)
 	msg = append(msg, constant.EIP191DataPrefix, constant.EIP712StructuredDataVersion)
 	msg = append(msg, domainSeparator[:]...)
 	msg = append(msg, structHash...)
-	hash := crypto.Keccak256(msg)
-
-	sig, err := crypto.Sign(hash, privateKey)
-	if err != nil {
-		return types.Signature{}, err
-	}
+	return crypto.Keccak256Hash(msg)
+}
 
+// signatureOf splits a 65-byte [R || S || V] signature of crypto.Sign, with
+// the legacy V of 27 or 28.
+func signatureOf(sig []byte) types.Signature {
 	var r, s [32]byte
 	copy(r[:], sig[:constant.ABIWordSize])
 	copy(s[:], sig[constant.ABIWordSize:constant.ABIWordSize*2])
@@ -90,5 +115,5 @@ func SignEIP712(
 		V: v,
 		R: r,
 		S: s,
-	}, nil
+	}
 }
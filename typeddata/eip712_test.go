@@ -4,10 +4,13 @@ import (
 	"math/big"
 	"testing"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/poteto-go/go-alchemy-sdk/constant"
 	"github.com/poteto-go/go-alchemy-sdk/encode"
+	"github.com/poteto-go/go-alchemy-sdk/signer"
 	"github.com/poteto-go/go-alchemy-sdk/typeddata"
+	"github.com/poteto-go/go-alchemy-sdk/types"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -204,3 +207,45 @@ func TestSignEIP712Str_matchesSignEIP712(t *testing.T) {
 
 	assert.Equal(t, sigFromKey, sigFromStr)
 }
+
+// hashSigner is a types.Signer that signs hashes with sign.
+type hashSigner struct {
+	types.Signer
+	sign func(hash common.Hash) ([]byte, error)
+}
+
+func (s hashSigner) SignHash(hash common.Hash) ([]byte, error) {
+	return s.sign(hash)
+}
+
+func TestSignEIP712BySigner_matchesSignEIP712(t *testing.T) {
+	privateKey, err := encode.PrivateKey(testPrivateKey)
+	assert.NoError(t, err)
+
+	var domainSeparator [32]byte
+	copy(domainSeparator[:], crypto.Keccak256([]byte("TestDomain")))
+	encoded := typeddata.EncodeWords(big.NewInt(999))
+
+	sigFromKey, err := typeddata.SignEIP712(privateKey, domainSeparator, encoded)
+	assert.NoError(t, err)
+
+	sigFromSigner, err := typeddata.SignEIP712BySigner(signer.NewLocal(privateKey), domainSeparator, encoded)
+	assert.NoError(t, err)
+
+	assert.Equal(t, sigFromKey, sigFromSigner)
+}
+
+func TestSignEIP712BySigner_signerError(t *testing.T) {
+	var domainSeparator [32]byte
+	encoded := typeddata.EncodeWords(big.NewInt(1))
+
+	_, err := typeddata.SignEIP712BySigner(hashSigner{sign: func(common.Hash) ([]byte, error) {
+		return nil, constant.ErrSignHashUnsupported
+	}}, domainSeparator, encoded)
+	assert.ErrorIs(t, err, constant.ErrSignHashUnsupported)
+
+	_, err = typeddata.SignEIP712BySigner(hashSigner{sign: func(common.Hash) ([]byte, error) {
+		return make([]byte, 64), nil
+	}}, domainSeparator, encoded)
+	assert.ErrorIs(t, err, constant.ErrInvalidSignatureLength)
+}
@@ -1,6 +1,7 @@
 package types
 
 import (
+	"context"
 	"errors"
 	"net/http"
 )
@@ -38,6 +39,9 @@ type IAlchemyProvider interface {
 
 	/* Send raw transaction */
 	Send(method string, params RequestArgs) (any, error)
+
+	// SendContext is Send bounded by ctx, backoff retries included.
+	SendContext(ctx context.Context, method string, params RequestArgs) (any, error)
 }
 
 type AlchemyFetchHandler func(*http.Client, AlchemyRequest, []byte) (AlchemyResponse, error)
@@ -1,5 +1,39 @@
 package types
 
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// AssetTransferCategory is a kind of transfer alchemy_getAssetTransfers
+// tells apart.
+type AssetTransferCategory string
+
+const (
+	CategoryExternal   = AssetTransferCategory("external")
+	CategoryInternal   = AssetTransferCategory("internal")
+	CategoryERC20      = AssetTransferCategory("erc20")
+	CategoryERC721     = AssetTransferCategory("erc721")
+	CategoryERC1155    = AssetTransferCategory("erc1155")
+	CategorySpecialNft = AssetTransferCategory("specialnft")
+)
+
+// AssetTransferOrder is the block order of the transfers.
+type AssetTransferOrder string
+
+const (
+	OrderAscending  = AssetTransferOrder("asc")
+	OrderDescending = AssetTransferOrder("desc")
+)
+
+// BlockNumber returns block n as the hex number FromBlock and ToBlock take.
+func BlockNumber(n uint64) string {
+	return fmt.Sprintf("0x%x", n)
+}
+
 // AssetTransfersParams is the input for GetAssetTransfers.
 // Category is required; all other fields are optional.
 // MaxCount of 0 uses the Alchemy API default (1000).
@@ -12,7 +46,8 @@ type AssetTransfersParams struct {
 	FromAddress       string
 	ToAddress         string
 	ContractAddresses []string
-	Category          []string // "external","internal","erc20","erc721","erc1155","specialnft"
+	Category          []AssetTransferCategory
+	Order             AssetTransferOrder // "" → API default (asc)
 	WithMetadata      bool
 	ExcludeZeroValue  bool
 	MaxCount          int // 0 → API default (1000)
@@ -31,7 +66,7 @@ type RawContract struct {
 }
 
 type AssetTransferMetadata struct {
-	BlockTimestamp string
+	BlockTimestamp time.Time
 }
 
 type AssetTransfer struct {
@@ -40,14 +75,36 @@ type AssetTransfer struct {
 	Hash            string
 	From            string
 	To              string
-	Value           *float64
+	Value           *float64 // rounded; use RawValue and Decimals for exact amounts
 	Erc721TokenId   string
 	Erc1155Metadata []Erc1155Metadata
 	TokenId         string
 	Asset           string
-	Category        string
+	Category        AssetTransferCategory
 	RawContract     RawContract
 	Metadata        *AssetTransferMetadata
+
+	// RawValue is RawContract.Value in the token's smallest unit (e.g. wei),
+	// exact; nil if the transfer has none, as an ERC-721 one.
+	RawValue *big.Int `mapstructure:"-"`
+
+	// Decimals is RawContract.Decimal; nil if the API does not know it.
+	Decimals *int `mapstructure:"-"`
+}
+
+// BlockNumber returns BlockNum as a number.
+func (t AssetTransfer) BlockNumber() (uint64, error) {
+	return strconv.ParseUint(strings.TrimPrefix(t.BlockNum, "0x"), 16, 64)
+}
+
+// Amount returns RawValue in whole tokens, exact: RawValue / 10^Decimals.
+// It is nil if RawValue or Decimals is.
+func (t AssetTransfer) Amount() *big.Rat {
+	if t.RawValue == nil || t.Decimals == nil {
+		return nil
+	}
+	unit := new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(*t.Decimals)), nil)
+	return new(big.Rat).SetFrac(t.RawValue, unit)
 }
 
 type AssetTransfersResponse struct {
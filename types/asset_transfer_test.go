@@ -0,0 +1,37 @@
+package types_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/poteto-go/go-alchemy-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBlockNumber(t *testing.T) {
+	assert.Equal(t, "0x0", types.BlockNumber(0))
+	assert.Equal(t, "0xf1d1c6", types.BlockNumber(15847878))
+}
+
+func TestAssetTransfer_Amount(t *testing.T) {
+	decimals := 6
+
+	t.Run("RawValue / 10^Decimals", func(t *testing.T) {
+		// Arrange
+		transfer := types.AssetTransfer{RawValue: big.NewInt(1_000_001), Decimals: &decimals}
+
+		// Act
+		amount := transfer.Amount()
+
+		// Assert
+		assert.Equal(t, "1.000001", amount.FloatString(6))
+	})
+
+	t.Run("nil without Decimals", func(t *testing.T) {
+		assert.Nil(t, types.AssetTransfer{RawValue: big.NewInt(1)}.Amount())
+	})
+
+	t.Run("nil without RawValue", func(t *testing.T) {
+		assert.Nil(t, types.AssetTransfer{Decimals: &decimals}.Amount())
+	})
+}
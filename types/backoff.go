@@ -5,6 +5,9 @@ type BackoffConfig struct {
 	MaxRetries     int     `yaml:"max_retries"`
 	InitialDelayMs float64 `yaml:"initial_delay_ms"`
 	MaxDelayMs     float64 `yaml:"max_delay_ms"`
+	// MaxRetryAfterMs caps the Retry-After a throttled call waits for
+	// (MaxDelayMs if 0); a longer one fails the call at once.
+	MaxRetryAfterMs float64 `yaml:"max_retry_after_ms"`
 }
 
 var DefaultBackoffConfig = BackoffConfig{
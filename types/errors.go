@@ -1,18 +1,26 @@
 package types
 
 import (
+	"encoding/json"
 	"fmt"
 	"math/big"
+	"strings"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
 )
 
 // RpcError wraps a JSON-RPC error with method context so callers can inspect
 // the method name and error code programmatically via errors.As.
+//
+// Data is the raw "data" member of the error object, e.g. the ABI-encoded
+// revert reason of an eth_call; nil when the node sent none.
 type RpcError struct {
 	Method  string
 	Code    int
 	Message string
+	Data    json.RawMessage
 	Err     error
 }
 
@@ -26,6 +34,62 @@ func (e *RpcError) Error() string {
 
 func (e *RpcError) Unwrap() error { return e.Err }
 
+// ErrorCode makes RpcError a geth rpc.Error, so the retry classification
+// treats it like any other JSON-RPC error.
+func (e *RpcError) ErrorCode() int { return e.Code }
+
+// ErrorData makes RpcError a geth rpc.DataError.
+func (e *RpcError) ErrorData() any {
+	if len(e.Data) == 0 {
+		return nil
+	}
+	return e.Data
+}
+
+// IsExecutionReverted reports whether the call or transaction reverted.
+func (e *RpcError) IsExecutionReverted() bool {
+	return e.Code == 3 || strings.HasPrefix(strings.ToLower(e.Message), "execution reverted")
+}
+
+// IsNonceTooLow reports whether the transaction's nonce is already used.
+func (e *RpcError) IsNonceTooLow() bool {
+	return strings.Contains(strings.ToLower(e.Message), "nonce too low")
+}
+
+// IsInsufficientFunds reports whether the sender cannot pay for gas * price + value.
+func (e *RpcError) IsInsufficientFunds() bool {
+	return strings.Contains(strings.ToLower(e.Message), "insufficient funds")
+}
+
+// IsLogsLimitExceeded reports whether eth_getLogs was refused for matching
+// too many logs or spanning too many blocks; a narrower range may succeed.
+// Only the known messages count: -32005 is also sent for a rate limit, and
+// an invalid block range fails the same at any size.
+func (e *RpcError) IsLogsLimitExceeded() bool {
+	if e.Code == 429 {
+		// throttled, not limited: the same range may succeed later
+		return false
+	}
+	message := strings.ToLower(e.Message)
+	for _, limit := range logsLimitMessages {
+		if strings.Contains(message, limit) {
+			return true
+		}
+	}
+	return false
+}
+
+// logsLimitMessages are the eth_getLogs limit errors of Alchemy, geth,
+// Infura and other nodes.
+var logsLimitMessages = []string{
+	"log response size exceeded",  // Alchemy
+	"query returned more than",    // Infura, Erigon: "query returned more than 10000 results"
+	"query exceeds max results",   // geth
+	"exceed maximum block range",  // BSC: "exceed maximum block range: 5000"
+	"eth_getlogs is limited to a", // QuickNode: "eth_getLogs is limited to a 10000 range"
+	"block range is too wide",     // Ankr
+}
+
 // TxError is returned when a transaction-related operation fails.
 // Callers can use errors.As to extract the TxHash and ChainID.
 type TxError struct {
@@ -43,3 +107,134 @@ func (e *TxError) Error() string {
 }
 
 func (e *TxError) Unwrap() error { return e.Err }
+
+// ReplacedReason is how the replacement of a tx differs from it.
+type ReplacedReason string
+
+const (
+	// ReplacedRepriced is the same tx with higher fees (e.g. Wallet.SpeedUp).
+	ReplacedRepriced ReplacedReason = "repriced"
+
+	// ReplacedCancelled is a 0-value self-transfer (e.g. Wallet.Cancel).
+	ReplacedCancelled ReplacedReason = "cancelled"
+
+	// ReplacedOther is any other tx.
+	ReplacedOther ReplacedReason = "replaced"
+)
+
+// ReplacedError is returned by Transact.WaitMinedOrReplaced, along with the
+// receipt of Replacement, when a tx of the same sender and nonce was mined in
+// place of the awaited one.
+type ReplacedError struct {
+	TxHash      common.Hash
+	Replacement *gethTypes.Transaction
+	Reason      ReplacedReason
+}
+
+func (e *ReplacedError) Error() string {
+	return fmt.Sprintf("tx %s was %s by %s", e.TxHash.Hex(), e.Reason, e.Replacement.Hash().Hex())
+}
+
+// RevertError is a reverted call or transaction with its revert payload
+// decoded (see decode.Revert). Exactly one of Reason, PanicCode or ErrorName
+// is set when the payload was recognised; Data is the raw payload either way.
+type RevertError struct {
+	// Data is the raw revert payload; empty when the node sent none.
+	Data []byte
+
+	// Reason is the message of a require / revert("...") (Error(string)).
+	Reason string
+
+	// PanicCode is the code of a Panic(uint256), e.g. 0x11 for an arithmetic
+	// overflow; PanicReason names it.
+	PanicCode   *big.Int
+	PanicReason string
+
+	// ErrorName and ErrorArgs are a Solidity custom error matched against a
+	// supplied ABI, with its arguments in declaration order.
+	ErrorName string
+	ErrorArgs []any
+
+	// TxHash is the mined transaction replayed to recover the payload; zero
+	// for a call.
+	TxHash common.Hash
+
+	Err error
+}
+
+func (e *RevertError) Error() string {
+	s := "execution reverted"
+	switch {
+	case e.ErrorName != "":
+		args := make([]string, len(e.ErrorArgs))
+		for i, arg := range e.ErrorArgs {
+			args[i] = fmt.Sprint(arg)
+		}
+		s += fmt.Sprintf(": %s(%s)", e.ErrorName, strings.Join(args, ", "))
+	case e.PanicCode != nil:
+		s += fmt.Sprintf(": panic 0x%x (%s)", e.PanicCode, e.PanicReason)
+	case e.Reason != "":
+		s += ": " + e.Reason
+	case len(e.Data) >= 4:
+		s += fmt.Sprintf(": unknown error 0x%x", e.Data[:4])
+	}
+	if e.TxHash != (common.Hash{}) {
+		s += fmt.Sprintf(" (tx=%s)", e.TxHash.Hex())
+	}
+	return s
+}
+
+func (e *RevertError) Unwrap() error { return e.Err }
+
+// ComputeUnitError is returned instead of sending a call that does not fit
+// the client-side compute-unit limits. Err is
+// constant.ErrComputeUnitRateExceeded or constant.ErrComputeUnitBudgetExceeded.
+type ComputeUnitError struct {
+	Method     string
+	Cost       int
+	RetryAfter time.Duration
+	Err        error
+}
+
+func (e *ComputeUnitError) Error() string {
+	return fmt.Sprintf("%s (method=%s, cost=%d CU): retry after %s", e.Err, e.Method, e.Cost, e.RetryAfter)
+}
+
+func (e *ComputeUnitError) Unwrap() error { return e.Err }
+
+// RateLimitError is returned when a call is still throttled (HTTP 429 or
+// JSON-RPC error 429) once its backoff retries run out. Err is the error of
+// the last attempt, an rpc.HTTPError or a *RpcError.
+type RateLimitError struct {
+	// RetryAfter is the wait the server asked for; 0 when not sent.
+	RetryAfter time.Duration
+
+	// Limit, Remaining and Reset come from the X-RateLimit-* headers. Limit
+	// and Remaining are -1, Reset zero, when not sent.
+	Limit     int
+	Remaining int
+	Reset     time.Time
+
+	// Code, Message and Data come from the JSON-RPC error of the body.
+	Code    int
+	Message string
+	Data    json.RawMessage
+
+	Err error
+}
+
+func (e *RateLimitError) Error() string {
+	s := "rate limited"
+	if e.RetryAfter > 0 {
+		s += fmt.Sprintf(" (retry after %s)", e.RetryAfter)
+	}
+	if e.Message != "" {
+		return s + ": " + e.Message
+	}
+	if e.Err != nil {
+		return s + ": " + e.Err.Error()
+	}
+	return s
+}
+
+func (e *RateLimitError) Unwrap() error { return e.Err }
@@ -1,12 +1,16 @@
 package types_test
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"math/big"
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/poteto-go/go-alchemy-sdk/types"
 	"github.com/stretchr/testify/assert"
 )
@@ -91,3 +95,179 @@ func TestTxError_ErrorsAs(t *testing.T) {
 	assert.Equal(t, hash, txErr.TxHash)
 	assert.Equal(t, big.NewInt(137), txErr.ChainID)
 }
+
+func TestComputeUnitError(t *testing.T) {
+	// Arrange
+	err := &types.ComputeUnitError{Method: "eth_getLogs", Cost: 75, RetryAfter: time.Second, Err: sentinel}
+
+	// Act
+	wrapped := fmt.Errorf("outer: %w", err)
+
+	// Assert
+	assert.Equal(t, "underlying error (method=eth_getLogs, cost=75 CU): retry after 1s", err.Error())
+	assert.ErrorIs(t, wrapped, sentinel)
+	var cuErr *types.ComputeUnitError
+	assert.True(t, errors.As(wrapped, &cuErr))
+	assert.Equal(t, time.Second, cuErr.RetryAfter)
+}
+
+func TestRateLimitError(t *testing.T) {
+	t.Run("error message", func(t *testing.T) {
+		assert.Equal(t,
+			"rate limited (retry after 1s): slow down",
+			(&types.RateLimitError{RetryAfter: time.Second, Message: "slow down", Err: sentinel}).Error(),
+		)
+		assert.Equal(t, "rate limited: underlying error", (&types.RateLimitError{Err: sentinel}).Error())
+		assert.Equal(t, "rate limited", (&types.RateLimitError{}).Error())
+	})
+
+	t.Run("unwraps the error of the last attempt", func(t *testing.T) {
+		wrapped := fmt.Errorf("outer: %w", &types.RateLimitError{Err: sentinel})
+
+		assert.ErrorIs(t, wrapped, sentinel)
+		var rateLimitErr *types.RateLimitError
+		assert.True(t, errors.As(wrapped, &rateLimitErr))
+	})
+}
+
+func TestReplacedError(t *testing.T) {
+	// Arrange
+	replacement := gethTypes.NewTx(&gethTypes.LegacyTx{Nonce: 1})
+	err := &types.ReplacedError{
+		TxHash:      common.HexToHash("0xdeadbeef"),
+		Replacement: replacement,
+		Reason:      types.ReplacedCancelled,
+	}
+
+	// Act
+	wrapped := fmt.Errorf("outer: %w", err)
+
+	// Assert
+	assert.Equal(t,
+		"tx 0x00000000000000000000000000000000000000000000000000000000deadbeef was cancelled by "+replacement.Hash().Hex(),
+		err.Error(),
+	)
+	replacedErr, ok := errors.AsType[*types.ReplacedError](wrapped)
+	assert.True(t, ok)
+	assert.Equal(t, replacement, replacedErr.Replacement)
+}
+
+func TestRpcError_ErrorData(t *testing.T) {
+	t.Run("raw data", func(t *testing.T) {
+		var dataErr rpc.DataError = &types.RpcError{Data: json.RawMessage(`"0x08c379a0"`)}
+		assert.Equal(t, json.RawMessage(`"0x08c379a0"`), dataErr.ErrorData())
+	})
+
+	t.Run("no data", func(t *testing.T) {
+		assert.Nil(t, (&types.RpcError{}).ErrorData())
+	})
+}
+
+func TestRpcError_Is(t *testing.T) {
+	tests := []struct {
+		name              string
+		err               *types.RpcError
+		reverted          bool
+		nonceTooLow       bool
+		insufficientFunds bool
+		logsLimitExceeded bool
+	}{
+		{
+			name:     "execution reverted by code",
+			err:      &types.RpcError{Code: 3, Message: "execution reverted: not owner"},
+			reverted: true,
+		},
+		{
+			name:     "execution reverted by message",
+			err:      &types.RpcError{Code: -32000, Message: "execution reverted"},
+			reverted: true,
+		},
+		{
+			name:        "nonce too low",
+			err:         &types.RpcError{Code: -32000, Message: "nonce too low: next nonce 5, tx nonce 3"},
+			nonceTooLow: true,
+		},
+		{
+			name:              "insufficient funds",
+			err:               &types.RpcError{Code: -32000, Message: "insufficient funds for gas * price + value"},
+			insufficientFunds: true,
+		},
+		{
+			name:              "logs limit exceeded by code",
+			err:               &types.RpcError{Code: -32005, Message: "query returned more than 10000 results"},
+			logsLimitExceeded: true,
+		},
+		{
+			name:              "logs limit exceeded by message",
+			err:               &types.RpcError{Code: -32602, Message: "Log response size exceeded. You can make eth_getLogs requests with up to a 2K block range"},
+			logsLimitExceeded: true,
+		},
+		{
+			name:              "logs limit exceeded by block range",
+			err:               &types.RpcError{Code: -32000, Message: "exceed maximum block range: 5000"},
+			logsLimitExceeded: true,
+		},
+		{
+			name: "-32005 rate limit is not a logs limit",
+			err:  &types.RpcError{Code: -32005, Message: "request rate limit exceeded"},
+		},
+		{
+			name: "invalid block range is not a logs limit",
+			err:  &types.RpcError{Code: -32602, Message: "invalid block range params"},
+		},
+		{
+			name: "tx pool limit is not a logs limit",
+			err:  &types.RpcError{Code: -32005, Message: "txpool is full: limit exceeded"},
+		},
+		{
+			name: "rate limited is not a logs limit",
+			err:  &types.RpcError{Code: 429, Message: "Your app has exceeded its compute units per second capacity."},
+		},
+		{
+			name: "other",
+			err:  &types.RpcError{Code: -32601, Message: "the method eth_foo does not exist"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.reverted, tt.err.IsExecutionReverted())
+			assert.Equal(t, tt.nonceTooLow, tt.err.IsNonceTooLow())
+			assert.Equal(t, tt.insufficientFunds, tt.err.IsInsufficientFunds())
+			assert.Equal(t, tt.logsLimitExceeded, tt.err.IsLogsLimitExceeded())
+		})
+	}
+}
+
+func TestRevertError_Error(t *testing.T) {
+	tests := []struct {
+		name string
+		err  *types.RevertError
+		want string
+	}{
+		{
+			name: "reason of a mined tx",
+			err:  &types.RevertError{Reason: "not owner", TxHash: common.HexToHash("0x01")},
+			want: "execution reverted: not owner (tx=" + common.HexToHash("0x01").Hex() + ")",
+		},
+		{
+			name: "custom error",
+			err:  &types.RevertError{ErrorName: "Unauthorized", ErrorArgs: []any{common.HexToAddress("0x01")}},
+			want: "execution reverted: Unauthorized(" + common.HexToAddress("0x01").Hex() + ")",
+		},
+		{
+			name: "unknown payload",
+			err:  &types.RevertError{Data: []byte{0x01, 0x02, 0x03, 0x04, 0x05}},
+			want: "execution reverted: unknown error 0x01020304",
+		},
+		{
+			name: "no payload",
+			err:  &types.RevertError{},
+			want: "execution reverted",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.err.Error())
+		})
+	}
+}
@@ -35,6 +35,7 @@ type EthClient interface {
 	BlockByNumber(ctx context.Context, number *big.Int) (*gethTypes.Block, error)
 	BlockByHash(ctx context.Context, hash common.Hash) (*gethTypes.Block, error)
 	BalanceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (*big.Int, error)
+	NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error)
 	TransactionByHash(ctx context.Context, txHash common.Hash) (tx *gethTypes.Transaction, isPending bool, err error)
 }
 
@@ -245,6 +246,12 @@ type AlchemyEnhanced interface {
 	*/
 	GetTokenBalances(address string, params ...string) (TokenBalanceResponse, error)
 
+	/*
+		Returns a page of every ERC-20 token balance of address, from pageKey
+		("" for the first page). maxCount 0 uses the API default (100).
+	*/
+	GetTokenBalancesPage(address string, pageKey string, maxCount int) (TokenBalanceResponse, error)
+
 	/* Returns metadata for a given token contract address. */
 	GetTokenMetadata(address string) (TokenMetadataResponse, error)
 
@@ -260,6 +267,10 @@ type AlchemyEnhanced interface {
 type ContractCaller interface {
 	/*
 		Read method call for Any Smart Contract
+
+		args are appended to the selector of method as they are, so any
+		layout can be passed as one encode.ABIPack; decode the output with
+		decode.ABIUnpackInto.
 	*/
 	CallReadMethod(
 		method []byte,
@@ -308,6 +319,13 @@ type ContractCaller interface {
 		It stops waiting when ctx is canceled.
 	*/
 	WaitMined(ctx context.Context, hash common.Hash) (*gethTypes.Receipt, error)
+
+	/*
+		RevertReason replays a mined transaction that failed (status=0) with
+		eth_call at its block, and returns its decoded revert.
+		It returns nil for a successful transaction.
+	*/
+	RevertReason(ctx context.Context, hash common.Hash) (*RevertError, error)
 }
 
 type TransactionSender interface {
@@ -319,6 +337,14 @@ type TransactionSender interface {
 	*/
 	PendingNonceAt(address string) (uint64, error)
 
+	/*
+		NonceAt returns the account nonce of the given account at blockTag:
+		the number of its txs mined by then.
+
+		internal call geth
+	*/
+	NonceAt(address string, blockTag string) (uint64, error)
+
 	// send signed tx into the pending pool for execution w/geth
 	SendRawTransaction(signedTx *gethTypes.Transaction) error
 }
@@ -396,6 +422,9 @@ type EtherApi interface {
 	TransactionSender
 	Deployer
 	EnsResolver
+
+	// every method above bounded by a caller supplied ctx
+	EtherApiContext
 }
 
 type WsEtherApi interface {
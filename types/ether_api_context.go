@@ -0,0 +1,94 @@
+package types
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+/*
+The ...Context interfaces below mirror the EtherApi method sets with a caller
+supplied ctx as the first argument.
+
+ctx bounds the whole call: every backoff attempt (each still limited by
+RequestTimeout) and the sleeps between them. Once ctx is done no further
+attempt is made and the returned error wraps ctx.Err().
+*/
+
+type BatchCallerContext interface {
+	BatchCallContext(ctx context.Context, elems []rpc.BatchElem) error
+}
+
+type ChainReaderContext interface {
+	BlockNumberContext(ctx context.Context) (uint64, error)
+	GetBalanceContext(ctx context.Context, address string, blockTag string) (*big.Int, error)
+	CodeAtContext(ctx context.Context, address string, blockTag string) (string, error)
+	CodeAtHashContext(ctx context.Context, address string, blockHash string) (string, error)
+	GetTransactionContext(ctx context.Context, hash string) (tx *gethTypes.Transaction, isPending bool, err error)
+	StorageAtContext(ctx context.Context, address, position, blockTag string) (string, error)
+	GetLogsContext(ctx context.Context, filter Filter) ([]LogResponse, error)
+	GetTransactionReceiptContext(ctx context.Context, hash string) (*gethTypes.Receipt, error)
+	GetTransactionReceiptsContext(ctx context.Context, arg BlockNumberOrHash) ([]*gethTypes.Receipt, error)
+	GetBlockByNumberContext(ctx context.Context, blockNumber string) (*gethTypes.Block, error)
+	GetBlockByHashContext(ctx context.Context, blockHash string) (*gethTypes.Block, error)
+	ChainIDContext(ctx context.Context) (*big.Int, error)
+	PeerCountContext(ctx context.Context) (uint64, error)
+}
+
+type GasEstimatorContext interface {
+	GasPriceContext(ctx context.Context) (*big.Int, error)
+	EstimateGasContext(ctx context.Context, tx TransactionRequest) (*big.Int, error)
+	SuggestGasPriceContext(ctx context.Context) (*big.Int, error)
+	SuggestGasTipCapContext(ctx context.Context) (*big.Int, error)
+	SuggestEIP1559FeesContext(ctx context.Context) (maxPriorityFeePerGas *big.Int, maxFeePerGas *big.Int, err error)
+}
+
+type AlchemyEnhancedContext interface {
+	GetTokenBalancesContext(ctx context.Context, address string, params ...string) (TokenBalanceResponse, error)
+	GetTokenBalancesPageContext(ctx context.Context, address string, pageKey string, maxCount int) (TokenBalanceResponse, error)
+	GetTokenMetadataContext(ctx context.Context, address string) (TokenMetadataResponse, error)
+	GetAssetTransfersContext(ctx context.Context, params AssetTransfersParams) (AssetTransfersResponse, error)
+}
+
+// ContractCallerContext has no ContractCall / ContractTransact variants:
+// bind.CallOpts and bind.TransactOpts already carry a Context.
+type ContractCallerContext interface {
+	CallReadMethodContext(
+		ctx context.Context,
+		method []byte,
+		contractAddress string,
+		args ...[]byte,
+	) ([]byte, error)
+	CallContext(ctx context.Context, tx TransactionRequest, blockTag string) (string, error)
+	CallContractContext(ctx context.Context, msg ethereum.CallMsg, blockTag string) ([]byte, error)
+}
+
+type TransactionSenderContext interface {
+	PendingNonceAtContext(ctx context.Context, address string) (uint64, error)
+	NonceAtContext(ctx context.Context, address string, blockTag string) (uint64, error)
+	SendRawTransactionContext(ctx context.Context, signedTx *gethTypes.Transaction) error
+}
+
+type DevChainContext interface {
+	SnapshotContext(ctx context.Context) (*big.Int, error)
+	RevertToContext(ctx context.Context, snapshotId *big.Int) (bool, error)
+}
+
+type EnsResolverContext interface {
+	ResolveNameByContext(ctx context.Context, registryAddress string, name string) (string, error)
+	LookupAddressByContext(ctx context.Context, registryAddress string, address string) (string, error)
+}
+
+type EtherApiContext interface {
+	BatchCallerContext
+	ChainReaderContext
+	GasEstimatorContext
+	AlchemyEnhancedContext
+	ContractCallerContext
+	TransactionSenderContext
+	DevChainContext
+	EnsResolverContext
+}
@@ -1,10 +1,74 @@
 package types
 
+import "encoding/json"
+
 type Filter struct {
 	FromBlock string   `json:"fromBlock,omitempty"`
 	ToBlock   string   `json:"toBlock,omitempty"`
 	Address   string   `json:"address,omitempty"`
 	Topics    []string `json:"topics"`
+
+	// BlockHash pins the filter to one block instead of FromBlock..ToBlock,
+	// which stays correct across a reorg.
+	BlockHash string `json:"blockHash,omitempty"`
+
+	// Addresses matches logs of any of them, along with Address.
+	Addresses []string `json:"-"`
+
+	// TopicSets replaces Topics with an OR-set per position: a log matches if
+	// its topic at each position is one of the set. An empty set matches any
+	// topic.
+	TopicSets [][]string `json:"-"`
+}
+
+// MarshalJSON sends Addresses as the "address" array and TopicSets as
+// "topics", the forms eth_getLogs takes for an OR.
+func (f Filter) MarshalJSON() ([]byte, error) {
+	var address any
+	if len(f.Addresses) > 0 {
+		addresses := make([]string, 0, len(f.Addresses)+1)
+		if f.Address != "" {
+			addresses = append(addresses, f.Address)
+		}
+		address = append(addresses, f.Addresses...)
+	} else if f.Address != "" {
+		address = f.Address
+	}
+
+	var topics any = f.Topics
+	if f.TopicSets != nil {
+		sets := make([]any, len(f.TopicSets))
+		for i, set := range f.TopicSets {
+			switch len(set) {
+			case 0:
+				sets[i] = nil
+			case 1:
+				sets[i] = set[0]
+			default:
+				sets[i] = set
+			}
+		}
+		topics = sets
+	}
+
+	return json.Marshal(struct {
+		FromBlock string `json:"fromBlock,omitempty"`
+		ToBlock   string `json:"toBlock,omitempty"`
+		Address   any    `json:"address,omitempty"`
+		Topics    any    `json:"topics"`
+		BlockHash string `json:"blockHash,omitempty"`
+	}{f.FromBlock, f.ToBlock, address, topics, f.BlockHash})
+}
+
+// LogsRangeOption tunes Core.GetLogsRange; a zero field uses its default.
+type LogsRangeOption struct {
+	// ChunkSize is the block span of one eth_getLogs request
+	// (constant.DefaultLogsRangeChunkSize).
+	ChunkSize uint64
+
+	// Concurrency is how many requests run at once
+	// (constant.DefaultLogsRangeConcurrency).
+	Concurrency int
 }
 
 type LogResponse struct {
@@ -0,0 +1,53 @@
+package types_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/poteto-go/go-alchemy-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilter_MarshalJSON(t *testing.T) {
+	tests := []struct {
+		name   string
+		filter types.Filter
+		want   string
+	}{
+		{
+			name:   "single address and topics",
+			filter: types.Filter{FromBlock: "0x1", ToBlock: "0x2", Address: "0xa", Topics: []string{"0xt"}},
+			want:   `{"fromBlock":"0x1","toBlock":"0x2","address":"0xa","topics":["0xt"]}`,
+		},
+		{
+			name:   "no address",
+			filter: types.Filter{},
+			want:   `{"topics":null}`,
+		},
+		{
+			name:   "block hash",
+			filter: types.Filter{BlockHash: "0xh", Topics: []string{}},
+			want:   `{"topics":[],"blockHash":"0xh"}`,
+		},
+		{
+			name:   "addresses",
+			filter: types.Filter{Address: "0xa", Addresses: []string{"0xb", "0xc"}},
+			want:   `{"address":["0xa","0xb","0xc"],"topics":null}`,
+		},
+		{
+			name:   "topic sets",
+			filter: types.Filter{Topics: []string{"0xignored"}, TopicSets: [][]string{{"0xt"}, {}, {"0xx", "0xy"}}},
+			want:   `{"topics":["0xt",null,["0xx","0xy"]]}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Act
+			got, err := json.Marshal(tt.filter)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.JSONEq(t, tt.want, string(got))
+		})
+	}
+}
@@ -0,0 +1,24 @@
+package types
+
+// KeystoreKDF is the key derivation function of a keystore file.
+type KeystoreKDF string
+
+const (
+	KeystoreScrypt KeystoreKDF = "scrypt"
+	KeystorePBKDF2 KeystoreKDF = "pbkdf2"
+)
+
+// KeystoreOption is how Wallet.ExportKeystore encrypts the key; nil encrypts
+// with scrypt at the standard cost of geth.
+type KeystoreOption struct {
+	// KDF derives the encryption key from the passphrase; "" is KeystoreScrypt.
+	KDF KeystoreKDF
+
+	// ScryptN and ScryptP are the scrypt cost; 0 uses geth's standard
+	// 1<<18 and 1.
+	ScryptN int
+	ScryptP int
+
+	// PBKDF2Iterations is the PBKDF2-HMAC-SHA256 count; 0 uses 262144.
+	PBKDF2Iterations int
+}
@@ -0,0 +1,42 @@
+package types
+
+import "context"
+
+// RpcRequest is a JSON-RPC call as seen by a Middleware.
+type RpcRequest struct {
+	Method string
+	Params RequestArgs
+}
+
+// RpcHandler performs req and returns its result.
+type RpcHandler func(ctx context.Context, req RpcRequest) (any, error)
+
+/*
+Middleware wraps every JSON-RPC call the SDK makes, e.g. for logging,
+auditing, metrics or request rewriting:
+
+	func Logger(next types.RpcHandler) types.RpcHandler {
+		return func(ctx context.Context, req types.RpcRequest) (any, error) {
+			start := time.Now()
+			res, err := next(ctx, req)
+			log.Println(req.Method, req.Params, res, err, time.Since(start))
+			return res, err
+		}
+	}
+
+A middleware sees the call once, backoff retries included. Over
+IAlchemyProvider.Send the params and result are the JSON-RPC ones; over the
+geth client they are the Go values handed to and returned by ethclient
+(e.g. common.Address, *big.Int, *types.Receipt), plus the fullTx flag of
+eth_getBlockBy*. There, params may be replaced by values of the same Go types
+but the method cannot be rewritten.
+*/
+type Middleware func(next RpcHandler) RpcHandler
+
+// ChainMiddlewares wraps handler so that middlewares[0] is the outermost one.
+func ChainMiddlewares(handler RpcHandler, middlewares ...Middleware) RpcHandler {
+	for i := len(middlewares) - 1; i >= 0; i-- {
+		handler = middlewares[i](handler)
+	}
+	return handler
+}
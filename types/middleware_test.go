@@ -0,0 +1,75 @@
+package types_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/poteto-go/go-alchemy-sdk/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestChainMiddlewares(t *testing.T) {
+	t.Run("returns handler as is without middleware", func(t *testing.T) {
+		// Arrange
+		handler := func(ctx context.Context, req types.RpcRequest) (any, error) {
+			return req.Method, nil
+		}
+
+		// Act
+		res, err := types.ChainMiddlewares(handler)(context.Background(), types.RpcRequest{Method: "eth_chainId"})
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, "eth_chainId", res)
+	})
+
+	t.Run("first middleware is the outermost", func(t *testing.T) {
+		// Arrange
+		var order []string
+		trace := func(name string) types.Middleware {
+			return func(next types.RpcHandler) types.RpcHandler {
+				return func(ctx context.Context, req types.RpcRequest) (any, error) {
+					order = append(order, name+":in")
+					res, err := next(ctx, req)
+					order = append(order, name+":out")
+					return res, err
+				}
+			}
+		}
+		handler := func(ctx context.Context, req types.RpcRequest) (any, error) {
+			order = append(order, "handler")
+			return nil, nil
+		}
+
+		// Act
+		_, err := types.ChainMiddlewares(handler, trace("a"), trace("b"))(context.Background(), types.RpcRequest{})
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, []string{"a:in", "b:in", "handler", "b:out", "a:out"}, order)
+	})
+
+	t.Run("middleware can rewrite the request", func(t *testing.T) {
+		// Arrange
+		rewrite := func(next types.RpcHandler) types.RpcHandler {
+			return func(ctx context.Context, req types.RpcRequest) (any, error) {
+				req.Params = types.RequestArgs{"latest"}
+				return next(ctx, req)
+			}
+		}
+		handler := func(ctx context.Context, req types.RpcRequest) (any, error) {
+			return req.Params, nil
+		}
+
+		// Act
+		res, err := types.ChainMiddlewares(handler, rewrite)(
+			context.Background(),
+			types.RpcRequest{Method: "eth_getBalance", Params: types.RequestArgs{"pending"}},
+		)
+
+		// Assert
+		require.NoError(t, err)
+		assert.Equal(t, types.RequestArgs{"latest"}, res)
+	})
+}
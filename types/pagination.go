@@ -0,0 +1,11 @@
+package types
+
+// PageOption tunes the paginated iterators of Core; nil uses the defaults.
+type PageOption struct {
+	// MaxItems stops after this many items; 0 means all of them.
+	MaxItems int
+
+	// Backoff retries a page that failed with a retryable error, on top of
+	// the retries of every request; nil uses DefaultBackoffConfig.
+	Backoff *BackoffConfig
+}
@@ -0,0 +1,28 @@
+package types
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+)
+
+/*
+Signer holds the key of a wallet and signs with it: in the process, or out of
+it (a geth Clef, a remote signer in front of a KMS...) so that the key never
+enters the process.
+
+The signer package has a local, a Clef and an HTTP remote Signer; build a
+Wallet of any of them with wallet.FromSigner.
+*/
+type Signer interface {
+	// Address returns the address of the key.
+	Address() common.Address
+
+	// SignHash signs a 32-byte hash, as crypto.Sign: the signature is
+	// 65 bytes [R || S || V] with V 0 or 1.
+	SignHash(hash common.Hash) ([]byte, error)
+
+	// SignTx signs tx for chainID.
+	SignTx(tx *gethTypes.Transaction, chainID *big.Int) (*gethTypes.Transaction, error)
+}
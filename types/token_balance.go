@@ -13,4 +13,10 @@ type TokenBalanceResponse struct {
 
 type TokenBalanceOption struct {
 	ContractAddresses []string
+
+	// PageKey and MaxCount page every ERC-20 balance of the owner; they are
+	// ignored along with ContractAddresses. MaxCount 0 uses the API default
+	// (100).
+	PageKey  string
+	MaxCount int
 }
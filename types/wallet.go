@@ -40,6 +40,28 @@ type Wallet interface {
 	// Returns the transaction hash of the submitted transaction
 	SendTransaction(txRequest TransactionRequest) (txHash common.Hash, err error)
 
+	/*
+		SpeedUp re-sends the pending tx txHash of this wallet with the same
+		nonce and its fees raised by bumpPercent - GasPrice, or MaxFeePerGas
+		and MaxPriorityFeePerGas - so that it replaces the tx.
+		A bumpPercent below constant.MinReplacementBumpPercent, the least a
+		node accepts, is raised to it.
+
+		It returns the replacement; Transact.WaitMinedOrReplaced(txHash)
+		returns its receipt with a *ReplacedError.
+	*/
+	SpeedUp(txHash common.Hash, bumpPercent uint64) (replacement *gethTypes.Transaction, err error)
+
+	/*
+		Cancel replaces the pending tx txHash of this wallet with a 0-value
+		transfer to itself, with the same nonce and the fees raised by
+		constant.MinReplacementBumpPercent.
+
+		It returns the replacement; Transact.WaitMinedOrReplaced(txHash)
+		returns its receipt with a *ReplacedError.
+	*/
+	Cancel(txHash common.Hash) (replacement *gethTypes.Transaction, err error)
+
 	/*
 		DeployContract creates and submits a deployment transaction based on the
 		deployer bytecode.
@@ -66,6 +88,10 @@ type Wallet interface {
 		ContractTransact executes a transaction on a deployed contract.
 		It waits for the transaction to be mined and returns the transaction receipt.
 		The operation stops waiting when ctx is canceled.
+
+		A revert is returned as a *RevertError: from the gas estimate before
+		sending, or, for a mined tx with status=0, along with its receipt in a
+		*TxError after replaying it.
 	*/
 	ContractTransact(
 		ctx context.Context,
@@ -109,6 +135,17 @@ type Wallet interface {
 		domainSeparator [32]byte, encoded []byte,
 	) (Signature, error)
 
+	/*
+		ExportKeystore encrypts the wallet's key with passphrase into a Web3
+		Secret Storage (v3) keystore file, as geth and MetaMask read it.
+		option picks the KDF - scrypt or pbkdf2 - and its cost; nil is scrypt at
+		geth's standard cost.
+
+		Read it back with wallet.FromKeystoreJSON. A wallet of a signer out of
+		the process (wallet.FromSigner) returns constant.ErrKeyNotExportable.
+	*/
+	ExportKeystore(passphrase string, option *KeystoreOption) (keyJSON []byte, err error)
+
 	/* ERC20 support */
 	ERC20() WalletERC20
 
@@ -128,4 +165,29 @@ type Wallet interface {
 		If switch network, you need to call this.
 	*/
 	ResetPool()
+
+	/*
+		UseNonceManager makes SignTx, SendTransaction, DeployContractNoWait,
+		ContractTransactNoWait and the ERC20 / StableCoin / Nft / ERC1155 writes
+		take their nonces from nonces instead of PendingNonceAt, so that
+		goroutines sending from this wallet do not get the same nonce.
+
+		A send that fails before broadcast gives its nonce back; one that fails
+		on the nonce is sent once more after a resync. A tx signed by SignTx
+		that is never sent keeps its nonce until released on the manager.
+		nil goes back to PendingNonceAt.
+
+			w.UseNonceManager(nonce.New(w.PendingNonceAt))
+	*/
+	UseNonceManager(nonces NonceManager)
+}
+
+// NonceManager hands out the nonces of a wallet's txs; see package nonce.
+type NonceManager interface {
+	// Next reserves the nonce of the next tx.
+	Next() (uint64, error)
+
+	// Failed settles nonce, of a tx whose send failed with err, and reports
+	// whether the tx can be sent again with a fresh nonce.
+	Failed(nonce uint64, err error) (retry bool)
 }
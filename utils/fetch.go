@@ -6,10 +6,32 @@ import (
 	"io"
 	"net/http"
 
+	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/poteto-go/go-alchemy-sdk/constant"
 	"github.com/poteto-go/go-alchemy-sdk/types"
 )
 
+// jsonRpcResponse decodes the JSON-RPC error object that AlchemyResponse
+// does not carry on the wire.
+type jsonRpcResponse struct {
+	types.AlchemyResponse
+	Error *jsonRpcError `json:"error"`
+}
+
+type jsonRpcError struct {
+	Code    int             `json:"code"`
+	Message string          `json:"message"`
+	Data    json.RawMessage `json:"data,omitempty"`
+}
+
+func (res jsonRpcResponse) toAlchemyResponse() types.AlchemyResponse {
+	response := res.AlchemyResponse
+	if res.Error != nil {
+		response.Error = res.Error.toRpcError("")
+	}
+	return response
+}
+
 func AlchemyFetch(
 	client *http.Client,
 	req types.AlchemyRequest,
@@ -29,12 +51,34 @@ func AlchemyFetch(
 	if err != nil {
 		return types.AlchemyResponse{}, constant.ErrFailedToReadResponse
 	}
+	if err := rateLimitErrorOf(res, resBody, methodOf(body)); err != nil {
+		return types.AlchemyResponse{}, err
+	}
 
-	result := types.AlchemyResponse{}
+	result := jsonRpcResponse{}
 	if err := json.Unmarshal(resBody, &result); err != nil {
 		return types.AlchemyResponse{}, constant.ErrFailedToUnmarshalResponse
 	}
-	return result, nil
+	if result.Error != nil {
+		return result.AlchemyResponse, result.Error.toRpcError(methodOf(body))
+	}
+	return result.AlchemyResponse, nil
+}
+
+// rateLimitErrorOf returns a *types.RateLimitError when res is throttled:
+// HTTP 429, or a JSON-RPC error 429 (Alchemy's compute units exceeded).
+func rateLimitErrorOf(res *http.Response, body []byte, method string) error {
+	if res.StatusCode == http.StatusTooManyRequests {
+		return ParseRateLimit(res.Header, body, rpc.HTTPError{
+			StatusCode: res.StatusCode,
+			Status:     res.Status,
+			Body:       body,
+		})
+	}
+	if rpcErr := jsonRpcErrorOf(body); rpcErr != nil && rpcErr.Code == http.StatusTooManyRequests {
+		return ParseRateLimit(res.Header, body, rpcErr.toRpcError(method))
+	}
+	return nil
 }
 
 func AlchemyBatchFetch(
@@ -58,16 +102,24 @@ func AlchemyBatchFetch(
 		if err != nil {
 			return []types.AlchemyResponse{}, constant.ErrFailedToReadResponse
 		}
+		if res.StatusCode == http.StatusTooManyRequests {
+			return []types.AlchemyResponse{}, rateLimitErrorOf(res, body, methodOf(bodies[0]))
+		}
 
-		result := types.AlchemyResponse{}
+		result := jsonRpcResponse{}
 		if err := json.Unmarshal(body, &result); err != nil {
 			return []types.AlchemyResponse{}, constant.ErrFailedToUnmarshalResponse
 		}
 
-		return []types.AlchemyResponse{result}, nil
+		return []types.AlchemyResponse{result.toAlchemyResponse()}, nil
 	}
 
-	paramJson, err := json.Marshal(bodies)
+	// each body is already a JSON-RPC object; send them as one JSON array.
+	batchBody := make([]json.RawMessage, len(bodies))
+	for i, body := range bodies {
+		batchBody[i] = body
+	}
+	paramJson, err := json.Marshal(batchBody)
 	if err != nil {
 		return []types.AlchemyResponse{}, constant.ErrFailedToMarshalParameter
 	}
@@ -85,11 +137,23 @@ func AlchemyBatchFetch(
 	if err != nil {
 		return []types.AlchemyResponse{}, constant.ErrFailedToReadResponse
 	}
+	if res.StatusCode == http.StatusTooManyRequests {
+		return []types.AlchemyResponse{}, rateLimitErrorOf(res, body, "")
+	}
 
-	results := []types.AlchemyResponse{}
+	results := []jsonRpcResponse{}
 	if err := json.Unmarshal(body, &results); err != nil {
+		// the node refused the whole batch with a single JSON-RPC error
+		single := jsonRpcResponse{}
+		if json.Unmarshal(body, &single) == nil && single.Error != nil {
+			return []types.AlchemyResponse{}, single.Error.toRpcError("")
+		}
 		return []types.AlchemyResponse{}, constant.ErrFailedToUnmarshalResponse
 	}
 
-	return results, nil
+	responses := make([]types.AlchemyResponse, len(results))
+	for i, result := range results {
+		responses[i] = result.toAlchemyResponse()
+	}
+	return responses, nil
 }
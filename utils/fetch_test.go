@@ -91,6 +91,35 @@ func TestAlchemyFetch(t *testing.T) {
 			assert.ErrorIs(t, constant.ErrFailedToConnect, err)
 		})
 
+		t.Run("if node answers an error -> *types.RpcError", func(t *testing.T) {
+			httpmock.Activate(t)
+			defer httpmock.DeactivateAndReset()
+
+			// Arrange
+			req, _ := http.NewRequest("POST", targetUrl, nil)
+			request := types.AlchemyRequest{
+				Request: req,
+			}
+
+			// Mock
+			httpmock.RegisterResponder(
+				"POST",
+				targetUrl,
+				httpmock.NewStringResponder(200, `{"jsonrpc":"2.0","id":1,"error":{"code":3,"message":"execution reverted","data":"0x08c379a0"}}`),
+			)
+
+			// Act
+			_, err := utils.AlchemyFetch(&http.Client{}, request, body)
+
+			// Assert
+			rpcErr, ok := errors.AsType[*types.RpcError](err)
+			assert.True(t, ok)
+			assert.Equal(t, "method", rpcErr.Method)
+			assert.Equal(t, 3, rpcErr.Code)
+			assert.Equal(t, "execution reverted", rpcErr.Message)
+			assert.Equal(t, json.RawMessage(`"0x08c379a0"`), rpcErr.Data)
+		})
+
 		t.Run("if failed to unmarshal response -> constant.ErrFailedToUnmarshalResponse", func(t *testing.T) {
 			httpmock.Activate(t)
 			patches := gomonkey.NewPatches()
@@ -316,6 +345,72 @@ func TestAlchemyBatchFetch(t *testing.T) {
 			assert.Nil(t, err)
 			assert.Equal(t, []types.AlchemyResponse{mockResult}, result)
 		})
+
+		t.Run("batched bodies are sent as one JSON array", func(t *testing.T) {
+			httpmock.Activate(t)
+			defer httpmock.DeactivateAndReset()
+
+			// Arrange
+			req, _ := http.NewRequest("POST", targetUrl, nil)
+			body1, _ := utils.CreateRequestBodyToBytes(1, "eth_blockNumber", types.RequestArgs{})
+			body2, _ := utils.CreateRequestBodyToBytes(2, "eth_chainId", types.RequestArgs{})
+			var sent []types.AlchemyRequestBody
+
+			// Mock
+			httpmock.RegisterResponder(
+				"POST",
+				targetUrl,
+				func(r *http.Request) (*http.Response, error) {
+					if err := json.NewDecoder(r.Body).Decode(&sent); err != nil {
+						return nil, err
+					}
+					return httpmock.NewStringResponse(200, `[{"jsonrpc":"2.0","id":1,"result":"0x1"},{"jsonrpc":"2.0","id":2,"result":"0x2"}]`), nil
+				},
+			)
+
+			// Act
+			_, err := utils.AlchemyBatchFetch(
+				&http.Client{},
+				[]types.AlchemyRequest{{Request: req}, {Request: req}},
+				[][]byte{body1, body2},
+			)
+
+			// Assert
+			assert.Nil(t, err)
+			assert.Len(t, sent, 2)
+			assert.Equal(t, "eth_blockNumber", sent[0].Method)
+			assert.Equal(t, "eth_chainId", sent[1].Method)
+		})
+
+		t.Run("error objects are decoded per element", func(t *testing.T) {
+			httpmock.Activate(t)
+			defer httpmock.DeactivateAndReset()
+
+			// Arrange
+			req, _ := http.NewRequest("POST", targetUrl, nil)
+			body1, _ := utils.CreateRequestBodyToBytes(1, "eth_call", types.RequestArgs{})
+			body2, _ := utils.CreateRequestBodyToBytes(2, "eth_chainId", types.RequestArgs{})
+
+			// Mock
+			httpmock.RegisterResponder(
+				"POST",
+				targetUrl,
+				httpmock.NewStringResponder(200, `[{"jsonrpc":"2.0","id":1,"error":{"code":3,"message":"execution reverted"}},{"jsonrpc":"2.0","id":2,"result":"0x2"}]`),
+			)
+
+			// Act
+			result, err := utils.AlchemyBatchFetch(
+				&http.Client{},
+				[]types.AlchemyRequest{{Request: req}, {Request: req}},
+				[][]byte{body1, body2},
+			)
+
+			// Assert
+			assert.Nil(t, err)
+			assert.Equal(t, &types.RpcError{Code: 3, Message: "execution reverted"}, result[0].Error)
+			assert.Nil(t, result[1].Error)
+			assert.Equal(t, "0x2", result[1].Result)
+		})
 	})
 
 	t.Run("error case:", func(t *testing.T) {
@@ -398,6 +493,33 @@ func TestAlchemyBatchFetch(t *testing.T) {
 			assert.ErrorIs(t, constant.ErrFailedToConnect, err)
 		})
 
+		t.Run("batch refused with a single error object -> *types.RpcError", func(t *testing.T) {
+			httpmock.Activate(t)
+			defer httpmock.DeactivateAndReset()
+
+			// Arrange
+			req, _ := http.NewRequest("POST", targetUrl, nil)
+			body1, _ := utils.CreateRequestBodyToBytes(1, "eth_call", types.RequestArgs{})
+			body2, _ := utils.CreateRequestBodyToBytes(2, "eth_chainId", types.RequestArgs{})
+
+			// Mock
+			httpmock.RegisterResponder(
+				"POST",
+				targetUrl,
+				httpmock.NewStringResponder(200, `{"jsonrpc":"2.0","id":null,"error":{"code":-32600,"message":"batch too large"}}`),
+			)
+
+			// Act
+			_, err := utils.AlchemyBatchFetch(
+				&http.Client{},
+				[]types.AlchemyRequest{{Request: req}, {Request: req}},
+				[][]byte{body1, body2},
+			)
+
+			// Assert
+			assert.Equal(t, &types.RpcError{Code: -32600, Message: "batch too large"}, err)
+		})
+
 		t.Run("failed batched unmarshal -> constant.ErrFailedToUnmarshalResponse", func(t *testing.T) {
 			patches := gomonkey.NewPatches()
 			httpmock.Activate(t)
@@ -0,0 +1,119 @@
+package utils
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/poteto-go/go-alchemy-sdk/types"
+)
+
+const (
+	HeaderRetryAfter         = "Retry-After"
+	HeaderRateLimitLimit     = "X-RateLimit-Limit"
+	HeaderRateLimitRemaining = "X-RateLimit-Remaining"
+	HeaderRateLimitReset     = "X-RateLimit-Reset"
+)
+
+/*
+ParseRateLimit reads the rate-limit details of a throttled response: the
+Retry-After and X-RateLimit-* headers, and the JSON-RPC error of body.
+err is the error of the attempt, kept as RateLimitError.Err.
+
+Retry-After is either delay-seconds or an HTTP-date. X-RateLimit-Reset is
+either a unix time or seconds from now.
+*/
+func ParseRateLimit(header http.Header, body []byte, err error) *types.RateLimitError {
+	now := time.Now()
+	rateLimitErr := &types.RateLimitError{
+		Limit:     -1,
+		Remaining: -1,
+		Err:       err,
+	}
+
+	if retryAfter := header.Get(HeaderRetryAfter); retryAfter != "" {
+		if seconds, err := strconv.ParseFloat(retryAfter, 64); err == nil && seconds >= 0 {
+			rateLimitErr.RetryAfter = time.Duration(seconds * float64(time.Second))
+		} else if at, err := http.ParseTime(retryAfter); err == nil && at.After(now) {
+			rateLimitErr.RetryAfter = at.Sub(now)
+		}
+	}
+	if limit, err := strconv.Atoi(header.Get(HeaderRateLimitLimit)); err == nil {
+		rateLimitErr.Limit = limit
+	}
+	if remaining, err := strconv.Atoi(header.Get(HeaderRateLimitRemaining)); err == nil {
+		rateLimitErr.Remaining = remaining
+	}
+	if reset, err := strconv.ParseInt(header.Get(HeaderRateLimitReset), 10, 64); err == nil {
+		// a unix time is far past any sane number of seconds to wait
+		if reset > 1_000_000_000 {
+			rateLimitErr.Reset = time.Unix(reset, 0)
+		} else {
+			rateLimitErr.Reset = now.Add(time.Duration(reset) * time.Second)
+		}
+	}
+
+	if rpcErr := jsonRpcErrorOf(body); rpcErr != nil {
+		rateLimitErr.Code = rpcErr.Code
+		rateLimitErr.Message = rpcErr.Message
+		rateLimitErr.Data = rpcErr.Data
+	}
+	return rateLimitErr
+}
+
+// jsonRpcErrorOf returns the JSON-RPC error of a response body, single or
+// batch; nil if there is none.
+func jsonRpcErrorOf(body []byte) *jsonRpcError {
+	var single jsonRpcResponse
+	if err := json.Unmarshal(body, &single); err == nil {
+		return single.Error
+	}
+	var batch []jsonRpcResponse
+	if err := json.Unmarshal(body, &batch); err == nil {
+		for _, res := range batch {
+			if res.Error != nil {
+				return res.Error
+			}
+		}
+	}
+	return nil
+}
+
+type rateLimitSinkKey struct{}
+
+type rateLimitSink struct {
+	mu     sync.Mutex
+	header http.Header
+}
+
+// WithRateLimitSink returns a ctx under which the SDK's http.Client records
+// the headers of a 429 response, for callers such as the geth client whose
+// errors do not carry them. Read them back with RateLimitHeader.
+func WithRateLimitSink(ctx context.Context) context.Context {
+	return context.WithValue(ctx, rateLimitSinkKey{}, &rateLimitSink{})
+}
+
+// RateLimitHeader returns the headers of the last 429 response recorded
+// under ctx, or nil.
+func RateLimitHeader(ctx context.Context) http.Header {
+	sink, ok := ctx.Value(rateLimitSinkKey{}).(*rateLimitSink)
+	if !ok {
+		return nil
+	}
+	sink.mu.Lock()
+	defer sink.mu.Unlock()
+	return sink.header
+}
+
+func recordRateLimitHeader(ctx context.Context, header http.Header) {
+	sink, ok := ctx.Value(rateLimitSinkKey{}).(*rateLimitSink)
+	if !ok {
+		return
+	}
+	sink.mu.Lock()
+	defer sink.mu.Unlock()
+	sink.header = header.Clone()
+}
@@ -0,0 +1,167 @@
+package utils_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/jarcoal/httpmock"
+	"github.com/poteto-go/go-alchemy-sdk/types"
+	"github.com/poteto-go/go-alchemy-sdk/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+const rateLimitedBody = `{"jsonrpc":"2.0","id":1,"error":{"code":429,"message":"Your app has exceeded its compute units per second capacity.","data":{"cups":330}}}`
+
+func TestParseRateLimit(t *testing.T) {
+	t.Run("retry-after seconds and x-ratelimit headers", func(t *testing.T) {
+		// Arrange
+		header := http.Header{}
+		header.Set("Retry-After", "2")
+		header.Set("X-RateLimit-Limit", "330")
+		header.Set("X-RateLimit-Remaining", "0")
+		header.Set("X-RateLimit-Reset", "1767225600")
+		cause := errors.New("test error")
+
+		// Act
+		rateLimitErr := utils.ParseRateLimit(header, []byte(rateLimitedBody), cause)
+
+		// Assert
+		assert.Equal(t, 2*time.Second, rateLimitErr.RetryAfter)
+		assert.Equal(t, 330, rateLimitErr.Limit)
+		assert.Equal(t, 0, rateLimitErr.Remaining)
+		assert.Equal(t, time.Unix(1767225600, 0), rateLimitErr.Reset)
+		assert.Equal(t, 429, rateLimitErr.Code)
+		assert.Equal(t, "Your app has exceeded its compute units per second capacity.", rateLimitErr.Message)
+		assert.JSONEq(t, `{"cups":330}`, string(rateLimitErr.Data))
+		assert.ErrorIs(t, rateLimitErr, cause)
+	})
+
+	t.Run("retry-after http-date and relative reset", func(t *testing.T) {
+		// Arrange
+		header := http.Header{}
+		header.Set("Retry-After", time.Now().Add(time.Hour).UTC().Format(http.TimeFormat))
+		header.Set("X-RateLimit-Reset", "30")
+
+		// Act
+		rateLimitErr := utils.ParseRateLimit(header, nil, nil)
+
+		// Assert
+		assert.InDelta(t, time.Hour, rateLimitErr.RetryAfter, float64(2*time.Second))
+		assert.WithinDuration(t, time.Now().Add(30*time.Second), rateLimitErr.Reset, 2*time.Second)
+	})
+
+	t.Run("nothing sent", func(t *testing.T) {
+		// Act
+		rateLimitErr := utils.ParseRateLimit(http.Header{}, []byte("too many requests"), nil)
+
+		// Assert
+		assert.Equal(t, time.Duration(0), rateLimitErr.RetryAfter)
+		assert.Equal(t, -1, rateLimitErr.Limit)
+		assert.Equal(t, -1, rateLimitErr.Remaining)
+		assert.True(t, rateLimitErr.Reset.IsZero())
+		assert.Equal(t, 0, rateLimitErr.Code)
+		assert.Equal(t, "rate limited", rateLimitErr.Error())
+	})
+
+	t.Run("error of a batch body", func(t *testing.T) {
+		// Act
+		rateLimitErr := utils.ParseRateLimit(nil, []byte(`[{"jsonrpc":"2.0","id":1,"result":"0x1"},`+rateLimitedBody+`]`), nil)
+
+		// Assert
+		assert.Equal(t, 429, rateLimitErr.Code)
+	})
+}
+
+func TestAlchemyFetch_RateLimited(t *testing.T) {
+	body, _ := utils.CreateRequestBodyToBytes(1, "eth_blockNumber", types.RequestArgs{})
+
+	t.Run("http 429", func(t *testing.T) {
+		httpmock.Activate(t)
+		defer httpmock.DeactivateAndReset()
+
+		// Arrange
+		req, _ := http.NewRequest("POST", "example.com", nil)
+		res := httpmock.NewStringResponse(http.StatusTooManyRequests, rateLimitedBody)
+		res.Header.Set("Retry-After", "1")
+		httpmock.RegisterResponder("POST", "example.com", httpmock.ResponderFromResponse(res))
+
+		// Act
+		_, err := utils.AlchemyFetch(&http.Client{}, types.AlchemyRequest{Request: req}, body)
+
+		// Assert
+		rateLimitErr, ok := errors.AsType[*types.RateLimitError](err)
+		assert.True(t, ok)
+		assert.Equal(t, time.Second, rateLimitErr.RetryAfter)
+		assert.Equal(t, 429, rateLimitErr.Code)
+		httpErr, ok := errors.AsType[rpc.HTTPError](err)
+		assert.True(t, ok)
+		assert.Equal(t, http.StatusTooManyRequests, httpErr.StatusCode)
+	})
+
+	t.Run("json-rpc error 429", func(t *testing.T) {
+		httpmock.Activate(t)
+		defer httpmock.DeactivateAndReset()
+
+		// Arrange
+		req, _ := http.NewRequest("POST", "example.com", nil)
+		httpmock.RegisterResponder("POST", "example.com", httpmock.NewStringResponder(200, rateLimitedBody))
+
+		// Act
+		_, err := utils.AlchemyFetch(&http.Client{}, types.AlchemyRequest{Request: req}, body)
+
+		// Assert
+		rateLimitErr, ok := errors.AsType[*types.RateLimitError](err)
+		assert.True(t, ok)
+		assert.Equal(t, 429, rateLimitErr.Code)
+		rpcErr, ok := errors.AsType[*types.RpcError](err)
+		assert.True(t, ok)
+		assert.Equal(t, 429, rpcErr.Code)
+	})
+
+	t.Run("batch http 429", func(t *testing.T) {
+		httpmock.Activate(t)
+		defer httpmock.DeactivateAndReset()
+
+		// Arrange
+		req, _ := http.NewRequest("POST", "example.com", nil)
+		requests := []types.AlchemyRequest{{Request: req}, {Request: req}}
+		httpmock.RegisterResponder("POST", "example.com", httpmock.NewStringResponder(http.StatusTooManyRequests, rateLimitedBody))
+
+		// Act
+		_, err := utils.AlchemyBatchFetch(&http.Client{}, requests, [][]byte{body, body})
+
+		// Assert
+		_, ok := errors.AsType[*types.RateLimitError](err)
+		assert.True(t, ok)
+	})
+}
+
+func TestRateLimitHeader(t *testing.T) {
+	t.Run("the shared client records the headers of a 429", func(t *testing.T) {
+		httpmock.Activate(t)
+		defer httpmock.DeactivateAndReset()
+
+		// Arrange
+		res := httpmock.NewStringResponse(http.StatusTooManyRequests, rateLimitedBody)
+		res.Header.Set("Retry-After", "3")
+		httpmock.RegisterResponder("POST", "http://example.com", httpmock.ResponderFromResponse(res))
+		ctx := utils.WithRateLimitSink(context.Background())
+		req, _ := http.NewRequestWithContext(ctx, "POST", "http://example.com", nil)
+
+		// Act
+		resp, err := utils.NewSharedHTTPClient(0, time.Second, nil).Do(req)
+		assert.NoError(t, err)
+		resp.Body.Close()
+
+		// Assert
+		assert.Equal(t, "3", utils.RateLimitHeader(ctx).Get("Retry-After"))
+	})
+
+	t.Run("no sink", func(t *testing.T) {
+		assert.Nil(t, utils.RateLimitHeader(context.Background()))
+	})
+}
@@ -0,0 +1,66 @@
+package utils
+
+import (
+	"encoding/json"
+	"errors"
+
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/poteto-go/go-alchemy-sdk/types"
+)
+
+/*
+RpcErrorOf turns the JSON-RPC error of a geth client call into a
+*types.RpcError for method, with the error's data as raw JSON. A
+*types.RateLimitError keeps its type, with its Err turned instead.
+
+Other errors, e.g. transport failures, are returned as is.
+*/
+func RpcErrorOf(method string, err error) error {
+	if err == nil {
+		return nil
+	}
+	if rateLimitErr, ok := errors.AsType[*types.RateLimitError](err); ok {
+		rateLimitErr.Err = RpcErrorOf(method, rateLimitErr.Err)
+		return err
+	}
+	if rpcErr, ok := errors.AsType[*types.RpcError](err); ok {
+		if rpcErr.Method == "" {
+			rpcErr.Method = method
+		}
+		return err
+	}
+
+	gethErr, ok := err.(rpc.Error)
+	if !ok {
+		return err
+	}
+	rpcErr := &types.RpcError{
+		Method:  method,
+		Code:    gethErr.ErrorCode(),
+		Message: gethErr.Error(),
+	}
+	if dataErr, ok := err.(rpc.DataError); ok && dataErr.ErrorData() != nil {
+		if data, err := json.Marshal(dataErr.ErrorData()); err == nil {
+			rpcErr.Data = data
+		}
+	}
+	return rpcErr
+}
+
+func (e *jsonRpcError) toRpcError(method string) *types.RpcError {
+	return &types.RpcError{
+		Method:  method,
+		Code:    e.Code,
+		Message: e.Message,
+		Data:    e.Data,
+	}
+}
+
+// methodOf returns the method of a JSON-RPC request body.
+func methodOf(body []byte) string {
+	var request types.AlchemyRequestBody
+	if err := json.Unmarshal(body, &request); err != nil {
+		return ""
+	}
+	return request.Method
+}
@@ -0,0 +1,85 @@
+package utils_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/poteto-go/go-alchemy-sdk/types"
+	"github.com/poteto-go/go-alchemy-sdk/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+type testGethError struct {
+	code int
+	data any
+}
+
+func (e testGethError) Error() string  { return "insufficient funds for gas * price + value" }
+func (e testGethError) ErrorCode() int { return e.code }
+func (e testGethError) ErrorData() any { return e.data }
+
+func TestRpcErrorOf(t *testing.T) {
+	t.Run("geth rpc error -> *types.RpcError", func(t *testing.T) {
+		// Act
+		err := utils.RpcErrorOf("eth_sendRawTransaction", testGethError{code: -32000, data: map[string]string{"reason": "balance"}})
+
+		// Assert
+		rpcErr, ok := errors.AsType[*types.RpcError](err)
+		assert.True(t, ok)
+		assert.Equal(t, "eth_sendRawTransaction", rpcErr.Method)
+		assert.Equal(t, -32000, rpcErr.Code)
+		assert.Equal(t, "insufficient funds for gas * price + value", rpcErr.Message)
+		assert.JSONEq(t, `{"reason":"balance"}`, string(rpcErr.Data))
+		assert.True(t, rpcErr.IsInsufficientFunds())
+	})
+
+	t.Run("no data", func(t *testing.T) {
+		// Act
+		err := utils.RpcErrorOf("eth_call", testGethError{code: -32000})
+
+		// Assert
+		rpcErr, ok := errors.AsType[*types.RpcError](err)
+		assert.True(t, ok)
+		assert.Nil(t, rpcErr.Data)
+	})
+
+	t.Run("*types.RpcError keeps its method, or takes one", func(t *testing.T) {
+		// Arrange
+		named := &types.RpcError{Method: "eth_call"}
+		unnamed := &types.RpcError{}
+
+		// Act
+		utils.RpcErrorOf("eth_getLogs", named)
+		utils.RpcErrorOf("eth_getLogs", unnamed)
+
+		// Assert
+		assert.Equal(t, "eth_call", named.Method)
+		assert.Equal(t, "eth_getLogs", unnamed.Method)
+	})
+
+	t.Run("*types.RateLimitError keeps its type", func(t *testing.T) {
+		// Arrange
+		rateLimitErr := &types.RateLimitError{Err: testGethError{code: 429}}
+
+		// Act
+		err := utils.RpcErrorOf("eth_call", rateLimitErr)
+
+		// Assert
+		assert.Same(t, rateLimitErr, err)
+		rpcErr, ok := errors.AsType[*types.RpcError](err)
+		assert.True(t, ok)
+		assert.Equal(t, 429, rpcErr.Code)
+	})
+
+	t.Run("other errors are returned as is", func(t *testing.T) {
+		// Arrange
+		httpErr := rpc.HTTPError{StatusCode: 500}
+		cause := errors.New("test error")
+
+		// Act & Assert
+		assert.Equal(t, httpErr, utils.RpcErrorOf("eth_call", httpErr))
+		assert.Equal(t, cause, utils.RpcErrorOf("eth_call", cause))
+		assert.Nil(t, utils.RpcErrorOf("eth_call", nil))
+	})
+}
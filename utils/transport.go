@@ -35,12 +35,16 @@ type limitedTransport struct {
 //	       └─ Transport.RoundTrip(req)   ← called automatically by Go's http.Client
 //	            └─ limitedTransport.RoundTrip()  [utils/transport.go]
 //	                 ├─ t.underlying.RoundTrip(req)  ← actual HTTP communication
+//	                 ├─ 429 headers recorded for WithRateLimitSink
 //	                 └─ resp.Body = LimitReader(resp.Body, maxBytes)  ← wrapped here
 func (t *limitedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
 	resp, err := t.underlying.RoundTrip(req)
 	if err != nil {
 		return nil, err
 	}
+	if resp.StatusCode == http.StatusTooManyRequests {
+		recordRateLimitHeader(req.Context(), resp.Header)
+	}
 	resp.Body = limitedReadCloser{
 		Reader: io.LimitReader(resp.Body, t.maxBytes),
 		Closer: resp.Body,
@@ -29,6 +29,14 @@ func Address(addr string) error {
 	return nil
 }
 
+// Hash validates a 32-byte hex hash, e.g. of a block or a transaction.
+func Hash(hash string) error {
+	if !common.IsHexHash(hash) {
+		return constant.ErrInvalidHash
+	}
+	return nil
+}
+
 func Addresses(addrs ...string) error {
 	for _, addr := range addrs {
 		if err := Address(addr); err != nil {
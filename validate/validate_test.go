@@ -2,6 +2,7 @@ package validate_test
 
 import (
 	"math/big"
+	"strings"
 	"testing"
 
 	"github.com/poteto-go/go-alchemy-sdk/constant"
@@ -81,6 +82,26 @@ func TestAddresses(t *testing.T) {
 	}
 }
 
+func TestHash(t *testing.T) {
+	tests := []struct {
+		name    string
+		hash    string
+		wantErr error
+	}{
+		{"valid", "0x" + strings.Repeat("ab", 32), nil},
+		{"without prefix", strings.Repeat("ab", 32), nil},
+		{"empty", "", constant.ErrInvalidHash},
+		{"too short", "0xabc", constant.ErrInvalidHash},
+		{"not hex", "0x" + strings.Repeat("gg", 32), constant.ErrInvalidHash},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.ErrorIs(t, validate.Hash(tt.hash), tt.wantErr)
+		})
+	}
+}
+
 func TestBlockTag(t *testing.T) {
 	tests := []struct {
 		name     string
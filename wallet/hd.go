@@ -0,0 +1,252 @@
+package wallet
+
+import (
+	"crypto/hmac"
+	"crypto/sha512"
+	"encoding/binary"
+	"errors"
+	"iter"
+	"math/big"
+	"slices"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/poteto-go/go-alchemy-sdk/constant"
+	"github.com/poteto-go/go-alchemy-sdk/types"
+	"github.com/tyler-smith/go-bip39"
+)
+
+const (
+	// DefaultHDPath is the BIP-44 path of the first Ethereum account, as
+	// MetaMask, Ledger & geth derive it.
+	DefaultHDPath = "m/44'/60'/0'/0/0"
+
+	// DefaultHDBasePath is the BIP-44 path HDAccounts derives the account at
+	// index i under, as DefaultHDBasePath/i.
+	DefaultHDBasePath = "m/44'/60'/0'/0"
+)
+
+// NewMnemonic generates a new random BIP-39 mnemonic (English) of words
+// words: 12, 15, 18, 21 or 24.
+func NewMnemonic(words int) (string, error) {
+	if words < 12 || words > 24 || words%3 != 0 {
+		return "", constant.ErrInvalidMnemonicWords
+	}
+
+	entropy, err := bip39.NewEntropy(words / 3 * 32)
+	if err != nil {
+		return "", err
+	}
+	return bip39.NewMnemonic(entropy)
+}
+
+// ValidateMnemonic checks that mnemonic is a BIP-39 mnemonic (English): its
+// word count, its words and its checksum.
+func ValidateMnemonic(mnemonic string) error {
+	if _, err := bip39.EntropyFromMnemonic(normalizeMnemonic(mnemonic)); err != nil {
+		return errors.Join(constant.ErrInvalidMnemonic, err)
+	}
+	return nil
+}
+
+/*
+FromMnemonic creates a wallet of the key derived at path (e.g. DefaultHDPath)
+from a BIP-39 mnemonic and its optional passphrase ("25th word").
+
+A path without the "m/" prefix is relative to m/44'/60'/0'/0, as in geth.
+*/
+func FromMnemonic(mnemonic, passphrase, path string) (types.Wallet, error) {
+	derivationPath, err := parseHDPath(path)
+	if err != nil {
+		return &wallet{}, err
+	}
+	master, err := masterKeyOf(mnemonic, passphrase)
+	if err != nil {
+		return &wallet{}, err
+	}
+
+	key, err := master.derivePath(derivationPath)
+	if err != nil {
+		return &wallet{}, err
+	}
+	return key.wallet()
+}
+
+/*
+HDAccounts derives the accounts of a mnemonic at index i of a base path,
+m/44'/60'/0'/0/i by default.
+
+The key at the base path is derived once, so each account costs one more
+derivation step.
+*/
+type HDAccounts struct {
+	base    accounts.DerivationPath
+	baseKey *hdKey
+}
+
+// NewHDAccounts derives the key of mnemonic and passphrase at basePath;
+// "" is DefaultHDBasePath.
+func NewHDAccounts(mnemonic, passphrase, basePath string) (*HDAccounts, error) {
+	if basePath == "" {
+		basePath = DefaultHDBasePath
+	}
+	base, err := parseHDPath(basePath)
+	if err != nil {
+		return nil, err
+	}
+	master, err := masterKeyOf(mnemonic, passphrase)
+	if err != nil {
+		return nil, err
+	}
+
+	baseKey, err := master.derivePath(base)
+	if err != nil {
+		return nil, err
+	}
+	return &HDAccounts{base: base, baseKey: baseKey}, nil
+}
+
+// Path returns the derivation path of the account at index.
+func (a *HDAccounts) Path(index uint32) string {
+	return append(slices.Clone(a.base), index).String()
+}
+
+/*
+Account creates a wallet of the account at index.
+
+It returns constant.ErrInvalidDerivedKey for the (about 1 in 2^127) indexes
+BIP-32 derives no key at; skip to the next one.
+*/
+func (a *HDAccounts) Account(index uint32) (types.Wallet, error) {
+	key, err := a.baseKey.child(index)
+	if err != nil {
+		return &wallet{}, err
+	}
+	return key.wallet()
+}
+
+/*
+All iterates over the accounts from index start on, with their indexes, until
+the loop breaks or the non-hardened indexes run out. Indexes with no key are
+skipped.
+
+	for i, w := range accounts.All(0) {
+		if i == 100 {
+			break
+		}
+		fmt.Println(i, w.GetAddress())
+	}
+*/
+func (a *HDAccounts) All(start uint32) iter.Seq2[uint32, types.Wallet] {
+	return func(yield func(uint32, types.Wallet) bool) {
+		for index := start; index < hardenedOffset; index++ {
+			w, err := a.Account(index)
+			if err != nil {
+				continue
+			}
+			if !yield(index, w) {
+				return
+			}
+		}
+	}
+}
+
+// hardenedOffset is the first hardened index of BIP-32.
+const hardenedOffset = 0x80000000
+
+// hdKey is a BIP-32 extended private key.
+type hdKey struct {
+	key       []byte
+	chainCode []byte
+}
+
+func normalizeMnemonic(mnemonic string) string {
+	return strings.Join(strings.Fields(mnemonic), " ")
+}
+
+func parseHDPath(path string) (accounts.DerivationPath, error) {
+	derivationPath, err := accounts.ParseDerivationPath(path)
+	if err != nil {
+		return nil, errors.Join(constant.ErrInvalidDerivationPath, err)
+	}
+	return derivationPath, nil
+}
+
+// masterKeyOf validates mnemonic and derives the BIP-32 master key of its
+// BIP-39 seed.
+func masterKeyOf(mnemonic, passphrase string) (*hdKey, error) {
+	mnemonic = normalizeMnemonic(mnemonic)
+	if err := ValidateMnemonic(mnemonic); err != nil {
+		return nil, err
+	}
+
+	return masterKey(bip39.NewSeed(mnemonic, passphrase))
+}
+
+// masterKey derives the BIP-32 master key of seed.
+func masterKey(seed []byte) (*hdKey, error) {
+	sum := hmacSHA512([]byte("Bitcoin seed"), seed)
+	if !validPrivateKey(new(big.Int).SetBytes(sum[:32])) {
+		return nil, constant.ErrInvalidDerivedKey
+	}
+	return &hdKey{key: sum[:32], chainCode: sum[32:]}, nil
+}
+
+func (k *hdKey) derivePath(path accounts.DerivationPath) (*hdKey, error) {
+	key := k
+	for _, index := range path {
+		child, err := key.child(index)
+		if err != nil {
+			return nil, err
+		}
+		key = child
+	}
+	return key, nil
+}
+
+// child derives the private child key at index (CKDpriv of BIP-32).
+func (k *hdKey) child(index uint32) (*hdKey, error) {
+	var data []byte
+	if index >= hardenedOffset {
+		data = append([]byte{0x00}, k.key...)
+	} else {
+		privateKey, err := crypto.ToECDSA(k.key)
+		if err != nil {
+			return nil, err
+		}
+		data = crypto.CompressPubkey(&privateKey.PublicKey)
+	}
+	data = binary.BigEndian.AppendUint32(data, index)
+
+	sum := hmacSHA512(k.chainCode, data)
+	tweak := new(big.Int).SetBytes(sum[:32])
+	if tweak.Cmp(crypto.S256().Params().N) >= 0 {
+		return nil, constant.ErrInvalidDerivedKey
+	}
+
+	childKey := tweak.Add(tweak, new(big.Int).SetBytes(k.key))
+	childKey.Mod(childKey, crypto.S256().Params().N)
+	if !validPrivateKey(childKey) {
+		return nil, constant.ErrInvalidDerivedKey
+	}
+	return &hdKey{key: childKey.FillBytes(make([]byte, 32)), chainCode: sum[32:]}, nil
+}
+
+func (k *hdKey) wallet() (types.Wallet, error) {
+	privateKey, err := crypto.ToECDSA(k.key)
+	if err != nil {
+		return &wallet{}, err
+	}
+	return fromPrivateKey(privateKey), nil
+}
+
+func validPrivateKey(key *big.Int) bool {
+	return key.Sign() > 0 && key.Cmp(crypto.S256().Params().N) < 0
+}
+
+func hmacSHA512(key, data []byte) []byte {
+	mac := hmac.New(sha512.New, key)
+	mac.Write(data)
+	return mac.Sum(nil)
+}
@@ -0,0 +1,218 @@
+package wallet
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/poteto-go/go-alchemy-sdk/constant"
+	"github.com/stretchr/testify/assert"
+)
+
+// the development mnemonic of hardhat & anvil, with its well-known accounts
+const testMnemonic = "test test test test test test test test test test test junk"
+
+var testMnemonicAddresses = []string{
+	"0xf39Fd6e51aad88F6F4ce6aB8827279cffFb92266",
+	"0x70997970C51812dc3A010C7d01b50e0d17dc79C8",
+	"0x3C44CdDdB6a900fa2b585dd299e03d12FA4293BC",
+}
+
+func TestNewMnemonic(t *testing.T) {
+	t.Run("generates valid mnemonics of each length", func(t *testing.T) {
+		for _, words := range []int{12, 15, 18, 21, 24} {
+			// Act
+			mnemonic, err := NewMnemonic(words)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Len(t, strings.Fields(mnemonic), words)
+			assert.NoError(t, ValidateMnemonic(mnemonic))
+		}
+	})
+
+	t.Run("if invalid word count, return ErrInvalidMnemonicWords", func(t *testing.T) {
+		for _, words := range []int{0, 11, 13, 27} {
+			// Act
+			_, err := NewMnemonic(words)
+
+			// Assert
+			assert.ErrorIs(t, err, constant.ErrInvalidMnemonicWords)
+		}
+	})
+}
+
+func TestValidateMnemonic(t *testing.T) {
+	tests := []struct {
+		name     string
+		mnemonic string
+	}{
+		{name: "bad checksum", mnemonic: strings.Repeat("test ", 11) + "test"},
+		{name: "unknown word", mnemonic: strings.Repeat("test ", 11) + "junky"},
+		{name: "bad word count", mnemonic: strings.Repeat("test ", 10) + "junk"},
+	}
+	for _, tt := range tests {
+		t.Run("if "+tt.name+", return ErrInvalidMnemonic", func(t *testing.T) {
+			// Act
+			err := ValidateMnemonic(tt.mnemonic)
+
+			// Assert
+			assert.ErrorIs(t, err, constant.ErrInvalidMnemonic)
+		})
+	}
+
+	t.Run("ignores extra whitespace", func(t *testing.T) {
+		// Act
+		err := ValidateMnemonic("  " + strings.ReplaceAll(testMnemonic, " ", "\n  ") + " ")
+
+		// Assert
+		assert.NoError(t, err)
+	})
+}
+
+func TestFromMnemonic(t *testing.T) {
+	t.Run("derives the account at path", func(t *testing.T) {
+		// Act
+		first, err := FromMnemonic(testMnemonic, "", DefaultHDPath)
+		third, thirdErr := FromMnemonic(testMnemonic, "", "m/44'/60'/0'/0/2")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.NoError(t, thirdErr)
+		assert.Equal(t, testMnemonicAddresses[0], first.GetAddress())
+		assert.Equal(t, testMnemonicAddresses[2], third.GetAddress())
+	})
+
+	t.Run("a relative path is under m/44'/60'/0'/0", func(t *testing.T) {
+		// Act
+		w, err := FromMnemonic(testMnemonic, "", "1")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, testMnemonicAddresses[1], w.GetAddress())
+	})
+
+	t.Run("the passphrase derives other accounts", func(t *testing.T) {
+		// Act
+		w, err := FromMnemonic(testMnemonic, "passphrase", DefaultHDPath)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.NotEqual(t, testMnemonicAddresses[0], w.GetAddress())
+	})
+
+	t.Run("if invalid mnemonic, return ErrInvalidMnemonic", func(t *testing.T) {
+		// Act
+		_, err := FromMnemonic(strings.Repeat("test ", 12), "", DefaultHDPath)
+
+		// Assert
+		assert.ErrorIs(t, err, constant.ErrInvalidMnemonic)
+	})
+
+	t.Run("if invalid path, return ErrInvalidDerivationPath", func(t *testing.T) {
+		// Act
+		_, err := FromMnemonic(testMnemonic, "", "m/44'/x")
+
+		// Assert
+		assert.ErrorIs(t, err, constant.ErrInvalidDerivationPath)
+	})
+}
+
+func TestHDAccounts(t *testing.T) {
+	t.Run("derives the accounts at m/44'/60'/0'/0/i", func(t *testing.T) {
+		// Arrange
+		hd, err := NewHDAccounts(testMnemonic, "", "")
+		assert.NoError(t, err)
+
+		// Act
+		addresses := []string{}
+		for i, w := range hd.All(0) {
+			if i == uint32(len(testMnemonicAddresses)) {
+				break
+			}
+			addresses = append(addresses, w.GetAddress())
+		}
+		second, accountErr := hd.Account(1)
+
+		// Assert
+		assert.Equal(t, testMnemonicAddresses, addresses)
+		assert.NoError(t, accountErr)
+		assert.Equal(t, testMnemonicAddresses[1], second.GetAddress())
+		assert.Equal(t, "m/44'/60'/0'/0/2", hd.Path(2))
+	})
+
+	t.Run("iterates from start", func(t *testing.T) {
+		// Arrange
+		hd, _ := NewHDAccounts(testMnemonic, "", DefaultHDBasePath)
+
+		// Act
+		var index uint32
+		var address string
+		for i, w := range hd.All(2) {
+			index, address = i, w.GetAddress()
+			break
+		}
+
+		// Assert
+		assert.Equal(t, uint32(2), index)
+		assert.Equal(t, testMnemonicAddresses[2], address)
+	})
+
+	t.Run("matches FromMnemonic at the same path", func(t *testing.T) {
+		// Arrange
+		hd, _ := NewHDAccounts(testMnemonic, "passphrase", "m/44'/60'/1'/0")
+
+		// Act
+		w, _ := hd.Account(7)
+		expected, _ := FromMnemonic(testMnemonic, "passphrase", hd.Path(7))
+
+		// Assert
+		assert.Equal(t, expected.GetAddress(), w.GetAddress())
+	})
+
+	t.Run("if invalid mnemonic, return ErrInvalidMnemonic", func(t *testing.T) {
+		// Act
+		_, err := NewHDAccounts("junk", "", "")
+
+		// Assert
+		assert.ErrorIs(t, err, constant.ErrInvalidMnemonic)
+	})
+}
+
+// BIP-32 test vector 1
+func Test_hdKey(t *testing.T) {
+	seed, _ := hex.DecodeString("000102030405060708090a0b0c0d0e0f")
+	tests := []struct {
+		path string
+		key  string
+	}{
+		{path: "m", key: "e8f32e723decf4051aefac8e2c93c9c5b214313817cdb01a1494b917c8436b35"},
+		{path: "m/0'", key: "edb2e14f9ee77d26dd93b4ecede8d16ed408ce149b6cd80b0715a2d911a0afea"},
+		{path: "m/0'/1", key: "3c6cb8d0f6a264c91ea8b5030fadaa8e538b020f0a387421a12de9319dc93368"},
+		{path: "m/0'/1/2'", key: "cbce0d719ecf7431d88e6a89fa1483e02e35092af60c042b1df2ff59fa424dca"},
+		{path: "m/0'/1/2'/2", key: "0f479245fb19a38a1954c5c7c0ebab2f9bdfd96a17563ef28a6a4b1a2a764ef4"},
+		{path: "m/0'/1/2'/2/1000000000", key: "471b76e389e528d6de6d816857e012c5455051cad6660850e58372a6c3e6e7c8"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			// Arrange
+			master, err := masterKey(seed)
+			assert.NoError(t, err)
+			path := accounts.DerivationPath{}
+			if tt.path != "m" {
+				path, _ = accounts.ParseDerivationPath(tt.path)
+			}
+
+			// Act
+			key, err := master.derivePath(path)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tt.key, hex.EncodeToString(key.key))
+			_, err = crypto.ToECDSA(key.key)
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,147 @@
+package wallet
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/pbkdf2"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/google/uuid"
+	"github.com/poteto-go/go-alchemy-sdk/constant"
+	"github.com/poteto-go/go-alchemy-sdk/signer"
+	"github.com/poteto-go/go-alchemy-sdk/types"
+)
+
+// DefaultPBKDF2Iterations is the PBKDF2 count of ExportKeystore, as in the
+// Web3 Secret Storage test vectors.
+const DefaultPBKDF2Iterations = 262144
+
+/*
+FromKeystoreJSON decrypts a Web3 Secret Storage (v3) keystore file - as geth,
+MetaMask or ExportKeystore write it, with scrypt or pbkdf2 - with passphrase
+and creates a wallet of its key.
+
+A wrong passphrase returns keystore.ErrDecrypt.
+*/
+func FromKeystoreJSON(keyJSON []byte, passphrase string) (types.Wallet, error) {
+	key, err := keystore.DecryptKey(keyJSON, passphrase)
+	if err != nil {
+		return &wallet{}, err
+	}
+
+	return fromPrivateKey(key.PrivateKey), nil
+}
+
+func (w *wallet) ExportKeystore(passphrase string, option *types.KeystoreOption) ([]byte, error) {
+	local, ok := w.signer.(*signer.Local)
+	if !ok {
+		return nil, constant.ErrKeyNotExportable
+	}
+
+	var config types.KeystoreOption
+	if option != nil {
+		config = *option
+	}
+
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return nil, err
+	}
+	key := &keystore.Key{
+		Id:         id,
+		Address:    local.Address(),
+		PrivateKey: local.PrivateKey(),
+	}
+
+	switch config.KDF {
+	case "", types.KeystoreScrypt:
+		scryptN, scryptP := keystore.StandardScryptN, keystore.StandardScryptP
+		if config.ScryptN > 0 {
+			scryptN = config.ScryptN
+		}
+		if config.ScryptP > 0 {
+			scryptP = config.ScryptP
+		}
+		return keystore.EncryptKey(key, passphrase, scryptN, scryptP)
+	case types.KeystorePBKDF2:
+		iterations := DefaultPBKDF2Iterations
+		if config.PBKDF2Iterations > 0 {
+			iterations = config.PBKDF2Iterations
+		}
+		return encryptKeyPBKDF2(key, passphrase, iterations)
+	default:
+		return nil, errors.Join(constant.ErrUnsupportedKDF, fmt.Errorf("kdf %q", config.KDF))
+	}
+}
+
+// keystoreV3 is a Web3 Secret Storage (v3) keystore file; geth's own is
+// unexported, and only writes scrypt.
+type keystoreV3 struct {
+	Address string         `json:"address"`
+	Crypto  keystoreCrypto `json:"crypto"`
+	Id      string         `json:"id"`
+	Version int            `json:"version"`
+}
+
+type keystoreCrypto struct {
+	Cipher       string `json:"cipher"`
+	CipherText   string `json:"ciphertext"`
+	CipherParams struct {
+		IV string `json:"iv"`
+	} `json:"cipherparams"`
+	KDF       string         `json:"kdf"`
+	KDFParams map[string]any `json:"kdfparams"`
+	MAC       string         `json:"mac"`
+}
+
+// encryptKeyPBKDF2 is keystore.EncryptKey with PBKDF2-HMAC-SHA256 in place
+// of scrypt.
+func encryptKeyPBKDF2(key *keystore.Key, passphrase string, iterations int) ([]byte, error) {
+	salt := make([]byte, 32)
+	iv := make([]byte, aes.BlockSize)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+	if _, err := rand.Read(iv); err != nil {
+		return nil, err
+	}
+
+	derivedKey, err := pbkdf2.Key(sha256.New, passphrase, salt, iterations, 32)
+	if err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(derivedKey[:16])
+	if err != nil {
+		return nil, err
+	}
+	plainText := crypto.FromECDSA(key.PrivateKey)
+	cipherText := make([]byte, len(plainText))
+	cipher.NewCTR(block, iv).XORKeyStream(cipherText, plainText)
+
+	v3 := keystoreV3{
+		Address: hex.EncodeToString(key.Address[:]),
+		Crypto: keystoreCrypto{
+			Cipher:     "aes-128-ctr",
+			CipherText: hex.EncodeToString(cipherText),
+			KDF:        string(types.KeystorePBKDF2),
+			KDFParams: map[string]any{
+				"c":     iterations,
+				"dklen": 32,
+				"prf":   "hmac-sha256",
+				"salt":  hex.EncodeToString(salt),
+			},
+			MAC: hex.EncodeToString(crypto.Keccak256(derivedKey[16:32], cipherText)),
+		},
+		Id:      key.Id.String(),
+		Version: 3,
+	}
+	v3.Crypto.CipherParams.IV = hex.EncodeToString(iv)
+	return json.Marshal(v3)
+}
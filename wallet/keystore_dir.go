@@ -0,0 +1,126 @@
+package wallet
+
+import (
+	"os"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/poteto-go/go-alchemy-sdk/constant"
+	"github.com/poteto-go/go-alchemy-sdk/types"
+)
+
+/*
+KeystoreDir is a directory of keystore files, one per account, as geth's
+--keystore: files named UTC--<created at>--<address>, picked up as they are
+added or removed.
+
+It watches the directory for that; Close it once done, so the watcher can
+stop.
+*/
+type KeystoreDir struct {
+	mu sync.RWMutex
+	ks *keystore.KeyStore
+}
+
+// NewKeystoreDir opens dir, created on first Store. Store encrypts with
+// scrypt at scryptN and scryptP; 0 uses geth's standard cost.
+func NewKeystoreDir(dir string, scryptN, scryptP int) *KeystoreDir {
+	if scryptN <= 0 {
+		scryptN = keystore.StandardScryptN
+	}
+	if scryptP <= 0 {
+		scryptP = keystore.StandardScryptP
+	}
+	return &KeystoreDir{ks: keystore.NewKeyStore(dir, scryptN, scryptP)}
+}
+
+/*
+Close lets go of the keystore: then Accounts is empty, and Unlock & Store
+return constant.ErrKeystoreDirClosed. Closing again does nothing.
+
+Stopping the directory watcher is best-effort. geth's keystore has no Close:
+it stops its watcher in a cleanup once it is garbage collected, so the
+watcher runs on for a while after Close.
+*/
+func (d *KeystoreDir) Close() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.ks = nil
+}
+
+func (d *KeystoreDir) keystore() (*keystore.KeyStore, error) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	if d.ks == nil {
+		return nil, constant.ErrKeystoreDirClosed
+	}
+	return d.ks, nil
+}
+
+// Accounts returns the addresses of the keystore files in the directory.
+func (d *KeystoreDir) Accounts() []string {
+	ks, err := d.keystore()
+	if err != nil {
+		return []string{}
+	}
+	accounts := ks.Accounts()
+	addresses := make([]string, len(accounts))
+	for i, account := range accounts {
+		addresses[i] = account.Address.Hex()
+	}
+	return addresses
+}
+
+/*
+Unlock decrypts the keystore file of address with passphrase, and creates a
+wallet of its key.
+
+It returns keystore.ErrNoMatch if no file is for address, and
+keystore.ErrDecrypt for a wrong passphrase.
+*/
+func (d *KeystoreDir) Unlock(address string, passphrase string) (types.Wallet, error) {
+	ks, err := d.keystore()
+	if err != nil {
+		return &wallet{}, err
+	}
+	account, err := ks.Find(accounts.Account{Address: common.HexToAddress(address)})
+	if err != nil {
+		return &wallet{}, err
+	}
+
+	keyJSON, err := os.ReadFile(account.URL.Path)
+	if err != nil {
+		return &wallet{}, err
+	}
+	return FromKeystoreJSON(keyJSON, passphrase)
+}
+
+/*
+Store writes the key of w to a new keystore file in the directory, encrypted
+with passphrase, and returns its address.
+
+It returns keystore.ErrAccountAlreadyExists if the directory has the account.
+*/
+func (d *KeystoreDir) Store(w types.Wallet, passphrase string) (string, error) {
+	ks, err := d.keystore()
+	if err != nil {
+		return "", err
+	}
+
+	// only carries the key to Import, which encrypts it again at the cost of d
+	keyJSON, err := w.ExportKeystore(passphrase, &types.KeystoreOption{
+		ScryptN: keystore.LightScryptN,
+		ScryptP: keystore.LightScryptP,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	account, err := ks.Import(keyJSON, passphrase, passphrase)
+	if err != nil {
+		return "", err
+	}
+	return account.Address.Hex(), nil
+}
@@ -0,0 +1,203 @@
+package wallet
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/poteto-go/go-alchemy-sdk/constant"
+	"github.com/poteto-go/go-alchemy-sdk/signer"
+	"github.com/poteto-go/go-alchemy-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+// the Web3 Secret Storage test vectors, as geth tests them
+const (
+	vectorPassphrase = "testpassword"
+	vectorPrivHex    = "7a28b5ba57c53603b0b07b56bba752f7784bf506fa95edc395f5cf6c7514fe9d"
+	vectorScrypt     = `{"crypto":{"cipher":"aes-128-ctr","cipherparams":{"iv":"83dbcc02d8ccb40e466191a123791e0e"},"ciphertext":"d172bf743a674da9cdad04534d56926ef8358534d458fffccd4e6ad2fbde479c","kdf":"scrypt","kdfparams":{"dklen":32,"n":262144,"r":1,"p":8,"salt":"ab0c7876052600dd703518d6fc3fe8984592145b591fc8fb5c6d43190334ba19"},"mac":"2103ac29920d71da29f15d75b4a16dbe95cfd7ff8faea1056c33131d846e3097"},"id":"3198bc9c-6672-5ab3-d995-4942343ae5b6","version":3}`
+	vectorPBKDF2     = `{"crypto":{"cipher":"aes-128-ctr","cipherparams":{"iv":"6087dab2f9fdbbfaddc31a909735c1e6"},"ciphertext":"5318b4d5bcd28de64ee5559e671353e16f075ecae9f99c7a79a38af5f869aa46","kdf":"pbkdf2","kdfparams":{"c":262144,"dklen":32,"prf":"hmac-sha256","salt":"ae3cd4e7013836a3df6bd7241b12db061dbe2c6785853cce422d148a624ce0bd"},"mac":"517ead924a9d0dc3124507e3393d175ce3ff7c1e96529c6c555ce9e51205e9b2"},"id":"3198bc9c-6672-5ab3-d995-4942343ae5b6","version":3}`
+)
+
+var lightScrypt = &types.KeystoreOption{ScryptN: keystore.LightScryptN, ScryptP: keystore.LightScryptP}
+
+func TestFromKeystoreJSON(t *testing.T) {
+	expected, _ := crypto.HexToECDSA(vectorPrivHex)
+
+	for name, keyJSON := range map[string]string{"scrypt": vectorScrypt, "pbkdf2": vectorPBKDF2} {
+		t.Run("decrypts the "+name+" test vector", func(t *testing.T) {
+			// Act
+			w, err := FromKeystoreJSON([]byte(keyJSON), vectorPassphrase)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, expected, w.(*wallet).signer.(*signer.Local).PrivateKey())
+			assert.Equal(t, crypto.PubkeyToAddress(expected.PublicKey).Hex(), w.GetAddress())
+		})
+	}
+
+	t.Run("if wrong passphrase, return keystore.ErrDecrypt", func(t *testing.T) {
+		// Act
+		_, err := FromKeystoreJSON([]byte(vectorPBKDF2), "wrong")
+
+		// Assert
+		assert.ErrorIs(t, err, keystore.ErrDecrypt)
+	})
+}
+
+func TestWallet_ExportKeystore(t *testing.T) {
+	t.Run("round-trips with each KDF", func(t *testing.T) {
+		tests := []struct {
+			option *types.KeystoreOption
+			kdf    string
+		}{
+			{option: lightScrypt, kdf: "scrypt"},
+			{option: &types.KeystoreOption{KDF: types.KeystorePBKDF2, PBKDF2Iterations: 1024}, kdf: "pbkdf2"},
+		}
+		for _, tt := range tests {
+			t.Run(tt.kdf, func(t *testing.T) {
+				// Arrange
+				w, _ := New(testPrivHex)
+
+				// Act
+				keyJSON, err := w.ExportKeystore("secret", tt.option)
+
+				// Assert
+				assert.NoError(t, err)
+				var v3 keystoreV3
+				assert.NoError(t, json.Unmarshal(keyJSON, &v3))
+				assert.Equal(t, 3, v3.Version)
+				assert.Equal(t, tt.kdf, v3.Crypto.KDF)
+				assert.Equal(t, testAddrHex, v3.Address)
+
+				imported, err := FromKeystoreJSON(keyJSON, "secret")
+				assert.NoError(t, err)
+				assert.Equal(t, w.GetAddress(), imported.GetAddress())
+			})
+		}
+	})
+
+	t.Run("pbkdf2 defaults to DefaultPBKDF2Iterations", func(t *testing.T) {
+		// Arrange
+		w, _ := New(testPrivHex)
+
+		// Act
+		keyJSON, err := w.ExportKeystore("secret", &types.KeystoreOption{KDF: types.KeystorePBKDF2})
+
+		// Assert
+		assert.NoError(t, err)
+		var v3 keystoreV3
+		assert.NoError(t, json.Unmarshal(keyJSON, &v3))
+		assert.Equal(t, float64(DefaultPBKDF2Iterations), v3.Crypto.KDFParams["c"])
+	})
+
+	t.Run("if unknown KDF, return ErrUnsupportedKDF", func(t *testing.T) {
+		// Arrange
+		w, _ := New(testPrivHex)
+
+		// Act
+		_, err := w.ExportKeystore("secret", &types.KeystoreOption{KDF: "argon2"})
+
+		// Assert
+		assert.ErrorIs(t, err, constant.ErrUnsupportedKDF)
+	})
+}
+
+func TestKeystoreDir(t *testing.T) {
+	t.Run("stores, lists & unlocks accounts by address", func(t *testing.T) {
+		// Arrange
+		dir := NewKeystoreDir(t.TempDir(), keystore.LightScryptN, keystore.LightScryptP)
+		defer dir.Close()
+		w, _ := New(testPrivHex)
+
+		// Act
+		address, err := dir.Store(w, "secret")
+		unlocked, unlockErr := dir.Unlock(address, "secret")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, w.GetAddress(), address)
+		assert.Equal(t, []string{address}, dir.Accounts())
+		assert.NoError(t, unlockErr)
+		assert.Equal(t, w.GetAddress(), unlocked.GetAddress())
+	})
+
+	t.Run("picks up keystore files written by others", func(t *testing.T) {
+		// Arrange
+		path := t.TempDir()
+		w, _ := New(testPrivHex)
+		keyJSON, _ := w.ExportKeystore("secret", &types.KeystoreOption{KDF: types.KeystorePBKDF2, PBKDF2Iterations: 1024})
+		assert.NoError(t, os.WriteFile(filepath.Join(path, "UTC--2026-01-01T00-00-00.000000000Z--"+testAddrHex), keyJSON, 0o600))
+		dir := NewKeystoreDir(path, 0, 0)
+		defer dir.Close()
+
+		// Act
+		unlocked, err := dir.Unlock(w.GetAddress(), "secret")
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, []string{w.GetAddress()}, dir.Accounts())
+		assert.Equal(t, w.GetAddress(), unlocked.GetAddress())
+	})
+
+	t.Run("if no account of the address, return keystore.ErrNoMatch", func(t *testing.T) {
+		// Arrange
+		dir := NewKeystoreDir(t.TempDir(), keystore.LightScryptN, keystore.LightScryptP)
+		defer dir.Close()
+
+		// Act
+		_, err := dir.Unlock("0x"+testAddrHex, "secret")
+
+		// Assert
+		assert.ErrorIs(t, err, keystore.ErrNoMatch)
+	})
+
+	t.Run("if wrong passphrase, return keystore.ErrDecrypt", func(t *testing.T) {
+		// Arrange
+		dir := NewKeystoreDir(t.TempDir(), keystore.LightScryptN, keystore.LightScryptP)
+		defer dir.Close()
+		w, _ := New(testPrivHex)
+		address, _ := dir.Store(w, "secret")
+
+		// Act
+		_, err := dir.Unlock(address, "wrong")
+
+		// Assert
+		assert.ErrorIs(t, err, keystore.ErrDecrypt)
+	})
+
+	t.Run("if the account is already stored, return ErrAccountAlreadyExists", func(t *testing.T) {
+		// Arrange
+		dir := NewKeystoreDir(t.TempDir(), keystore.LightScryptN, keystore.LightScryptP)
+		defer dir.Close()
+		w, _ := New(testPrivHex)
+		dir.Store(w, "secret")
+
+		// Act
+		_, err := dir.Store(w, "secret")
+
+		// Assert
+		assert.ErrorIs(t, err, keystore.ErrAccountAlreadyExists)
+	})
+
+	t.Run("after Close, lists no account & returns ErrKeystoreDirClosed", func(t *testing.T) {
+		// Arrange
+		dir := NewKeystoreDir(t.TempDir(), keystore.LightScryptN, keystore.LightScryptP)
+		w, _ := New(testPrivHex)
+		address, _ := dir.Store(w, "secret")
+
+		// Act
+		dir.Close()
+		dir.Close()
+		_, unlockErr := dir.Unlock(address, "secret")
+		_, storeErr := dir.Store(w, "secret")
+
+		// Assert
+		assert.Empty(t, dir.Accounts())
+		assert.ErrorIs(t, unlockErr, constant.ErrKeystoreDirClosed)
+		assert.ErrorIs(t, storeErr, constant.ErrKeystoreDirClosed)
+	})
+}
@@ -0,0 +1,162 @@
+package wallet
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/params"
+	"github.com/poteto-go/go-alchemy-sdk/constant"
+	"github.com/poteto-go/go-alchemy-sdk/types"
+)
+
+// replacement is what a replacement tx sends; the nonce and fees come from
+// the pending tx it replaces.
+type replacement struct {
+	to         *common.Address
+	value      *big.Int
+	data       []byte
+	gas        uint64
+	accessList gethTypes.AccessList
+}
+
+func (w *wallet) SpeedUp(txHash common.Hash, bumpPercent uint64) (*gethTypes.Transaction, error) {
+	provider := w.snapshot()
+	if provider == nil {
+		return nil, constant.ErrWalletIsNotConnected
+	}
+
+	pending, err := w.pendingTx(provider, txHash)
+	if err != nil {
+		return nil, err
+	}
+
+	return w.replace(provider, pending, replacement{
+		to:         pending.To(),
+		value:      pending.Value(),
+		data:       pending.Data(),
+		gas:        pending.Gas(),
+		accessList: pending.AccessList(),
+	}, bumpPercent)
+}
+
+func (w *wallet) Cancel(txHash common.Hash) (*gethTypes.Transaction, error) {
+	provider := w.snapshot()
+	if provider == nil {
+		return nil, constant.ErrWalletIsNotConnected
+	}
+
+	pending, err := w.pendingTx(provider, txHash)
+	if err != nil {
+		return nil, err
+	}
+
+	self := common.HexToAddress(w.GetAddress())
+	return w.replace(provider, pending, replacement{
+		to:    &self,
+		value: new(big.Int),
+		gas:   params.TxGas,
+	}, constant.MinReplacementBumpPercent)
+}
+
+// pendingTx returns the tx txHash, if it is pending and sent by w.
+func (w *wallet) pendingTx(provider types.IAlchemyProvider, txHash common.Hash) (*gethTypes.Transaction, error) {
+	tx, isPending, err := provider.Eth().GetTransaction(txHash.Hex())
+	if err != nil {
+		return nil, err
+	}
+	if !isPending {
+		return nil, errors.Join(constant.ErrTxNotPending, fmt.Errorf("tx %s", txHash.Hex()))
+	}
+
+	chainID, _, err := w.chainID()
+	if err != nil {
+		return nil, err
+	}
+	from, err := gethTypes.Sender(gethTypes.LatestSignerForChainID(chainID), tx)
+	if err != nil {
+		return nil, err
+	}
+	if from != common.HexToAddress(w.GetAddress()) {
+		return nil, errors.Join(constant.ErrTxNotFromWallet, fmt.Errorf("tx %s is sent from %s", txHash.Hex(), from.Hex()))
+	}
+
+	return tx, nil
+}
+
+// replace signs & sends r with the nonce of pending and its fees raised by
+// bumpPercent, keeping the tx type of pending.
+func (w *wallet) replace(
+	provider types.IAlchemyProvider,
+	pending *gethTypes.Transaction,
+	r replacement,
+	bumpPercent uint64,
+) (*gethTypes.Transaction, error) {
+	chainID, _, err := w.chainID()
+	if err != nil {
+		return nil, err
+	}
+	bumpPercent = max(bumpPercent, constant.MinReplacementBumpPercent)
+
+	var txData gethTypes.TxData
+	switch pending.Type() {
+	case gethTypes.LegacyTxType:
+		txData = &gethTypes.LegacyTx{
+			Nonce:    pending.Nonce(),
+			GasPrice: bumpFee(pending.GasPrice(), bumpPercent),
+			Gas:      r.gas,
+			To:       r.to,
+			Value:    r.value,
+			Data:     r.data,
+		}
+	case gethTypes.AccessListTxType:
+		txData = &gethTypes.AccessListTx{
+			ChainID:    chainID,
+			Nonce:      pending.Nonce(),
+			GasPrice:   bumpFee(pending.GasPrice(), bumpPercent),
+			Gas:        r.gas,
+			To:         r.to,
+			Value:      r.value,
+			Data:       r.data,
+			AccessList: r.accessList,
+		}
+	case gethTypes.DynamicFeeTxType:
+		txData = &gethTypes.DynamicFeeTx{
+			ChainID:    chainID,
+			Nonce:      pending.Nonce(),
+			GasTipCap:  bumpFee(pending.GasTipCap(), bumpPercent),
+			GasFeeCap:  bumpFee(pending.GasFeeCap(), bumpPercent),
+			Gas:        r.gas,
+			To:         r.to,
+			Value:      r.value,
+			Data:       r.data,
+			AccessList: r.accessList,
+		}
+	default:
+		return nil, errors.Join(constant.ErrUnsupportedTxType, fmt.Errorf("tx type %d", pending.Type()))
+	}
+
+	signedTx, err := w.signer.SignTx(gethTypes.NewTx(txData), chainID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := provider.Eth().SendRawTransaction(signedTx); err != nil {
+		return nil, err
+	}
+
+	return signedTx, nil
+}
+
+// bumpFee raises fee by percent, rounded up and by 1 wei at least, as the
+// tx pool wants every fee of a replacement strictly higher.
+func bumpFee(fee *big.Int, percent uint64) *big.Int {
+	bumped := new(big.Int).Mul(fee, new(big.Int).SetUint64(100+percent))
+	bumped.Add(bumped, big.NewInt(99)).Div(bumped, big.NewInt(100))
+	if bumped.Cmp(fee) <= 0 {
+		bumped.Add(fee, big.NewInt(1))
+	}
+	return bumped
+}
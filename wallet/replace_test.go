@@ -0,0 +1,226 @@
+package wallet
+
+import (
+	"errors"
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/agiledragon/gomonkey"
+	"github.com/ethereum/go-ethereum/common"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/poteto-go/go-alchemy-sdk/constant"
+	"github.com/poteto-go/go-alchemy-sdk/ether"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWallet_SpeedUp_Cancel(t *testing.T) {
+	to := common.HexToAddress("0x" + testAddrHexTo)
+
+	// patchPending mocks the lookup of pending, and records the replacement
+	// sent in *sent.
+	patchPending := func(patches *gomonkey.Patches, w *wallet, pending *gethTypes.Transaction, isPending bool, sent **gethTypes.Transaction) {
+		patches.ApplyMethod(
+			reflect.TypeOf(w.provider.Eth()),
+			"GetTransaction",
+			func(_ *ether.Ether, hash string) (*gethTypes.Transaction, bool, error) {
+				assert.Equal(t, pending.Hash().Hex(), hash)
+				return pending, isPending, nil
+			},
+		)
+		patches.ApplyMethod(
+			reflect.TypeOf(w.provider.Eth()),
+			"ChainID",
+			func(_ *ether.Ether) (*big.Int, error) {
+				return big.NewInt(1), nil
+			},
+		)
+		patches.ApplyMethod(
+			reflect.TypeOf(w.provider.Eth()),
+			"SendRawTransaction",
+			func(_ *ether.Ether, tx *gethTypes.Transaction) error {
+				*sent = tx
+				return nil
+			},
+		)
+	}
+
+	signBy := func(t *testing.T, w *wallet, txData gethTypes.TxData) *gethTypes.Transaction {
+		t.Helper()
+		tx, err := w.signer.SignTx(gethTypes.NewTx(txData), big.NewInt(1))
+		assert.NoError(t, err)
+		return tx
+	}
+
+	t.Run("SpeedUp raises both EIP-1559 fees by bumpPercent, with the same nonce & call", func(t *testing.T) {
+		patches := gomonkey.NewPatches()
+		defer patches.Reset()
+
+		// Arrange
+		w := createConnectedWallet()
+		pending := signBy(t, w, &gethTypes.DynamicFeeTx{
+			ChainID:   big.NewInt(1),
+			Nonce:     7,
+			GasTipCap: big.NewInt(100),
+			GasFeeCap: big.NewInt(1000),
+			Gas:       50000,
+			To:        &to,
+			Value:     big.NewInt(5),
+			Data:      []byte{0x12, 0x34},
+		})
+		var sent *gethTypes.Transaction
+		patchPending(patches, w, pending, true, &sent)
+
+		// Act
+		replacement, err := w.SpeedUp(pending.Hash(), 25)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, sent, replacement)
+		assert.Equal(t, uint8(gethTypes.DynamicFeeTxType), replacement.Type())
+		assert.Equal(t, uint64(7), replacement.Nonce())
+		assert.Equal(t, big.NewInt(125), replacement.GasTipCap())
+		assert.Equal(t, big.NewInt(1250), replacement.GasFeeCap())
+		assert.Equal(t, uint64(50000), replacement.Gas())
+		assert.Equal(t, &to, replacement.To())
+		assert.Equal(t, big.NewInt(5), replacement.Value())
+		assert.Equal(t, []byte{0x12, 0x34}, replacement.Data())
+	})
+
+	t.Run("SpeedUp raises a legacy GasPrice by the node's minimum bump at least, rounded up", func(t *testing.T) {
+		patches := gomonkey.NewPatches()
+		defer patches.Reset()
+
+		// Arrange
+		w := createConnectedWallet()
+		pending := signBy(t, w, &gethTypes.LegacyTx{
+			Nonce:    3,
+			GasPrice: big.NewInt(1001),
+			Gas:      21000,
+			To:       &to,
+			Value:    big.NewInt(1),
+		})
+		var sent *gethTypes.Transaction
+		patchPending(patches, w, pending, true, &sent)
+
+		// Act
+		replacement, err := w.SpeedUp(pending.Hash(), 1)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, uint8(gethTypes.LegacyTxType), replacement.Type())
+		assert.Equal(t, uint64(3), replacement.Nonce())
+		assert.Equal(t, big.NewInt(1102), replacement.GasPrice())
+	})
+
+	t.Run("Cancel replaces the tx with a 0-value self-transfer", func(t *testing.T) {
+		patches := gomonkey.NewPatches()
+		defer patches.Reset()
+
+		// Arrange
+		w := createConnectedWallet()
+		pending := signBy(t, w, &gethTypes.AccessListTx{
+			ChainID:  big.NewInt(1),
+			Nonce:    9,
+			GasPrice: big.NewInt(1),
+			Gas:      80000,
+			To:       &to,
+			Value:    big.NewInt(10),
+			Data:     []byte{0xff},
+		})
+		var sent *gethTypes.Transaction
+		patchPending(patches, w, pending, true, &sent)
+
+		// Act
+		replacement, err := w.Cancel(pending.Hash())
+
+		// Assert
+		assert.NoError(t, err)
+		self := common.HexToAddress(w.GetAddress())
+		assert.Equal(t, &self, replacement.To())
+		assert.Equal(t, uint64(9), replacement.Nonce())
+		assert.Equal(t, 0, replacement.Value().Sign())
+		assert.Empty(t, replacement.Data())
+		assert.Equal(t, uint64(21000), replacement.Gas())
+		assert.Equal(t, big.NewInt(2), replacement.GasPrice())
+	})
+
+	t.Run("if the tx is not pending, return ErrTxNotPending", func(t *testing.T) {
+		patches := gomonkey.NewPatches()
+		defer patches.Reset()
+
+		// Arrange
+		w := createConnectedWallet()
+		pending := signBy(t, w, &gethTypes.LegacyTx{GasPrice: big.NewInt(1), To: &to})
+		var sent *gethTypes.Transaction
+		patchPending(patches, w, pending, false, &sent)
+
+		// Act
+		_, err := w.SpeedUp(pending.Hash(), 10)
+
+		// Assert
+		assert.ErrorIs(t, err, constant.ErrTxNotPending)
+		assert.Nil(t, sent)
+	})
+
+	t.Run("if the tx is sent from another account, return ErrTxNotFromWallet", func(t *testing.T) {
+		patches := gomonkey.NewPatches()
+		defer patches.Reset()
+
+		// Arrange
+		w := createConnectedWallet()
+		other, _ := crypto.GenerateKey()
+		pending, _ := gethTypes.SignTx(
+			gethTypes.NewTx(&gethTypes.LegacyTx{GasPrice: big.NewInt(1), To: &to}),
+			gethTypes.LatestSignerForChainID(big.NewInt(1)),
+			other,
+		)
+		var sent *gethTypes.Transaction
+		patchPending(patches, w, pending, true, &sent)
+
+		// Act
+		_, err := w.Cancel(pending.Hash())
+
+		// Assert
+		assert.ErrorIs(t, err, constant.ErrTxNotFromWallet)
+		assert.Nil(t, sent)
+	})
+
+	t.Run("if failed to send the replacement, return error", func(t *testing.T) {
+		patches := gomonkey.NewPatches()
+		defer patches.Reset()
+
+		// Arrange
+		w := createConnectedWallet()
+		pending := signBy(t, w, &gethTypes.LegacyTx{GasPrice: big.NewInt(1), To: &to})
+		var sent *gethTypes.Transaction
+		patchPending(patches, w, pending, true, &sent)
+		patches.ApplyMethod(
+			reflect.TypeOf(w.provider.Eth()),
+			"SendRawTransaction",
+			func(_ *ether.Ether, _ *gethTypes.Transaction) error {
+				return errors.New("replacement transaction underpriced")
+			},
+		)
+
+		// Act
+		_, err := w.SpeedUp(pending.Hash(), 10)
+
+		// Assert
+		assert.EqualError(t, err, "replacement transaction underpriced")
+	})
+
+	t.Run("if wallet is not connected, return error", func(t *testing.T) {
+		// Arrange
+		w, _ := New(testPrivHex)
+
+		// Act
+		_, speedUpErr := w.SpeedUp(common.Hash{}, 10)
+		_, cancelErr := w.Cancel(common.Hash{})
+
+		// Assert
+		assert.ErrorIs(t, speedUpErr, constant.ErrWalletIsNotConnected)
+		assert.ErrorIs(t, cancelErr, constant.ErrWalletIsNotConnected)
+	})
+}
@@ -294,8 +294,8 @@ func (api *walletStableCoin) signPermit(
 		return types.Signature{}, err
 	}
 
-	return typeddata.SignEIP712(
-		api.w.privateKey,
+	return typeddata.SignEIP712BySigner(
+		api.w.signer,
 		domainSeparator,
 		typeddata.EncodeWords(
 			constant.PermitTypeHash,
@@ -444,8 +444,8 @@ func TestWallet_StableCoin_IsBlacklisted(t *testing.T) {
 
 		patches.ApplyMethod(
 			reflect.TypeOf(w.provider.Eth()),
-			"CallContract",
-			func(_ *ether.Ether, _ ethereum.CallMsg, _ string) ([]byte, error) {
+			"CallContractContext",
+			func(_ *ether.Ether, _ context.Context, _ ethereum.CallMsg, _ string) ([]byte, error) {
 				return expected, nil
 			},
 		)
@@ -465,8 +465,8 @@ func TestWallet_StableCoin_IsBlacklisted(t *testing.T) {
 
 		patches.ApplyMethod(
 			reflect.TypeOf(w.provider.Eth()),
-			"CallContract",
-			func(_ *ether.Ether, _ ethereum.CallMsg, _ string) ([]byte, error) {
+			"CallContractContext",
+			func(_ *ether.Ether, _ context.Context, _ ethereum.CallMsg, _ string) ([]byte, error) {
 				return expected, nil
 			},
 		)
@@ -498,8 +498,8 @@ func TestWallet_StableCoin_Currency(t *testing.T) {
 
 		patches.ApplyMethod(
 			reflect.TypeOf(w.provider.Eth()),
-			"CallContract",
-			func(_ *ether.Ether, _ ethereum.CallMsg, _ string) ([]byte, error) {
+			"CallContractContext",
+			func(_ *ether.Ether, _ context.Context, _ ethereum.CallMsg, _ string) ([]byte, error) {
 				return encode.ABIString(expected), nil
 			},
 		)
@@ -531,8 +531,8 @@ func TestWallet_StableCoin_Version(t *testing.T) {
 
 		patches.ApplyMethod(
 			reflect.TypeOf(w.provider.Eth()),
-			"CallContract",
-			func(_ *ether.Ether, _ ethereum.CallMsg, _ string) ([]byte, error) {
+			"CallContractContext",
+			func(_ *ether.Ether, _ context.Context, _ ethereum.CallMsg, _ string) ([]byte, error) {
 				return encode.ABIString(expected), nil
 			},
 		)
@@ -747,8 +747,8 @@ func TestWallet_StableCoin_Paused(t *testing.T) {
 
 		patches.ApplyMethod(
 			reflect.TypeOf(w.provider.Eth()),
-			"CallContract",
-			func(_ *ether.Ether, _ ethereum.CallMsg, _ string) ([]byte, error) {
+			"CallContractContext",
+			func(_ *ether.Ether, _ context.Context, _ ethereum.CallMsg, _ string) ([]byte, error) {
 				return expected, nil
 			},
 		)
@@ -768,8 +768,8 @@ func TestWallet_StableCoin_Paused(t *testing.T) {
 
 		patches.ApplyMethod(
 			reflect.TypeOf(w.provider.Eth()),
-			"CallContract",
-			func(_ *ether.Ether, _ ethereum.CallMsg, _ string) ([]byte, error) {
+			"CallContractContext",
+			func(_ *ether.Ether, _ context.Context, _ ethereum.CallMsg, _ string) ([]byte, error) {
 				return expected, nil
 			},
 		)
@@ -803,8 +803,8 @@ func TestWallet_StableCoin_MasterMinter(t *testing.T) {
 
 		patches.ApplyMethod(
 			reflect.TypeOf(w.provider.Eth()),
-			"CallContract",
-			func(_ *ether.Ether, _ ethereum.CallMsg, _ string) ([]byte, error) {
+			"CallContractContext",
+			func(_ *ether.Ether, _ context.Context, _ ethereum.CallMsg, _ string) ([]byte, error) {
 				return expected, nil
 			},
 		)
@@ -838,8 +838,8 @@ func TestWallet_StableCoin_Pauser(t *testing.T) {
 
 		patches.ApplyMethod(
 			reflect.TypeOf(w.provider.Eth()),
-			"CallContract",
-			func(_ *ether.Ether, _ ethereum.CallMsg, _ string) ([]byte, error) {
+			"CallContractContext",
+			func(_ *ether.Ether, _ context.Context, _ ethereum.CallMsg, _ string) ([]byte, error) {
 				return expected, nil
 			},
 		)
@@ -873,8 +873,8 @@ func TestWallet_StableCoin_Blacklister(t *testing.T) {
 
 		patches.ApplyMethod(
 			reflect.TypeOf(w.provider.Eth()),
-			"CallContract",
-			func(_ *ether.Ether, _ ethereum.CallMsg, _ string) ([]byte, error) {
+			"CallContractContext",
+			func(_ *ether.Ether, _ context.Context, _ ethereum.CallMsg, _ string) ([]byte, error) {
 				return expected, nil
 			},
 		)
@@ -908,8 +908,8 @@ func TestWallet_StableCoin_Owner(t *testing.T) {
 
 		patches.ApplyMethod(
 			reflect.TypeOf(w.provider.Eth()),
-			"CallContract",
-			func(_ *ether.Ether, _ ethereum.CallMsg, _ string) ([]byte, error) {
+			"CallContractContext",
+			func(_ *ether.Ether, _ context.Context, _ ethereum.CallMsg, _ string) ([]byte, error) {
 				return expected, nil
 			},
 		)
@@ -1198,8 +1198,8 @@ func TestWallet_StableCoin_IsMinter(t *testing.T) {
 
 		patches.ApplyMethod(
 			reflect.TypeOf(w.provider.Eth()),
-			"CallContract",
-			func(_ *ether.Ether, _ ethereum.CallMsg, _ string) ([]byte, error) {
+			"CallContractContext",
+			func(_ *ether.Ether, _ context.Context, _ ethereum.CallMsg, _ string) ([]byte, error) {
 				return expected, nil
 			},
 		)
@@ -1219,8 +1219,8 @@ func TestWallet_StableCoin_IsMinter(t *testing.T) {
 
 		patches.ApplyMethod(
 			reflect.TypeOf(w.provider.Eth()),
-			"CallContract",
-			func(_ *ether.Ether, _ ethereum.CallMsg, _ string) ([]byte, error) {
+			"CallContractContext",
+			func(_ *ether.Ether, _ context.Context, _ ethereum.CallMsg, _ string) ([]byte, error) {
 				return expected, nil
 			},
 		)
@@ -1254,8 +1254,8 @@ func TestWallet_StableCoin_MinterAllowance(t *testing.T) {
 
 		patches.ApplyMethod(
 			reflect.TypeOf(w.provider.Eth()),
-			"CallContract",
-			func(_ *ether.Ether, _ ethereum.CallMsg, _ string) ([]byte, error) {
+			"CallContractContext",
+			func(_ *ether.Ether, _ context.Context, _ ethereum.CallMsg, _ string) ([]byte, error) {
 				return expected, nil
 			},
 		)
@@ -1622,8 +1622,8 @@ func mockCallContractForPermit(patches *gomonkey.Patches, eth interface {
 	CallContract(ethereum.CallMsg, string) ([]byte, error)
 }) {
 	callCount := 0
-	patches.ApplyMethod(reflect.TypeOf(eth), "CallContract",
-		func(_ *ether.Ether, _ ethereum.CallMsg, _ string) ([]byte, error) {
+	patches.ApplyMethod(reflect.TypeOf(eth), "CallContractContext",
+		func(_ *ether.Ether, _ context.Context, _ ethereum.CallMsg, _ string) ([]byte, error) {
 			callCount++
 			if callCount == 1 {
 				// Nonces: return nonce = 0
@@ -1669,8 +1669,8 @@ func TestWallet_StableCoin_PermitNoWait(t *testing.T) {
 
 		w := createConnectedWallet()
 
-		patches.ApplyMethod(reflect.TypeOf(w.snapshot().Eth()), "CallContract",
-			func(_ *ether.Ether, _ ethereum.CallMsg, _ string) ([]byte, error) {
+		patches.ApplyMethod(reflect.TypeOf(w.snapshot().Eth()), "CallContractContext",
+			func(_ *ether.Ether, _ context.Context, _ ethereum.CallMsg, _ string) ([]byte, error) {
 				return nil, errors.New("nonces error")
 			},
 		)
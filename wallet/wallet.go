@@ -12,26 +12,28 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	gethTypes "github.com/ethereum/go-ethereum/core/types"
 
-	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/poteto-go/go-alchemy-sdk/constant"
 	"github.com/poteto-go/go-alchemy-sdk/encode"
 	"github.com/poteto-go/go-alchemy-sdk/internal"
 	"github.com/poteto-go/go-alchemy-sdk/namespace"
+	"github.com/poteto-go/go-alchemy-sdk/signer"
 	"github.com/poteto-go/go-alchemy-sdk/typeddata"
 	"github.com/poteto-go/go-alchemy-sdk/types"
 	"github.com/poteto-go/go-alchemy-sdk/utils"
 )
 
 type wallet struct {
-	privateKey *ecdsa.PrivateKey
-	publicKey  *ecdsa.PublicKey
-	provider   types.IAlchemyProvider
-	mu         sync.RWMutex
+	signer   types.Signer
+	provider types.IAlchemyProvider
+	mu       sync.RWMutex
 
 	// Cache for performance (chainID and legacy-chain flag are immutable per network)
 	cachedChainID *big.Int
 	legacyChain   bool
 
+	// nil takes the nonces from PendingNonceAt
+	nonces types.NonceManager
+
 	// for ERC20 / StableCoin / Nft / Erc1155
 	erc20      namespace.IERC20
 	stablecoin namespace.IStableCoin
@@ -45,17 +47,23 @@ func New(privateKeyStr string) (types.Wallet, error) {
 		return &wallet{}, err
 	}
 
-	publicKey := privateKey.Public()
-	publicKeyECDSA := publicKey.(*ecdsa.PublicKey)
-	return &wallet{
-		privateKey: privateKey,
-		publicKey:  publicKeyECDSA,
-	}, nil
+	return fromPrivateKey(privateKey), nil
+}
+
+/*
+FromSigner creates a wallet that signs by s, e.g. a signer.Clef or a
+signer.Remote, so that its key need not be in the process.
+*/
+func FromSigner(s types.Signer) types.Wallet {
+	return &wallet{signer: s}
+}
+
+func fromPrivateKey(privateKey *ecdsa.PrivateKey) *wallet {
+	return &wallet{signer: signer.NewLocal(privateKey)}
 }
 
 func (w *wallet) GetAddress() string {
-	address := crypto.PubkeyToAddress(*w.publicKey)
-	return common.HexToAddress(address.Hex()).String()
+	return w.signer.Address().Hex()
 }
 
 // snapshot returns the current provider under read lock.
@@ -95,6 +103,12 @@ func (w *wallet) snapshotErc1155() namespace.IErc1155 {
 	return w.erc1155
 }
 
+func (w *wallet) snapshotNonces() types.NonceManager {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	return w.nonces
+}
+
 func (w *wallet) GetBalance() (*big.Int, error) {
 	provider := w.snapshot()
 	if provider == nil {
@@ -140,12 +154,24 @@ func (w *wallet) SignTx(txRequest types.TransactionRequest) (*gethTypes.Transact
 		return nil, constant.ErrWalletIsNotConnected
 	}
 
-	nonce, err := w.PendingNonceAt()
+	nonces := w.snapshotNonces()
+	nonce, err := w.nextNonce(nonces)
 	if err != nil {
 		return nil, err
 	}
 	txRequest.Nonce = nonce
 
+	signedTx, err := w.signTx(provider, txRequest)
+	if err != nil {
+		if nonces != nil {
+			nonces.Failed(nonce, err)
+		}
+		return nil, err
+	}
+	return signedTx, nil
+}
+
+func (w *wallet) signTx(provider types.IAlchemyProvider, txRequest types.TransactionRequest) (*gethTypes.Transaction, error) {
 	estimatedGas, err := provider.Eth().EstimateGas(txRequest)
 	if err != nil {
 		return nil, err
@@ -182,10 +208,7 @@ func (w *wallet) SignTx(txRequest types.TransactionRequest) (*gethTypes.Transact
 	if err != nil {
 		return nil, err
 	}
-	tx := gethTypes.NewTx(txData)
-
-	latestEIP155Signer := gethTypes.LatestSignerForChainID(txRequest.ChainID)
-	signedTx, err := gethTypes.SignTx(tx, latestEIP155Signer, w.privateKey)
+	signedTx, err := w.signer.SignTx(gethTypes.NewTx(txData), txRequest.ChainID)
 	if err != nil {
 		return nil, err
 	}
@@ -199,16 +222,21 @@ func (w *wallet) SendTransaction(txRequest types.TransactionRequest) (common.Has
 		return common.Hash{}, constant.ErrWalletIsNotConnected
 	}
 
-	signedTx, err := w.SignTx(txRequest)
-	if err != nil {
-		return common.Hash{}, err
-	}
+	for attempt := 0; ; attempt++ {
+		signedTx, err := w.SignTx(txRequest)
+		if err != nil {
+			return common.Hash{}, err
+		}
 
-	if err := provider.Eth().SendRawTransaction(signedTx); err != nil {
+		err = provider.Eth().SendRawTransaction(signedTx)
+		if err == nil {
+			return signedTx.Hash(), nil
+		}
+		if w.retryNonce(signedTx.Nonce(), err) && attempt == 0 {
+			continue
+		}
 		return common.Hash{}, err
 	}
-
-	return signedTx.Hash(), nil
 }
 
 func (w *wallet) DeployContract(ctx context.Context, metaData *bind.MetaData) (common.Address, error) {
@@ -236,17 +264,21 @@ func (w *wallet) DeployContractNoWait(metaData *bind.MetaData) (*bind.Deployment
 		return nil, constant.ErrWalletIsNotConnected
 	}
 
-	auth, err := w.buildAuth()
-	if err != nil {
-		return nil, err
-	}
+	for attempt := 0; ; attempt++ {
+		auth, err := w.buildAuth()
+		if err != nil {
+			return nil, err
+		}
 
-	deployRes, err := provider.Eth().DeployContract(auth, metaData)
-	if err != nil {
+		deployRes, err := provider.Eth().DeployContract(auth, metaData)
+		if err == nil {
+			return deployRes, nil
+		}
+		if auth.Nonce != nil && w.retryNonce(auth.Nonce.Uint64(), err) && attempt == 0 {
+			continue
+		}
 		return nil, err
 	}
-
-	return deployRes, nil
 }
 
 func (w *wallet) ContractTransact(
@@ -267,7 +299,29 @@ func (w *wallet) ContractTransact(
 		return nil, err
 	}
 
-	return provider.Eth().WaitMined(ctx, tx.Hash())
+	txReceipt, err := provider.Eth().WaitMined(ctx, tx.Hash())
+	if err != nil {
+		return nil, err
+	}
+	if txReceipt.Status == gethTypes.ReceiptStatusFailed {
+		return txReceipt, revertedTxError(ctx, provider.Eth(), tx)
+	}
+
+	return txReceipt, nil
+}
+
+// revertedTxError is the error of a mined tx that failed: a *types.TxError
+// wrapping the *types.RevertError recovered by replaying it.
+func revertedTxError(ctx context.Context, eth types.EtherApi, tx *gethTypes.Transaction) error {
+	revertErr, err := eth.RevertReason(ctx, tx.Hash())
+	if err != nil || revertErr == nil {
+		revertErr = &types.RevertError{TxHash: tx.Hash(), Err: err}
+	}
+	return &types.TxError{
+		TxHash:  tx.Hash(),
+		ChainID: tx.ChainId(),
+		Err:     revertErr,
+	}
 }
 
 func (w *wallet) ContractTransactNoWait(
@@ -279,17 +333,21 @@ func (w *wallet) ContractTransactNoWait(
 		return nil, constant.ErrWalletIsNotConnected
 	}
 
-	auth, err := w.buildAuth()
-	if err != nil {
-		return nil, err
-	}
+	for attempt := 0; ; attempt++ {
+		auth, err := w.buildAuth()
+		if err != nil {
+			return nil, err
+		}
 
-	tx, err := provider.Eth().ContractTransact(auth, contractAddress, data)
-	if err != nil {
+		tx, err := provider.Eth().ContractTransact(auth, contractAddress, data)
+		if err == nil {
+			return tx, nil
+		}
+		if auth.Nonce != nil && w.retryNonce(auth.Nonce.Uint64(), err) && attempt == 0 {
+			continue
+		}
 		return nil, err
 	}
-
-	return tx, nil
 }
 
 func (w *wallet) ContractCall(
@@ -308,7 +366,7 @@ func (w *wallet) ContractCall(
 }
 
 func (w *wallet) SignEIP712(domainSeparator [32]byte, encoded []byte) (types.Signature, error) {
-	return typeddata.SignEIP712(w.privateKey, domainSeparator, encoded)
+	return typeddata.SignEIP712BySigner(w.signer, domainSeparator, encoded)
 }
 
 func (w *wallet) ERC20() types.WalletERC20 {
@@ -333,7 +391,7 @@ func (w *wallet) buildAuth() (*bind.TransactOpts, error) {
 		return nil, err
 	}
 
-	auth := bind.NewKeyedTransactor(w.privateKey, chainID)
+	auth := w.transactor(chainID)
 
 	if legacyChain {
 		gasPrice, err := w.snapshot().Eth().SuggestGasPrice()
@@ -343,9 +401,58 @@ func (w *wallet) buildAuth() (*bind.TransactOpts, error) {
 		auth.GasPrice = gasPrice
 	}
 
+	// without a NonceManager, bind takes the pending nonce itself
+	if nonces := w.snapshotNonces(); nonces != nil {
+		nonce, err := nonces.Next()
+		if err != nil {
+			return nil, err
+		}
+		auth.Nonce = new(big.Int).SetUint64(nonce)
+	}
+
 	return auth, nil
 }
 
+// transactor is bind.NewKeyedTransactor of w.signer.
+func (w *wallet) transactor(chainID *big.Int) *bind.TransactOpts {
+	from := w.signer.Address()
+	return &bind.TransactOpts{
+		From: from,
+		Signer: func(address common.Address, tx *gethTypes.Transaction) (*gethTypes.Transaction, error) {
+			if address != from {
+				return nil, bind.ErrNotAuthorized
+			}
+			return w.signer.SignTx(tx, chainID)
+		},
+		Context: context.Background(),
+	}
+}
+
+// nextNonce returns the nonce of the next tx, from nonces if set.
+func (w *wallet) nextNonce(nonces types.NonceManager) (uint64, error) {
+	if nonces == nil {
+		return w.PendingNonceAt()
+	}
+	return nonces.Next()
+}
+
+// retryNonce settles nonce, of a tx whose send failed with err, with the
+// NonceManager, and reports whether to send the tx again with a new one.
+func (w *wallet) retryNonce(nonce uint64, err error) bool {
+	nonces := w.snapshotNonces()
+	if nonces == nil {
+		return false
+	}
+	return nonces.Failed(nonce, err)
+}
+
+func (w *wallet) UseNonceManager(nonces types.NonceManager) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.nonces = nonces
+}
+
 // chainID returns the cached chainID and legacy-chain flag, fetching them from
 // the network on first use. It uses a double-checked pattern so the network
 // round-trip happens outside the lock: a concurrent buildAuth / SignTx no longer
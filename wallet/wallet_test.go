@@ -5,7 +5,12 @@ import (
 	"crypto/ecdsa"
 	"errors"
 	"math/big"
+	"net/url"
 	"reflect"
+	"slices"
+	"strings"
+	"sync"
+	"syscall"
 	"testing"
 
 	"github.com/agiledragon/gomonkey"
@@ -18,9 +23,16 @@ import (
 	"github.com/poteto-go/go-alchemy-sdk/ether"
 	"github.com/poteto-go/go-alchemy-sdk/gas"
 	"github.com/poteto-go/go-alchemy-sdk/internal"
+	"github.com/poteto-go/go-alchemy-sdk/nonce"
+	"github.com/poteto-go/go-alchemy-sdk/ratelimit"
+	"github.com/poteto-go/go-alchemy-sdk/signer"
+	"github.com/poteto-go/go-alchemy-sdk/telemetry"
+	"github.com/poteto-go/go-alchemy-sdk/typeddata"
 	"github.com/poteto-go/go-alchemy-sdk/types"
 	"github.com/poteto-go/go-alchemy-sdk/utils"
 	"github.com/stretchr/testify/assert"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 )
 
 var testAddrHex = "970e8128ab834e8eac17ab8e3812f010678cf791"
@@ -45,19 +57,44 @@ func createConnectedWallet() *wallet {
 	return w.(*wallet)
 }
 
+// createWalletOnNode connects a wallet to a provider whose last middleware
+// answers each JSON-RPC method with results[method] instead of the network,
+// recording the methods it sees in *methods. middlewares run before it.
+func createWalletOnNode(results map[string]any, methods *[]string, middlewares ...types.Middleware) *wallet {
+	w, _ := New(testPrivHex)
+
+	node := func(_ types.RpcHandler) types.RpcHandler {
+		return func(_ context.Context, req types.RpcRequest) (any, error) {
+			*methods = append(*methods, req.Method)
+			return results[req.Method], nil
+		}
+	}
+	setting := gas.AlchemySetting{
+		ApiKey:      "api-key",
+		Network:     types.EthMainnet,
+		Middlewares: append(middlewares, node),
+	}
+	alchemy, err := gas.NewAlchemy(setting)
+	if err != nil {
+		panic(err)
+	}
+
+	w.Connect(alchemy.GetProvider())
+
+	return w.(*wallet)
+}
+
 func TestNewWallet(t *testing.T) {
 	t.Run("if can hex to ECDSA, return wallet", func(t *testing.T) {
 		// Arrange
 		expectedP8Key, _ := crypto.HexToECDSA(testPrivHexForNew)
-		expectedPublicKey := expectedP8Key.Public().(*ecdsa.PublicKey)
 
 		// Act
 		w, err := New("0x" + testPrivHexForNew)
 
 		// Assert
 		assert.Nil(t, err)
-		assert.Equal(t, expectedP8Key, w.(*wallet).privateKey)
-		assert.Equal(t, expectedPublicKey, w.(*wallet).publicKey)
+		assert.Equal(t, signer.NewLocal(expectedP8Key), w.(*wallet).signer)
 	})
 
 	t.Run("private key length is less than 64 characters, return invalid error", func(t *testing.T) {
@@ -1295,6 +1332,20 @@ func TestWallet_DeployContractNoWait(t *testing.T) {
 	})
 }
 
+// contractTransactResults are the node results of a ContractTransact that is
+// mined with receipt.
+func contractTransactResults(receipt *gethTypes.Receipt) map[string]any {
+	return map[string]any{
+		constant.Eth_ChainId:               big.NewInt(1),
+		constant.Eth_GetTransactionCount:   uint64(3),
+		constant.Eth_GetBlockByNumber:      &gethTypes.Header{Number: big.NewInt(1), BaseFee: big.NewInt(10)},
+		constant.Eth_MaxPriorityFeePerGas:  big.NewInt(1),
+		constant.Eth_GetCode:               []byte{0x60},
+		constant.Eth_EstimateGas:           uint64(21000),
+		constant.Eth_GetTransactionReceipt: receipt,
+	}
+}
+
 func TestWallet_ContractTransact(t *testing.T) {
 	contractAddress := "0x1234567890123456789012345678901234567890"
 	data := []byte("test data")
@@ -1353,6 +1404,131 @@ func TestWallet_ContractTransact(t *testing.T) {
 		assert.Equal(t, txReceipt, expectedReceipt)
 	})
 
+	t.Run("middlewares see the JSON-RPC calls of bind", func(t *testing.T) {
+		// Arrange
+		var methods []string
+		expectedReceipt := &gethTypes.Receipt{Status: gethTypes.ReceiptStatusSuccessful}
+		w := createWalletOnNode(contractTransactResults(expectedReceipt), &methods)
+
+		// Act
+		txReceipt, err := w.ContractTransact(context.Background(), contractAddress, data)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, expectedReceipt, txReceipt)
+		assert.Contains(t, methods, constant.Eth_EstimateGas)
+		assert.Contains(t, methods, constant.Eth_SendRawTransaction)
+		assert.Contains(t, methods, constant.Eth_GetTransactionReceipt)
+	})
+
+	t.Run("the rate limiter charges the compute units of bind", func(t *testing.T) {
+		// Arrange
+		var methods []string
+		limiter := ratelimit.New(ratelimit.Config{DailyBudget: 10_000})
+		w := createWalletOnNode(
+			contractTransactResults(&gethTypes.Receipt{Status: gethTypes.ReceiptStatusSuccessful}),
+			&methods,
+			limiter.Middleware(),
+		)
+
+		// Act
+		_, err := w.ContractTransact(context.Background(), contractAddress, data)
+
+		// Assert
+		assert.NoError(t, err)
+		expected := int64(0)
+		for _, method := range methods {
+			expected += int64(limiter.Cost(types.RpcRequest{Method: method}))
+		}
+		assert.Equal(t, expected, limiter.Used())
+		assert.GreaterOrEqual(t, limiter.Used(), int64(
+			ratelimit.DefaultComputeUnits[constant.Eth_EstimateGas]+
+				ratelimit.DefaultComputeUnits[constant.Eth_SendRawTransaction]+
+				ratelimit.DefaultComputeUnits[constant.Eth_GetTransactionReceipt],
+		))
+	})
+
+	t.Run("telemetry traces the JSON-RPC calls of bind", func(t *testing.T) {
+		// Arrange
+		var methods []string
+		recorder := tracetest.NewSpanRecorder()
+		tel, _ := telemetry.New(telemetry.Config{
+			TracerProvider: sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)),
+		}, types.EthMainnet)
+		w := createWalletOnNode(
+			contractTransactResults(&gethTypes.Receipt{Status: gethTypes.ReceiptStatusSuccessful}),
+			&methods,
+			tel.Middleware(),
+		)
+
+		// Act
+		_, err := w.ContractTransact(context.Background(), contractAddress, data)
+
+		// Assert
+		assert.NoError(t, err)
+		spanNames := []string{}
+		for _, span := range recorder.Ended() {
+			spanNames = append(spanNames, span.Name())
+		}
+		assert.Equal(t, methods, spanNames)
+		assert.Contains(t, spanNames, constant.Eth_EstimateGas)
+		assert.Contains(t, spanNames, constant.Eth_SendRawTransaction)
+		assert.Contains(t, spanNames, constant.Eth_GetTransactionReceipt)
+	})
+
+	t.Run("mined with status=0 -> receipt & TxError wrapping the replayed RevertError", func(t *testing.T) {
+		patches := gomonkey.NewPatches()
+		defer patches.Reset()
+
+		// Arrange
+		w := createConnectedWallet()
+		failedReceipt := &gethTypes.Receipt{
+			Status: gethTypes.ReceiptStatusFailed,
+		}
+		expectedTx := gethTypes.NewTx(&gethTypes.AccessListTx{
+			To:       &common.Address{},
+			ChainID:  big.NewInt(1),
+			GasPrice: big.NewInt(1),
+			Data:     []byte("data"),
+		})
+
+		// Mock
+		patches.ApplyMethod(
+			reflect.TypeOf(w),
+			"ContractTransactNoWait",
+			func(_ *wallet, _ string, _ []byte) (*gethTypes.Transaction, error) {
+				return expectedTx, nil
+			},
+		)
+		patches.ApplyMethod(
+			reflect.TypeOf(w.provider.Eth()),
+			"WaitMined",
+			func(_ *ether.Ether, _ context.Context, _ common.Hash) (*gethTypes.Receipt, error) {
+				return failedReceipt, nil
+			},
+		)
+		patches.ApplyMethod(
+			reflect.TypeOf(w.provider.Eth()),
+			"RevertReason",
+			func(_ *ether.Ether, _ context.Context, txHash common.Hash) (*types.RevertError, error) {
+				return &types.RevertError{Reason: "not owner", TxHash: txHash}, nil
+			},
+		)
+
+		// Act
+		txReceipt, err := w.ContractTransact(context.Background(), contractAddress, data)
+
+		// Assert
+		assert.Equal(t, failedReceipt, txReceipt)
+		txErr, ok := errors.AsType[*types.TxError](err)
+		assert.True(t, ok)
+		assert.Equal(t, expectedTx.Hash(), txErr.TxHash)
+		assert.Equal(t, big.NewInt(1), txErr.ChainID)
+		revertErr, ok := errors.AsType[*types.RevertError](err)
+		assert.True(t, ok)
+		assert.Equal(t, "not owner", revertErr.Reason)
+	})
+
 	t.Run("if the wallet isn't connected, return err", func(t *testing.T) {
 		w, _ := New(testPrivHex)
 
@@ -1716,6 +1892,30 @@ func TestWallet_buildAuth(t *testing.T) {
 
 		assert.Equal(t, 1, chainIDCallCount, "ChainID RPC must be called only once (cached)")
 	})
+
+	t.Run("signs by the wallet's signer, only from its address", func(t *testing.T) {
+		patches := gomonkey.NewPatches()
+		defer patches.Reset()
+
+		// Arrange
+		w := createConnectedWallet()
+		applyChainIDPatch(patches, w, big.NewInt(1))
+		to := common.HexToAddress("0x" + testAddrHexTo)
+		tx := gethTypes.NewTx(&gethTypes.DynamicFeeTx{ChainID: big.NewInt(1), GasFeeCap: big.NewInt(1), GasTipCap: big.NewInt(1), To: &to})
+
+		// Act
+		auth, err := w.buildAuth()
+		signedTx, signErr := auth.Signer(auth.From, tx)
+		_, otherErr := auth.Signer(to, tx)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, w.GetAddress(), auth.From.Hex())
+		assert.NoError(t, signErr)
+		sender, _ := gethTypes.Sender(gethTypes.LatestSignerForChainID(big.NewInt(1)), signedTx)
+		assert.Equal(t, auth.From, sender)
+		assert.ErrorIs(t, otherErr, bind.ErrNotAuthorized)
+	})
 }
 
 func TestWallet_SignTx_CachesChainID(t *testing.T) {
@@ -1874,3 +2074,315 @@ func TestWallet_ResetPool(t *testing.T) {
 		assert.Nil(t, w.cachedChainID, "ChainID should be cleared")
 	})
 }
+
+func TestWallet_UseNonceManager(t *testing.T) {
+	txRequest := types.TransactionRequest{
+		To:       "0x123",
+		GasLimit: 30000,
+		Value:    "0x0",
+	}
+
+	// patchChain mocks the RPCs of SendTransaction: the pending nonce is
+	// pending[i] on the i-th call (the last one after), and send settles
+	// SendRawTransaction.
+	patchChain := func(
+		patches *gomonkey.Patches,
+		w *wallet,
+		pending []uint64,
+		send func(tx *gethTypes.Transaction) error,
+	) *int {
+		pendingCalls := 0
+		patches.ApplyMethod(
+			reflect.TypeOf(w.provider.Eth()),
+			"PendingNonceAt",
+			func(_ *ether.Ether, _ string) (uint64, error) {
+				nonce := pending[min(pendingCalls, len(pending)-1)]
+				pendingCalls++
+				return nonce, nil
+			},
+		)
+		patches.ApplyMethod(
+			reflect.TypeOf(w.provider.Eth()),
+			"EstimateGas",
+			func(_ *ether.Ether, _ types.TransactionRequest) (*big.Int, error) {
+				return big.NewInt(21000), nil
+			},
+		)
+		patches.ApplyMethod(
+			reflect.TypeOf(w.provider.Eth()),
+			"SuggestGasPrice",
+			func(_ *ether.Ether) (*big.Int, error) {
+				return big.NewInt(1), nil
+			},
+		)
+		patches.ApplyMethod(
+			reflect.TypeOf(w.provider.Eth()),
+			"ChainID",
+			func(_ *ether.Ether) (*big.Int, error) {
+				return big.NewInt(1), nil
+			},
+		)
+		patches.ApplyMethod(
+			reflect.TypeOf(w.provider.Eth()),
+			"SendRawTransaction",
+			func(_ *ether.Ether, tx *gethTypes.Transaction) error {
+				return send(tx)
+			},
+		)
+		return &pendingCalls
+	}
+
+	t.Run("concurrent sends get distinct nonces, synced once", func(t *testing.T) {
+		patches := gomonkey.NewPatches()
+		defer patches.Reset()
+
+		// Arrange
+		w := createConnectedWallet()
+		var (
+			mu   sync.Mutex
+			sent []uint64
+		)
+		pendingCalls := patchChain(patches, w, []uint64{5}, func(tx *gethTypes.Transaction) error {
+			mu.Lock()
+			defer mu.Unlock()
+			sent = append(sent, tx.Nonce())
+			return nil
+		})
+		w.UseNonceManager(nonce.New(w.PendingNonceAt))
+
+		// Act
+		var wg sync.WaitGroup
+		for range 20 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, err := w.SendTransaction(txRequest)
+				assert.NoError(t, err)
+			}()
+		}
+		wg.Wait()
+
+		// Assert
+		slices.Sort(sent)
+		for i, n := range sent {
+			assert.Equal(t, uint64(5+i), n)
+		}
+		assert.Len(t, sent, 20)
+		assert.Equal(t, 1, *pendingCalls)
+	})
+
+	t.Run("a send rejected on the nonce is resynced & sent once more", func(t *testing.T) {
+		patches := gomonkey.NewPatches()
+		defer patches.Reset()
+
+		// Arrange
+		w := createConnectedWallet()
+		sent := []uint64{}
+		patchChain(patches, w, []uint64{0, 7}, func(tx *gethTypes.Transaction) error {
+			sent = append(sent, tx.Nonce())
+			if tx.Nonce() < 7 {
+				return errors.New("nonce too low: next nonce 7, tx nonce 0")
+			}
+			return nil
+		})
+		w.UseNonceManager(nonce.New(w.PendingNonceAt))
+
+		// Act
+		_, err := w.SendTransaction(txRequest)
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Equal(t, []uint64{0, 7}, sent)
+	})
+
+	t.Run("a send the node rejected gives its nonce back", func(t *testing.T) {
+		patches := gomonkey.NewPatches()
+		defer patches.Reset()
+
+		// Arrange
+		w := createConnectedWallet()
+		sent := []uint64{}
+		fail := true
+		patchChain(patches, w, []uint64{3}, func(tx *gethTypes.Transaction) error {
+			sent = append(sent, tx.Nonce())
+			if fail {
+				return &types.RpcError{
+					Method:  constant.Eth_SendRawTransaction,
+					Code:    -32000,
+					Message: "insufficient funds for gas * price + value",
+				}
+			}
+			return nil
+		})
+		w.UseNonceManager(nonce.New(w.PendingNonceAt))
+
+		// Act
+		_, err := w.SendTransaction(txRequest)
+		fail = false
+		_, retryErr := w.SendTransaction(txRequest)
+
+		// Assert
+		assert.Error(t, err)
+		assert.NoError(t, retryErr)
+		assert.Equal(t, []uint64{3, 3}, sent)
+	})
+
+	t.Run("a send failing with a transport error keeps its nonce if the node took the tx", func(t *testing.T) {
+		patches := gomonkey.NewPatches()
+		defer patches.Reset()
+
+		// Arrange
+		w := createConnectedWallet()
+		sent := []uint64{}
+		fail := true
+		// the node took the tx of nonce 3 before the connection was reset
+		pendingCalls := patchChain(patches, w, []uint64{3, 4}, func(tx *gethTypes.Transaction) error {
+			sent = append(sent, tx.Nonce())
+			if fail {
+				return &url.Error{Op: "Post", URL: "https://eth-mainnet.g.alchemy.com/v2/api-key", Err: syscall.ECONNRESET}
+			}
+			return nil
+		})
+		nonces := nonce.New(w.PendingNonceAt)
+		w.UseNonceManager(nonces)
+
+		// Act
+		_, err := w.SendTransaction(txRequest)
+		fail = false
+		_, nextErr := w.SendTransaction(txRequest)
+
+		// Assert
+		assert.Error(t, err)
+		assert.NoError(t, nextErr)
+		assert.Equal(t, []uint64{3, 4}, sent)
+		assert.Equal(t, 2, *pendingCalls)
+		assert.Empty(t, nonces.Gaps())
+	})
+
+	t.Run("ContractTransactNoWait sends with the nonce of the manager", func(t *testing.T) {
+		patches := gomonkey.NewPatches()
+		defer patches.Reset()
+
+		// Arrange
+		w := createConnectedWallet()
+		patchChain(patches, w, []uint64{4}, func(_ *gethTypes.Transaction) error { return nil })
+		var nonces []uint64
+		patches.ApplyMethod(
+			reflect.TypeOf(w.provider.Eth()),
+			"ContractTransact",
+			func(_ *ether.Ether, auth *bind.TransactOpts, _ string, _ []byte) (*gethTypes.Transaction, error) {
+				nonces = append(nonces, auth.Nonce.Uint64())
+				return gethTypes.NewTx(&gethTypes.LegacyTx{Nonce: auth.Nonce.Uint64()}), nil
+			},
+		)
+		w.UseNonceManager(nonce.New(w.PendingNonceAt))
+
+		// Act
+		_, err := w.ContractTransactNoWait("0x1234567890123456789012345678901234567890", []byte("data"))
+		_, sendErr := w.SendTransaction(txRequest)
+		_, nextErr := w.ContractTransactNoWait("0x1234567890123456789012345678901234567890", []byte("data"))
+
+		// Assert
+		assert.NoError(t, err)
+		assert.NoError(t, sendErr)
+		assert.NoError(t, nextErr)
+		assert.Equal(t, []uint64{4, 6}, nonces)
+	})
+
+	t.Run("without a manager, bind takes the pending nonce", func(t *testing.T) {
+		patches := gomonkey.NewPatches()
+		defer patches.Reset()
+
+		// Arrange
+		w := createConnectedWallet()
+		patchChain(patches, w, []uint64{0}, func(_ *gethTypes.Transaction) error { return nil })
+
+		// Act
+		auth, err := w.buildAuth()
+
+		// Assert
+		assert.NoError(t, err)
+		assert.Nil(t, auth.Nonce)
+	})
+}
+
+// countingSigner is a types.Signer out of the process, that counts what it
+// signs.
+type countingSigner struct {
+	types.Signer
+	hashes int
+	txs    int
+}
+
+func (s *countingSigner) SignHash(hash common.Hash) ([]byte, error) {
+	s.hashes++
+	return s.Signer.SignHash(hash)
+}
+
+func (s *countingSigner) SignTx(tx *gethTypes.Transaction, chainID *big.Int) (*gethTypes.Transaction, error) {
+	s.txs++
+	return s.Signer.SignTx(tx, chainID)
+}
+
+func TestFromSigner(t *testing.T) {
+	key, _ := crypto.HexToECDSA(testPrivHex)
+
+	t.Run("signs txs & EIP-712 by the signer", func(t *testing.T) {
+		patches := gomonkey.NewPatches()
+		defer patches.Reset()
+
+		// Arrange
+		s := &countingSigner{Signer: signer.NewLocal(key)}
+		w := FromSigner(s).(*wallet)
+		w.Connect(createConnectedWallet().provider)
+		applyChainIDPatch(patches, w, big.NewInt(1))
+		patches.ApplyMethod(
+			reflect.TypeOf(w),
+			"PendingNonceAt",
+			func(_ *wallet) (uint64, error) {
+				return 1, nil
+			},
+		)
+		patches.ApplyMethod(
+			reflect.TypeOf(w.provider.Eth()),
+			"EstimateGas",
+			func(_ *ether.Ether, _ types.TransactionRequest) (*big.Int, error) {
+				return big.NewInt(21000), nil
+			},
+		)
+		patches.ApplyMethod(
+			reflect.TypeOf(w.provider.Eth()),
+			"SuggestGasPrice",
+			func(_ *ether.Ether) (*big.Int, error) {
+				return big.NewInt(1), nil
+			},
+		)
+		var domainSeparator [32]byte
+
+		// Act
+		signedTx, err := w.SignTx(types.TransactionRequest{To: "0x" + testAddrHexTo, Value: "0x1"})
+		sig, sigErr := w.SignEIP712(domainSeparator, []byte("encoded"))
+
+		// Assert
+		assert.Equal(t, "0x"+testAddrHex, strings.ToLower(w.GetAddress()))
+		assert.NoError(t, err)
+		sender, _ := gethTypes.Sender(gethTypes.LatestSignerForChainID(big.NewInt(1)), signedTx)
+		assert.Equal(t, s.Address(), sender)
+		assert.NoError(t, sigErr)
+		expected, _ := typeddata.SignEIP712(key, domainSeparator, []byte("encoded"))
+		assert.Equal(t, expected, sig)
+		assert.Equal(t, 1, s.txs)
+		assert.Equal(t, 1, s.hashes)
+	})
+
+	t.Run("ExportKeystore returns ErrKeyNotExportable", func(t *testing.T) {
+		// Arrange
+		w := FromSigner(&countingSigner{Signer: signer.NewLocal(key)})
+
+		// Act
+		_, err := w.ExportKeystore("secret", nil)
+
+		// Assert
+		assert.ErrorIs(t, err, constant.ErrKeyNotExportable)
+	})
+}